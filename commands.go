@@ -0,0 +1,14 @@
+package main
+
+import (
+	"io"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm"
+)
+
+// commands are the subcommands of the provider binary, run instead of serving the plugin
+var commands = map[string]func(args []string, out io.Writer) error{
+	"export":        ibm.ExportCommand,
+	"migrate":       ibm.MigrateCommand,
+	"schema-export": ibm.SchemaExportCommand,
+}
@@ -0,0 +1,239 @@
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	gohttp "net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev1/controller"
+	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev2/controllerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	corev1 "github.com/IBM/go-sdk-core/core"
+	corev3 "github.com/IBM/go-sdk-core/v3/core"
+	"github.com/IBM/go-sdk-core/v4/core"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// apiErrorKind classifies the errors returned by the IBM Cloud APIs
+type apiErrorKind int
+
+const (
+	apiErrorUnknown apiErrorKind = iota
+	apiErrorNotFound
+	apiErrorConflict
+	apiErrorThrottled
+	apiErrorUnauthorized
+)
+
+func (k apiErrorKind) String() string {
+	switch k {
+	case apiErrorNotFound:
+		return "NotFound"
+	case apiErrorConflict:
+		return "Conflict"
+	case apiErrorThrottled:
+		return "Throttled"
+	case apiErrorUnauthorized:
+		return "Unauthorized"
+	}
+	return "Unknown"
+}
+
+// apiError is an error returned by an IBM Cloud API, classified from the status
+// code of the response whichever SDK returned it.
+type apiError struct {
+	Kind          apiErrorKind
+	StatusCode    int
+	RequestID     string
+	TransactionID string
+	Err           error
+}
+
+func (e *apiError) Error() string {
+	var ids []string
+	if e.RequestID != "" && !strings.Contains(e.Err.Error(), e.RequestID) {
+		ids = append(ids, "request ID: "+e.RequestID)
+	}
+	if e.TransactionID != "" && !strings.Contains(e.Err.Error(), e.TransactionID) {
+		ids = append(ids, "transaction ID: "+e.TransactionID)
+	}
+	if len(ids) == 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s (%s)", e.Err, strings.Join(ids, ", "))
+}
+
+func (e *apiError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	// Status codes in the messages of errors the SDKs wrap with fmt.Errorf, like the
+	// ones of the Power clients or bluemix-go errors quoted by a resource
+	statusCodePatterns = []*regexp.Regexp{
+		// bluemix-go
+		regexp.MustCompile(`status code: (\d{3})\b`),
+		// sl.Error
+		regexp.MustCompile(`\(HTTP (\d{3})\)`),
+		// go-swagger responses of the Power clients
+		regexp.MustCompile(`\]\[(\d{3})\] `),
+		// go-openapi runtime.APIError
+		regexp.MustCompile(`\(status (\d{3})\)`),
+	}
+	// Codes of the bluemix-go errors replacing the 404 of the lookups of a resource by
+	// its ID or name. The codes of the lookups of a referenced resource, like
+	// ResourceGroupDoesnotExist, are not classified.
+	notFoundErrorCodes = map[string]bool{
+		controllerv2.ErrCodeResourceServiceInstanceDoesnotExist: true,
+		controller.ErrCodeResourceServiceAliasDoesnotExist:      true,
+	}
+	requestIDPattern     = regexp.MustCompile(`"(?:request_id|requestId|x-request-id|X-Request-Id|correlation_id|correlationId)"\s*:\s*"([^"]+)"`)
+	transactionIDPattern = regexp.MustCompile(`"(?:transaction_id|transactionId|trace|incidentID|x-global-transaction-id)"\s*:\s*"([^"]+)"`)
+)
+
+// classifyError unwraps the error returned by a bluemix-go, Platform SDK, SoftLayer,
+// Key Protect or Power client. It returns nil for a nil error, and an apiError of
+// kind apiErrorUnknown when the status code cannot be found.
+func classifyError(err error) *apiError {
+	if err == nil {
+		return nil
+	}
+	var classified *apiError
+	if errors.As(err, &classified) {
+		return classified
+	}
+	classified = &apiError{Err: err}
+
+	var slErr sl.Error
+	var kpErr *kp.Error
+	var bmxErr bmxerror.RequestFailure
+	var bmxCodeErr bmxerror.Error
+	switch {
+	case errors.As(err, &slErr):
+		classified.StatusCode = slErr.StatusCode
+		if strings.HasSuffix(slErr.Exception, "ObjectNotFound") {
+			classified.StatusCode = gohttp.StatusNotFound
+		}
+	case errors.As(err, &kpErr):
+		classified.StatusCode = kpErr.StatusCode
+		classified.RequestID = kpErr.CorrelationID
+	case errors.As(err, &bmxErr):
+		classified.StatusCode = bmxErr.StatusCode()
+	case errors.As(err, &bmxCodeErr) && notFoundErrorCodes[bmxCodeErr.Code()]:
+		classified.StatusCode = gohttp.StatusNotFound
+	default:
+		classified.StatusCode = statusCodeFromMessage(err.Error())
+	}
+
+	message := err.Error()
+	if classified.RequestID == "" {
+		if match := requestIDPattern.FindStringSubmatch(message); match != nil {
+			classified.RequestID = match[1]
+		}
+	}
+	if match := transactionIDPattern.FindStringSubmatch(message); match != nil {
+		classified.TransactionID = match[1]
+	}
+	classified.Kind = apiErrorKindOf(classified.StatusCode)
+	return classified
+}
+
+// responseError classifies the error returned by a Platform SDK operation with its
+// DetailedResponse, or by a client returning the http.Response, keeping the request
+// and transaction IDs of the response headers.
+func responseError(response interface{}, err error) error {
+	if err == nil {
+		return nil
+	}
+	var statusCode int
+	var headers gohttp.Header
+	switch r := response.(type) {
+	case *core.DetailedResponse:
+		if r != nil {
+			statusCode, headers = r.StatusCode, r.Headers
+		}
+	case *corev3.DetailedResponse:
+		if r != nil {
+			statusCode, headers = r.StatusCode, r.Headers
+		}
+	case *corev1.DetailedResponse:
+		if r != nil {
+			statusCode, headers = r.StatusCode, r.Headers
+		}
+	case *gohttp.Response:
+		if r != nil {
+			statusCode, headers = r.StatusCode, r.Header
+		}
+	}
+	if statusCode == 0 {
+		return classifyError(err)
+	}
+	return &apiError{
+		Kind:          apiErrorKindOf(statusCode),
+		StatusCode:    statusCode,
+		RequestID:     firstHeader(headers, "X-Request-Id", "X-Correlation-Id"),
+		TransactionID: firstHeader(headers, "Transaction-Id", "X-Transaction-Id", "X-Global-Transaction-Id"),
+		Err:           err,
+	}
+}
+
+func apiErrorKindOf(statusCode int) apiErrorKind {
+	switch statusCode {
+	case gohttp.StatusNotFound, gohttp.StatusGone:
+		return apiErrorNotFound
+	case gohttp.StatusConflict:
+		return apiErrorConflict
+	case gohttp.StatusTooManyRequests:
+		return apiErrorThrottled
+	case gohttp.StatusUnauthorized:
+		return apiErrorUnauthorized
+	}
+	return apiErrorUnknown
+}
+
+func statusCodeFromMessage(message string) int {
+	for _, pattern := range statusCodePatterns {
+		if match := pattern.FindStringSubmatch(message); match != nil {
+			code, _ := strconv.Atoi(match[1])
+			return code
+		}
+	}
+	return 0
+}
+
+func firstHeader(headers gohttp.Header, names ...string) string {
+	for _, name := range names {
+		if value := headers.Get(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// isNotFound reports whether the API could not find the resource, i.e. it was deleted
+func isNotFound(err error) bool {
+	return classifyError(err).is(apiErrorNotFound)
+}
+
+// isConflict reports whether the request conflicts with the state of the resource
+func isConflict(err error) bool {
+	return classifyError(err).is(apiErrorConflict)
+}
+
+// isThrottled reports whether the API rejected the request because of its rate limit
+func isThrottled(err error) bool {
+	return classifyError(err).is(apiErrorThrottled)
+}
+
+// isUnauthorized reports whether the API rejected the credentials of the request
+func isUnauthorized(err error) bool {
+	return classifyError(err).is(apiErrorUnauthorized)
+}
+
+func (e *apiError) is(kind apiErrorKind) bool {
+	return e != nil && e.Kind == kind
+}
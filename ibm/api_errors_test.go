@@ -0,0 +1,73 @@
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v4/core"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		kind apiErrorKind
+	}{
+		{"bluemix-go", bmxerror.NewRequestFailure("ServerErrorResponse", `{"message": "Object not found"}`, 404), apiErrorNotFound},
+		{"wrapped bluemix-go", fmt.Errorf("Error retrieving instance: %s", bmxerror.NewRequestFailure("ServerErrorResponse", "conflict", 409)), apiErrorConflict},
+		{"bluemix-go lookup", bmxerror.New("ResourceServiceInstanceDoesnotExist", "Given service instance doesn't exist"), apiErrorNotFound},
+		{"bluemix-go resource group lookup", bmxerror.New("ResourceGroupDoesnotExist", "Given resource Group : \"default\" doesn't exist"), apiErrorUnknown},
+		{"bluemix-go service lookup", bmxerror.New("ServiceDoesnotExist", "Given service : \"cloud-object-storage\" doesn't exist"), apiErrorUnknown},
+		{"bluemix-go other code", bmxerror.New("ParameterMissing", "the service instance name is missing"), apiErrorUnknown},
+		{"softlayer", sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_ObjectNotFound"}, apiErrorNotFound},
+		{"softlayer throttled", sl.Error{StatusCode: 429}, apiErrorThrottled},
+		{"key protect", &kp.Error{StatusCode: 401, CorrelationID: "1234"}, apiErrorUnauthorized},
+		{"power", fmt.Errorf("Failed to Get PVM Instance abc :[GET /pcloud/v1/cloud-instances/{cloud_instance_id}/pvm-instances/{pvm_instance_id}][404] pcloudPvminstancesGetNotFound  &{Description:not found}"), apiErrorNotFound},
+		{"unknown", errors.New("connection refused"), apiErrorUnknown},
+	}
+	for _, c := range cases {
+		if kind := classifyError(c.err).Kind; kind != c.kind {
+			t.Errorf("%s: expected %s, got %s", c.name, c.kind, kind)
+		}
+	}
+	if classifyError(nil) != nil || isNotFound(nil) {
+		t.Errorf("expected a nil error not to be classified")
+	}
+	if id := classifyError(&kp.Error{StatusCode: 404, CorrelationID: "1234"}).RequestID; id != "1234" {
+		t.Errorf("expected the correlation ID of Key Protect errors as request ID, got %q", id)
+	}
+	if id := classifyError(bmxerror.NewRequestFailure("ServerErrorResponse", `{"trace": "abcd"}`, 500)).TransactionID; id != "abcd" {
+		t.Errorf("expected the trace of the response as transaction ID, got %q", id)
+	}
+}
+
+func TestResponseError(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: 404,
+		Headers:    http.Header{"X-Request-Id": []string{"req-1"}, "Transaction-Id": []string{"tx-1"}},
+	}
+	err := responseError(response, errors.New("Not Found"))
+	if !isNotFound(err) {
+		t.Fatalf("expected a NotFound error, got %v", err)
+	}
+	classified := classifyError(err)
+	if classified.RequestID != "req-1" || classified.TransactionID != "tx-1" {
+		t.Errorf("expected the request and transaction IDs of the response, got %q and %q", classified.RequestID, classified.TransactionID)
+	}
+	if err.Error() != "Not Found (request ID: req-1, transaction ID: tx-1)" {
+		t.Errorf("unexpected message %q", err)
+	}
+
+	var nilResponse *core.DetailedResponse
+	if isNotFound(responseError(nilResponse, errors.New("timeout"))) {
+		t.Errorf("expected an error without response not to be NotFound")
+	}
+	if responseError(response, nil) != nil {
+		t.Errorf("expected no error for a nil error")
+	}
+}
@@ -0,0 +1,177 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	defaultAsyncMinPollInterval = 5 * time.Second
+	defaultAsyncMaxPollInterval = time.Minute
+)
+
+// asyncWaiterTimeScale scales the delay and the poll intervals of every waiter and the
+// waits of the parent locks. It is only changed by the offline runs, which poll the fake
+// cloud of the unit tests or replay a cassette without waiting for seconds.
+var asyncWaiterTimeScale = 1.0
+
+// asyncStatus is the status of an asynchronous operation, the progress is an optional
+// detail shown to the user while waiting, e.g. "3/5 workers normal"
+type asyncStatus struct {
+	State    string
+	Progress string
+}
+
+// asyncStatusFunc returns the resource and its current status
+type asyncStatusFunc func() (interface{}, asyncStatus, error)
+
+// asyncWaiter waits for an asynchronous operation on a resource to complete.
+//
+// The waiter polls the status until it is one of the Target states, and fails as soon
+// as it is one of the Failed states, or not a Pending state when Pending is set. When
+// NotFoundIsDeleted is set the resource not being found completes the wait, which is
+// how the deletions are awaited. The poll interval starts at MinPollInterval and grows
+// up to MaxPollInterval while the status does not change. The status and the progress
+// are logged at INFO level whenever they change.
+type asyncWaiter struct {
+	// Description completes "Waiting for", e.g. "VPC r006-xxx to be available"
+	Description string
+	Status      asyncStatusFunc
+
+	Pending []string
+	Target  []string
+	Failed  []string
+
+	NotFoundIsDeleted bool
+
+	// ContinuousTarget is the number of times in a row the target has to be observed,
+	// for the statuses which flap while the operation settles
+	ContinuousTarget int
+
+	Timeout         time.Duration
+	Delay           time.Duration
+	MinPollInterval time.Duration
+	MaxPollInterval time.Duration
+}
+
+// refreshStatus adapts a resource.StateRefreshFunc to the status getter of asyncWaiter
+func refreshStatus(refresh resource.StateRefreshFunc) asyncStatusFunc {
+	return func() (interface{}, asyncStatus, error) {
+		result, state, err := refresh()
+		return result, asyncStatus{State: state}, err
+	}
+}
+
+// wait polls the status until the operation completes, it returns the last result of
+// the status getter
+func (w *asyncWaiter) wait() (interface{}, error) {
+	minInterval := w.MinPollInterval
+	if minInterval <= 0 {
+		minInterval = defaultAsyncMinPollInterval
+	}
+	maxInterval := w.MaxPollInterval
+	if maxInterval < minInterval {
+		maxInterval = defaultAsyncMaxPollInterval
+		if maxInterval < minInterval {
+			maxInterval = minInterval
+		}
+	}
+	minInterval, maxInterval = scaleAsyncDuration(minInterval), scaleAsyncDuration(maxInterval)
+	continuousTarget := w.ContinuousTarget
+	if continuousTarget <= 0 {
+		continuousTarget = 1
+	}
+
+	log.Printf("[INFO] Waiting for %s", w.Description)
+	start := time.Now()
+	deadline := start.Add(w.Timeout)
+	if w.Delay > 0 {
+		time.Sleep(scaleAsyncDuration(w.Delay))
+	}
+
+	var result interface{}
+	var last asyncStatus
+	interval := minInterval
+	targetOccurence := 0
+	for polls := 0; ; polls++ {
+		var status asyncStatus
+		var err error
+		result, status, err = w.Status()
+		if err != nil {
+			if w.NotFoundIsDeleted && isNotFound(err) {
+				log.Printf("[INFO] Done waiting for %s after %s: not found", w.Description, elapsed(start))
+				return result, nil
+			}
+			return result, fmt.Errorf("Error waiting for %s: %s", w.Description, err)
+		}
+
+		if polls == 0 || status != last {
+			log.Printf("[INFO] Still waiting for %s after %s: %s", w.Description, elapsed(start), status)
+			interval = minInterval
+		} else if interval = interval * 3 / 2; interval > maxInterval {
+			interval = maxInterval
+		}
+		last = status
+
+		switch {
+		case containsString(w.Failed, status.State):
+			return result, fmt.Errorf("Error waiting for %s: the operation failed with the status %s", w.Description, status)
+		case containsString(w.Target, status.State):
+			targetOccurence++
+			if targetOccurence >= continuousTarget {
+				log.Printf("[INFO] Done waiting for %s after %s: %s", w.Description, elapsed(start), status)
+				return result, nil
+			}
+			interval = minInterval
+		case len(w.Pending) > 0 && !containsString(w.Pending, status.State):
+			return result, fmt.Errorf("Error waiting for %s: unexpected status %s, expected %s", w.Description, status, strings.Join(w.Target, ", "))
+		default:
+			targetOccurence = 0
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return result, fmt.Errorf("Error waiting for %s: %s", w.Description, &resource.TimeoutError{
+				LastState:     status.String(),
+				Timeout:       w.Timeout,
+				ExpectedState: w.Target,
+			})
+		}
+		if interval > remaining {
+			interval = remaining
+		}
+		time.Sleep(interval)
+	}
+}
+
+func (s asyncStatus) String() string {
+	state := s.State
+	if state == "" {
+		state = "unknown"
+	}
+	if s.Progress == "" {
+		return state
+	}
+	return fmt.Sprintf("%s (%s)", state, s.Progress)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func scaleAsyncDuration(d time.Duration) time.Duration {
+	return time.Duration(float64(d) * asyncWaiterTimeScale)
+}
+
+func elapsed(start time.Time) time.Duration {
+	return time.Since(start).Round(time.Second)
+}
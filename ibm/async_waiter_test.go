@@ -0,0 +1,178 @@
+package ibm
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	bmxerror "github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+// statuses returns a status getter returning the statuses in order, the last one repeatedly
+func statuses(polls *int, states ...asyncStatus) asyncStatusFunc {
+	return func() (interface{}, asyncStatus, error) {
+		i := *polls
+		*polls++
+		if i >= len(states) {
+			i = len(states) - 1
+		}
+		return i, states[i], nil
+	}
+}
+
+func testWaiter(status asyncStatusFunc) *asyncWaiter {
+	return &asyncWaiter{
+		Description:     "test to be done",
+		Status:          status,
+		Pending:         []string{"pending"},
+		Target:          []string{"done"},
+		Failed:          []string{"failed"},
+		Timeout:         time.Second,
+		MinPollInterval: time.Millisecond,
+		MaxPollInterval: time.Millisecond,
+	}
+}
+
+func TestAsyncWaiterTarget(t *testing.T) {
+	polls := 0
+	w := testWaiter(statuses(&polls, asyncStatus{State: "pending"}, asyncStatus{State: "pending", Progress: "1/2"}, asyncStatus{State: "done"}))
+	result, err := w.wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result != 2 || polls != 3 {
+		t.Errorf("expected the result of the third poll, got %v after %d polls", result, polls)
+	}
+}
+
+func TestAsyncWaiterFailed(t *testing.T) {
+	polls := 0
+	w := testWaiter(statuses(&polls, asyncStatus{State: "pending"}, asyncStatus{State: "failed", Progress: "quota exceeded"}, asyncStatus{State: "done"}))
+	_, err := w.wait()
+	if err == nil || !strings.Contains(err.Error(), "failed (quota exceeded)") {
+		t.Fatalf("expected the failed status in the error, got %v", err)
+	}
+	if polls != 2 {
+		t.Errorf("expected the wait to stop at the failed status, got %d polls", polls)
+	}
+}
+
+func TestAsyncWaiterUnexpectedStatus(t *testing.T) {
+	polls := 0
+	_, err := testWaiter(statuses(&polls, asyncStatus{State: "unknown_state"})).wait()
+	if err == nil || !strings.Contains(err.Error(), "unexpected status unknown_state") {
+		t.Fatalf("expected an unexpected status error, got %v", err)
+	}
+
+	// Without pending states any status is waited for
+	polls = 0
+	w := testWaiter(statuses(&polls, asyncStatus{State: "unknown_state"}, asyncStatus{State: "done"}))
+	w.Pending = nil
+	if _, err := w.wait(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestAsyncWaiterErrors(t *testing.T) {
+	notFound := bmxerror.NewRequestFailure("ServerErrorResponse", `{"message": "Object not found"}`, 404)
+	getter := func(err error) asyncStatusFunc {
+		return func() (interface{}, asyncStatus, error) {
+			return nil, asyncStatus{}, err
+		}
+	}
+
+	w := testWaiter(getter(notFound))
+	if _, err := w.wait(); err == nil {
+		t.Errorf("expected the not found error")
+	}
+	w.NotFoundIsDeleted = true
+	if _, err := w.wait(); err != nil {
+		t.Errorf("expected not found to complete the wait, got %s", err)
+	}
+	if _, err := testWaiter(getter(errors.New("internal error"))).wait(); err == nil || !strings.Contains(err.Error(), "Error waiting for test to be done: internal error") {
+		t.Errorf("expected the error of the status getter, got %v", err)
+	}
+}
+
+func TestAsyncWaiterTimeout(t *testing.T) {
+	polls := 0
+	w := testWaiter(statuses(&polls, asyncStatus{State: "pending", Progress: "2/5 workers normal"}))
+	w.Timeout = 20 * time.Millisecond
+	_, err := w.wait()
+	if err == nil || !strings.Contains(err.Error(), "timeout while waiting for state to become 'done' (last state: 'pending (2/5 workers normal)'") {
+		t.Fatalf("expected a timeout error with the last status, got %v", err)
+	}
+}
+
+func TestAsyncWaiterContinuousTarget(t *testing.T) {
+	polls := 0
+	done, pending := asyncStatus{State: "done"}, asyncStatus{State: "pending"}
+	w := testWaiter(statuses(&polls, done, pending, done, done, done))
+	w.ContinuousTarget = 3
+	if _, err := w.wait(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if polls != 5 {
+		t.Errorf("expected the target to be observed 3 times in a row, got %d polls", polls)
+	}
+}
+
+func TestAsyncWaiterPollInterval(t *testing.T) {
+	var times []time.Time
+	polls := 0
+	states := statuses(&polls, asyncStatus{State: "pending"}, asyncStatus{State: "pending"}, asyncStatus{State: "pending"},
+		asyncStatus{State: "pending"}, asyncStatus{State: "pending", Progress: "1/2"}, asyncStatus{State: "done"})
+	w := testWaiter(func() (interface{}, asyncStatus, error) {
+		times = append(times, time.Now())
+		return states()
+	})
+	w.MinPollInterval = 20 * time.Millisecond
+	w.MaxPollInterval = 60 * time.Millisecond
+	if _, err := w.wait(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	intervals := make([]time.Duration, len(times)-1)
+	for i := range intervals {
+		intervals[i] = times[i+1].Sub(times[i])
+	}
+	// 20ms, then 30ms, 45ms and 60ms while the status does not change, back to 20ms on the change
+	if intervals[0] < 20*time.Millisecond || intervals[3] < 60*time.Millisecond {
+		t.Errorf("expected the interval to grow up to the maximum while the status does not change, got %v", intervals)
+	}
+	if intervals[4] >= 50*time.Millisecond {
+		t.Errorf("expected the interval to be reset when the status changes, got %v", intervals)
+	}
+}
+
+func TestAsyncWaiterTimeScale(t *testing.T) {
+	polls := 0
+	w := testWaiter(statuses(&polls, asyncStatus{State: "pending"}, asyncStatus{State: "done"}))
+	w.Delay = time.Minute
+	w.MinPollInterval = time.Minute
+	scale := asyncWaiterTimeScale
+	asyncWaiterTimeScale = 0.0001
+	defer func() {
+		asyncWaiterTimeScale = scale
+	}()
+	start := time.Now()
+	if _, err := w.wait(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected the delay and the poll interval to be scaled, waited %s", elapsed)
+	}
+}
+
+func TestAsyncStatusString(t *testing.T) {
+	cases := map[asyncStatus]string{
+		{}:                                    "unknown",
+		{State: "normal"}:                     "normal",
+		{State: "deploying", Progress: "3/5"}: "deploying (3/5)",
+	}
+	for s, expected := range cases {
+		if s.String() != expected {
+			t.Errorf("expected %q, got %q", expected, s.String())
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package ibm
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	idSegmentString = ""
+	idSegmentCRN    = "crn"
+	idSegmentInt    = "int"
+)
+
+var idTemplateSegment = regexp.MustCompile(`\{([a-z0-9_]+)(?::([a-z]+))?(\?)?\}`)
+
+// idTemplates are the ID templates by resource name
+var idTemplates = map[string]*idTemplate{}
+
+// idTemplate describes the composite ID of a resource, e.g. {lb_id}/{listener_id}.
+// A segment may be typed, {cis_id:crn} or {port:int}, and the trailing segments may
+// be optional, {namespace?}. A crn segment contains the separator, it must be the
+// last segment.
+type idTemplate struct {
+	resource  string
+	separator string
+	segments  []idSegment
+	example   string
+}
+
+type idSegment struct {
+	name     string
+	kind     string
+	optional bool
+}
+
+// compositeID is an ID parsed with its template
+type compositeID struct {
+	template *idTemplate
+	values   []string
+}
+
+// newIDTemplate returns the template of the IDs of the resource and registers it in
+// idTemplates, the example is shown in the errors of the IDs not matching the template.
+// It panics when the template is invalid.
+func newIDTemplate(resource, template, example string) *idTemplate {
+	t := parseIDTemplate(resource, template, example)
+	if _, ok := idTemplates[resource]; ok {
+		panic(fmt.Sprintf("duplicate ID template for %s", resource))
+	}
+	idTemplates[resource] = t
+	return t
+}
+
+func parseIDTemplate(resource, template, example string) *idTemplate {
+	t := &idTemplate{resource: resource, example: example}
+	matches := idTemplateSegment.FindAllStringSubmatchIndex(template, -1)
+	if len(matches) == 0 || matches[0][0] != 0 || matches[len(matches)-1][1] != len(template) {
+		panic(fmt.Sprintf("invalid ID template %q for %s", template, resource))
+	}
+	for i, m := range matches {
+		if i > 0 {
+			separator := template[matches[i-1][1]:m[0]]
+			if separator == "" || (t.separator != "" && separator != t.separator) {
+				panic(fmt.Sprintf("invalid separator in the ID template %q for %s", template, resource))
+			}
+			t.separator = separator
+		}
+		s := idSegment{name: template[m[2]:m[3]], optional: m[6] >= 0}
+		if m[4] >= 0 {
+			s.kind = template[m[4]:m[5]]
+		}
+		switch {
+		case s.kind != idSegmentString && s.kind != idSegmentCRN && s.kind != idSegmentInt:
+			panic(fmt.Sprintf("unknown type %s of the segment %s in the ID template for %s", s.kind, s.name, resource))
+		case s.kind == idSegmentCRN && i != len(matches)-1:
+			panic(fmt.Sprintf("the crn segment %s must be the last one in the ID template for %s", s.name, resource))
+		case !s.optional && len(t.segments) > 0 && t.segments[len(t.segments)-1].optional:
+			panic(fmt.Sprintf("the optional segments must be the last ones in the ID template for %s", resource))
+		}
+		t.segments = append(t.segments, s)
+	}
+	if len(t.segments) > 1 && t.separator == "" {
+		panic(fmt.Sprintf("missing separator in the ID template %q for %s", template, resource))
+	}
+	return t
+}
+
+// String returns the expected format of the IDs, e.g. <lb_id>/<listener_id>
+func (t *idTemplate) String() string {
+	var b strings.Builder
+	for i, s := range t.segments {
+		separator := ""
+		if i > 0 {
+			separator = t.separator
+		}
+		if s.optional {
+			fmt.Fprintf(&b, "[%s<%s>]", separator, s.name)
+		} else {
+			fmt.Fprintf(&b, "%s<%s>", separator, s.name)
+		}
+	}
+	return b.String()
+}
+
+func (t *idTemplate) required() int {
+	n := 0
+	for _, s := range t.segments {
+		if !s.optional {
+			n++
+		}
+	}
+	return n
+}
+
+func (t *idTemplate) errorf(id string, format string, a ...interface{}) error {
+	return fmt.Errorf("Invalid ID %q for %s: %s, expected %s, e.g. %s", id, t.resource, fmt.Sprintf(format, a...), t, t.example)
+}
+
+// parse splits the ID in segments and validates them
+func (t *idTemplate) parse(id string) (compositeID, error) {
+	var values []string
+	last := t.segments[len(t.segments)-1]
+	switch {
+	case len(t.segments) == 1:
+		values = []string{id}
+	case last.kind == idSegmentCRN:
+		values = strings.SplitN(id, t.separator, len(t.segments))
+	default:
+		values = strings.Split(id, t.separator)
+	}
+	if len(values) < t.required() {
+		return compositeID{}, t.errorf(id, "the %s segment is missing", t.segments[len(values)].name)
+	}
+	if len(values) > len(t.segments) {
+		if t.required() < len(t.segments) {
+			return compositeID{}, t.errorf(id, "the ID has %d segments instead of at most %d", len(values), len(t.segments))
+		}
+		return compositeID{}, t.errorf(id, "the ID has %d segments instead of %d", len(values), len(t.segments))
+	}
+	for i, v := range values {
+		s := t.segments[i]
+		switch {
+		case v == "" && !s.optional:
+			return compositeID{}, t.errorf(id, "the %s segment is empty", s.name)
+		case s.kind == idSegmentCRN && !strings.HasPrefix(v, "crn:"):
+			return compositeID{}, t.errorf(id, "the %s segment %q is not a CRN", s.name, v)
+		case s.kind == idSegmentInt && v != "":
+			if _, err := strconv.Atoi(v); err != nil {
+				return compositeID{}, t.errorf(id, "the %s segment %q is not a number", s.name, v)
+			}
+		}
+	}
+	for len(values) < len(t.segments) {
+		values = append(values, "")
+	}
+	return compositeID{template: t, values: values}, nil
+}
+
+// split returns the segments of the ID in the order of the template, the missing
+// optional segments are empty
+func (t *idTemplate) split(id string) ([]string, error) {
+	parsed, err := t.parse(id)
+	if err != nil {
+		return nil, err
+	}
+	return parsed.values, nil
+}
+
+// validate returns the error of the ID not matching the template
+func (t *idTemplate) validate(id string) error {
+	_, err := t.parse(id)
+	return err
+}
+
+// format joins the segments of the ID, the empty trailing optional segments are omitted
+func (t *idTemplate) format(values ...string) string {
+	if len(values) != len(t.segments) && len(values) != t.required() {
+		panic(fmt.Sprintf("%d segments given for the ID template %s of %s", len(values), t, t.resource))
+	}
+	n := len(values)
+	for n > t.required() && values[n-1] == "" {
+		n--
+	}
+	return strings.Join(values[:n], t.separator)
+}
+
+// importer validates the imported ID before reading the resource, so that a malformed
+// ID is reported as such instead of failing in the API calls
+func (t *idTemplate) importer() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if err := t.validate(d.Id()); err != nil {
+				return nil, err
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// get returns the value of the named segment
+func (id compositeID) get(name string) string {
+	for i, s := range id.template.segments {
+		if s.name == name {
+			return id.values[i]
+		}
+	}
+	panic(fmt.Sprintf("unknown segment %s in the ID template %s of %s", name, id.template, id.template.resource))
+}
+
+// String formats the ID
+func (id compositeID) String() string {
+	return id.template.format(id.values...)
+}
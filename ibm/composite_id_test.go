@@ -0,0 +1,131 @@
+package ibm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestIDTemplateParse(t *testing.T) {
+	template := parseIDTemplate("ibm_test_listener", "{lb_id}/{listener_id}", "r006-1/r006-2")
+	id, err := template.parse("r006-1/r006-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if id.get("lb_id") != "r006-1" || id.get("listener_id") != "r006-2" {
+		t.Errorf("unexpected segments %v", id.values)
+	}
+	if id.String() != "r006-1/r006-2" {
+		t.Errorf("expected the ID to be formatted back, got %s", id)
+	}
+
+	cases := []struct {
+		id      string
+		message string
+	}{
+		{"r006-1", "the listener_id segment is missing"},
+		{"r006-1/", "the listener_id segment is empty"},
+		{"/r006-2", "the lb_id segment is empty"},
+		{"r006-1/r006-2/r006-3", "the ID has 3 segments instead of 2"},
+	}
+	for _, c := range cases {
+		err := template.validate(c.id)
+		if err == nil {
+			t.Errorf("expected an error for %q", c.id)
+			continue
+		}
+		if !strings.Contains(err.Error(), c.message) {
+			t.Errorf("expected %q in the error of %q, got %q", c.message, c.id, err)
+		}
+		if !strings.Contains(err.Error(), "expected <lb_id>/<listener_id>, e.g. r006-1/r006-2") {
+			t.Errorf("expected the format and the example in the error of %q, got %q", c.id, err)
+		}
+	}
+}
+
+func TestIDTemplateTypedSegments(t *testing.T) {
+	template := parseIDTemplate("ibm_test_rule", "{rule_id:int}:{zone_id}:{cis_id:crn}", "1:zone:"+cisIDExampleCRN)
+	id, err := template.parse("100:zone:" + cisIDExampleCRN)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if id.get("cis_id") != cisIDExampleCRN {
+		t.Errorf("expected the CRN to keep its colons, got %s", id.get("cis_id"))
+	}
+	if err := template.validate("abc:zone:" + cisIDExampleCRN); err == nil || !strings.Contains(err.Error(), `the rule_id segment "abc" is not a number`) {
+		t.Errorf("expected an error for the rule_id segment, got %v", err)
+	}
+	if err := template.validate("100:zone:31fa970d"); err == nil || !strings.Contains(err.Error(), `the cis_id segment "31fa970d" is not a CRN`) {
+		t.Errorf("expected an error for the cis_id segment, got %v", err)
+	}
+}
+
+func TestIDTemplateOptionalSegments(t *testing.T) {
+	template := parseIDTemplate("ibm_test_cert", "{cluster_id}/{secret_name}/{namespace?}", "cluster/secret")
+	if template.String() != "<cluster_id>/<secret_name>[/<namespace>]" {
+		t.Errorf("unexpected format %s", template)
+	}
+	parts, err := template.split("cluster/secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(parts) != 3 || parts[2] != "" {
+		t.Errorf("expected the missing optional segment to be empty, got %v", parts)
+	}
+	if id := template.format("cluster", "secret", ""); id != "cluster/secret" {
+		t.Errorf("expected the empty optional segment to be omitted, got %s", id)
+	}
+	if id := template.format("cluster", "secret", "default"); id != "cluster/secret/default" {
+		t.Errorf("unexpected ID %s", id)
+	}
+	if err := template.validate("cluster/secret/default/x"); err == nil || !strings.Contains(err.Error(), "instead of at most 3") {
+		t.Errorf("expected an error for the extra segment, got %v", err)
+	}
+}
+
+func TestIDTemplateInvalid(t *testing.T) {
+	for _, template := range []string{
+		"",
+		"lb/{listener_id}",
+		"{lb_id}{listener_id}",
+		"{lb_id}/{listener_id}:{rule_id}",
+		"{cis_id:crn}:{zone_id}",
+		"{lb_id:uuid}",
+		"{lb_id?}/{listener_id}",
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected the template %q to be rejected", template)
+				}
+			}()
+			parseIDTemplate("ibm_test", template, "")
+		}()
+	}
+}
+
+func TestIDTemplatesExamples(t *testing.T) {
+	resources := Provider().(*schema.Provider).ResourcesMap
+	for name, template := range idTemplates {
+		if _, ok := resources[name]; !ok {
+			t.Errorf("the ID template %s is not the one of a resource", name)
+		}
+		if err := template.validate(template.example); err != nil {
+			t.Errorf("the example of the ID template of %s is invalid: %s", name, err)
+		}
+	}
+}
+
+func TestIDTemplateImporter(t *testing.T) {
+	d := resourceIBMISLBListener().TestResourceData()
+	d.SetId("r006-1")
+	_, err := isLBListenerIDTemplate.importer().State(d, nil)
+	if err == nil || !strings.Contains(err.Error(), "Invalid ID \"r006-1\" for ibm_is_lb_listener: the listener_id segment is missing") {
+		t.Errorf("expected a descriptive error, got %v", err)
+	}
+	d.SetId("r006-1/r006-2")
+	if _, err := isLBListenerIDTemplate.importer().State(d, nil); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"log"
 	gohttp "net/http"
+	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	// Added code for the Power Colo Offering
@@ -42,11 +44,14 @@ import (
 	cisratelimitv1 "github.com/IBM/networking-go-sdk/zoneratelimitsv1"
 	cisdomainsettingsv1 "github.com/IBM/networking-go-sdk/zonessettingsv1"
 	ciszonesv1 "github.com/IBM/networking-go-sdk/zonesv1"
+	searchv2 "github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	iamidentity "github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	vpcclassic "github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/apache/openwhisk-client-go/whisk"
 	jwt "github.com/dgrijalva/jwt-go"
+	httptransport "github.com/go-openapi/runtime/client"
 	slsession "github.com/softlayer/softlayer-go/session"
 	ns "github.ibm.com/ibmcloud/namespace-go-sdk/ibmcloudfunctionsnamespaceapiv1"
 
@@ -83,7 +88,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 )
 
-// RetryAPIDelay - retry api delay
+// RetryAPIDelay - initial delay of the exponential backoff between retries
 const RetryAPIDelay = 5 * time.Second
 
 //BluemixRegion ...
@@ -91,7 +96,7 @@ var BluemixRegion string
 
 var (
 	errEmptySoftLayerCredentials = errors.New("iaas_classic_username and iaas_classic_api_key must be provided. Please see the documentation on how to configure them")
-	errEmptyBluemixCredentials   = errors.New("ibmcloud_api_key or bluemix_api_key or iam_token and iam_refresh_token must be provided. Please see the documentation on how to configure it")
+	errEmptyBluemixCredentials   = errors.New("ibmcloud_api_key or bluemix_api_key or iam_token and iam_refresh_token or iam_profile_id or iam_profile_name must be provided. Please see the documentation on how to configure it")
 )
 
 //UserConfig ...
@@ -132,8 +137,10 @@ type Config struct {
 	//When sdk implements it we an expose them for expected behaviour
 	//https://github.com/softlayer/softlayer-go/issues/41
 	RetryCount int
-	//Constant Retry Delay for API calls
+	//Initial Retry Delay for API calls, doubled on each retry up to RetryMaxWait
 	RetryDelay time.Duration
+	//Maximum Retry Delay for API calls
+	RetryMaxWait time.Duration
 
 	// FunctionNameSpace ...
 	FunctionNameSpace string
@@ -150,11 +157,39 @@ type Config struct {
 	//IAM Refresh Token
 	IAMRefreshToken string
 
+	// IAMProfileID and IAMProfileName identify the trusted profile to authenticate with
+	IAMProfileID   string
+	IAMProfileName string
+
+	// IAMCRTokenFile is the path of the compute resource token exchanged for the trusted profile token
+	IAMCRTokenFile string
+
 	// PowerService Instance
 	PowerServiceInstance string
 
 	// Zone
 	Zone string
+
+	// Visibility of the service endpoints: public, private or public-and-private
+	Visibility string
+
+	// EndpointsFile is the path of a JSON or YAML file overriding service endpoints
+	EndpointsFile string
+	endpoints     endpointsFile
+
+	// RateLimits overrides the requests per second allowed for a service
+	RateLimits   map[string]float64
+	rateLimiters map[string]*rateLimiter
+
+	// DefaultTags are attached to every resource supporting tags
+	DefaultTags []string
+
+	// recorder records or replays the HTTP interactions of the tests, see loadRecorder
+	recorder *httpRecorder
+
+	// AuditLogPath is the path of the JSONL file logging every mutating call
+	AuditLogPath string
+	auditLog     *auditLog
 }
 
 //Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -164,6 +199,12 @@ type Session struct {
 
 	// BluemixSession is the the Bluemix session used to connect to the Bluemix API
 	BluemixSession *bxsession.Session
+
+	// tokens holds the IAM access token shared by all the clients of the session
+	tokens *iamTokenManager
+
+	// recorder is nil unless the HTTP interactions are recorded or replayed
+	recorder *httpRecorder
 }
 
 // ClientSession ...
@@ -231,414 +272,1189 @@ type ClientSession interface {
 	CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error)
 	CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error)
 	IAMIdentityV1API() (*iamidentity.IamIdentityV1, error)
+	GlobalTaggingAPIv1() (*globaltaggingv1.GlobalTaggingV1, error)
+	GlobalSearchAPIv2() (*searchv2.GlobalSearchV2, error)
+	DefaultTags() []string
 }
 
 type clientSession struct {
+	config  *Config
 	session *Session
 
-	apigatewayErr error
-	apigatewayAPI *apigateway.ApiGatewayControllerApiV1
+	// bluemixOnce authenticates the bluemix session the first time a client needs it
+	bluemixOnce       sync.Once
+	bluemixSessionErr error
+	bmxUserDetails    *UserConfig
+	bmxUserFetchErr   error
 
+	accountOnce          sync.Once
 	accountConfigErr     error
 	bmxAccountServiceAPI accountv2.AccountServiceAPI
 
+	accountV1Once          sync.Once
 	accountV1ConfigErr     error
 	bmxAccountv1ServiceAPI accountv1.AccountServiceAPI
 
-	bmxUserDetails  *UserConfig
-	bmxUserFetchErr error
-
+	csOnce       sync.Once
 	csConfigErr  error
 	csServiceAPI containerv1.ContainerServiceAPI
 
+	csv2Once       sync.Once
 	csv2ConfigErr  error
 	csv2ServiceAPI containerv2.ContainerServiceAPI
 
+	crv1Once       sync.Once
 	crv1ConfigErr  error
 	crv1ServiceAPI registryv1.RegistryServiceAPI
 
+	stxOnce       sync.Once
 	stxConfigErr  error
 	stxServiceAPI schematics.SchematicsServiceAPI
 
-	certManagementErr error
-	certManagementAPI certificatemanager.CertificateManagerServiceAPI
-
-	cfConfigErr  error
-	cfServiceAPI mccpv2.MccpServiceAPI
-
+	cisOnce       sync.Once
 	cisConfigErr  error
 	cisServiceAPI cisv1.CisServiceAPI
 
-	functionConfigErr error
-	functionClient    *whisk.Client
-
+	globalSearchOnce       sync.Once
 	globalSearchConfigErr  error
 	globalSearchServiceAPI globalsearchv2.GlobalSearchServiceAPI
 
+	globalTaggingOnce       sync.Once
 	globalTaggingConfigErr  error
 	globalTaggingServiceAPI globaltaggingv3.GlobalTaggingServiceAPI
 
+	hpcsEndpointOnce sync.Once
+	hpcsEndpointErr  error
+	hpcsEndpointAPI  hpcs.HPCSV2
+
+	iamOnce       sync.Once
+	iamConfigErr  error
+	iamServiceAPI iamv1.IAMServiceAPI
+
+	userManagementOnce sync.Once
+	userManagementErr  error
+	userManagementAPI  usermanagementv2.UserManagementAPI
+
+	iamPAPOnce       sync.Once
 	iamPAPConfigErr  error
 	iamPAPServiceAPI iampapv1.IAMPAPAPI
 
+	iamPAPOncev2       sync.Once
 	iamPAPConfigErrv2  error
 	iamPAPServiceAPIv2 iampapv2.IAMPAPAPIV2
 
+	iamUUMOnce       sync.Once
 	iamUUMConfigErr  error
 	iamUUMServiceAPI iamuumv1.IAMUUMServiceAPI
 
+	iamUUMOnceV2       sync.Once
 	iamUUMConfigErrV2  error
 	iamUUMServiceAPIV2 iamuumv2.IAMUUMServiceAPIv2
 
-	iamConfigErr  error
-	iamServiceAPI iamv1.IAMServiceAPI
-
-	userManagementErr error
-	userManagementAPI usermanagementv2.UserManagementAPI
-
+	icdOnce       sync.Once
 	icdConfigErr  error
 	icdServiceAPI icdv4.ICDServiceAPI
 
-	resourceControllerConfigErr  error
-	resourceControllerServiceAPI controller.ResourceControllerAPI
+	cfOnce       sync.Once
+	cfConfigErr  error
+	cfServiceAPI mccpv2.MccpServiceAPI
 
-	resourceControllerConfigErrv2  error
-	resourceControllerServiceAPIv2 controllerv2.ResourceControllerAPIV2
+	resourceCatalogOnce       sync.Once
+	resourceCatalogConfigErr  error
+	resourceCatalogServiceAPI catalog.ResourceCatalogAPI
 
+	resourceManagementOnce       sync.Once
 	resourceManagementConfigErr  error
 	resourceManagementServiceAPI management.ResourceManagementAPI
 
+	resourceManagementOncev2       sync.Once
 	resourceManagementConfigErrv2  error
 	resourceManagementServiceAPIv2 managementv2.ResourceManagementAPIv2
 
-	resourceCatalogConfigErr  error
-	resourceCatalogServiceAPI catalog.ResourceCatalogAPI
+	resourceControllerOnce       sync.Once
+	resourceControllerConfigErr  error
+	resourceControllerServiceAPI controller.ResourceControllerAPI
 
+	resourceControllerOncev2       sync.Once
+	resourceControllerConfigErrv2  error
+	resourceControllerServiceAPIv2 controllerv2.ResourceControllerAPIV2
+
+	certManagementOnce sync.Once
+	certManagementErr  error
+	certManagementAPI  certificatemanager.CertificateManagerServiceAPI
+
+	functionOnce      sync.Once
+	functionConfigErr error
+	functionClient    *whisk.Client
+
+	powerOnce      sync.Once
 	powerConfigErr error
-	ibmpiConfigErr error
 	ibmpiSession   *ibmpisession.IBMPISession
 
-	kpErr error
-	kpAPI *kp.API
+	kpOnce sync.Once
+	kpErr  error
+	kpAPI  *kp.API
 
-	kmsErr error
-	kmsAPI *kp.API
+	kmsOnce sync.Once
+	kmsErr  error
+	kmsAPI  *kp.API
 
-	hpcsEndpointErr error
-	hpcsEndpointAPI hpcs.HPCSV2
+	apigatewayOnce sync.Once
+	apigatewayErr  error
+	apigatewayAPI  *apigateway.ApiGatewayControllerApiV1
 
-	pDNSClient *dns.DnsSvcsV1
-	pDNSErr    error
+	vpcClassicOnce sync.Once
+	vpcClassicErr  error
+	vpcClassicAPI  *vpcclassic.VpcClassicV1
 
-	bluemixSessionErr error
+	vpcOnce sync.Once
+	vpcErr  error
+	vpcAPI  *vpc.VpcV1
+
+	directlinkOnce sync.Once
+	directlinkErr  error
+	directlinkAPI  *dl.DirectLinkV1
+
+	dlProviderOnce sync.Once
+	dlProviderErr  error
+	dlProviderAPI  *dlProviderV2.DirectLinkProviderV2
 
-	vpcClassicErr error
-	vpcClassicAPI *vpcclassic.VpcClassicV1
+	cosConfigOnce sync.Once
+	cosConfigErr  error
+	cosConfigAPI  *cosconfig.ResourceConfigurationV1
 
-	vpcErr error
-	vpcAPI *vpc.VpcV1
+	transitgatewayOnce sync.Once
+	transitgatewayErr  error
+	transitgatewayAPI  *tg.TransitGatewayApisV1
 
-	directlinkAPI *dl.DirectLinkV1
-	directlinkErr error
-	dlProviderAPI *dlProviderV2.DirectLinkProviderV2
-	dlProviderErr error
+	pDNSOnce   sync.Once
+	pDNSErr    error
+	pDNSClient *dns.DnsSvcsV1
 
-	cosConfigErr error
-	cosConfigAPI *cosconfig.ResourceConfigurationV1
+	iamNamespaceOnce sync.Once
+	iamNamespaceErr  error
+	iamNamespaceAPI  *ns.IbmCloudFunctionsNamespaceAPIV1
 
-	transitgatewayAPI *tg.TransitGatewayApisV1
-	transitgatewayErr error
+	iamIdentityOnce sync.Once
+	iamIdentityErr  error
+	iamIdentityAPI  *iamidentity.IamIdentityV1
 
-	iamNamespaceAPI *ns.IbmCloudFunctionsNamespaceAPIV1
-	iamNamespaceErr error
+	globalTaggingV1Once sync.Once
+	globalTaggingV1Err  error
+	globalTaggingAPIv1  *globaltaggingv1.GlobalTaggingV1
+
+	globalSearchV2Once sync.Once
+	globalSearchV2Err  error
+	globalSearchAPIv2  *searchv2.GlobalSearchV2
 
 	// CIS Zones
+	cisZonesOnce     sync.Once
 	cisZonesErr      error
 	cisZonesV1Client *ciszonesv1.ZonesV1
 
 	// CIS dns service options
+	cisDNSOnce          sync.Once
 	cisDNSErr           error
 	cisDNSRecordsClient *cisdnsrecordsv1.DnsRecordsV1
 
 	// CIS dns bulk service options
+	cisDNSBulkOnce         sync.Once
 	cisDNSBulkErr          error
 	cisDNSRecordBulkClient *cisdnsbulkv1.DnsRecordBulkV1
 
 	// CIS Global Load Balancer Pool service options
+	cisGLBPoolOnce   sync.Once
 	cisGLBPoolErr    error
 	cisGLBPoolClient *cisglbpoolv0.GlobalLoadBalancerPoolsV0
 
 	// CIS GLB service options
+	cisGLBOnce   sync.Once
 	cisGLBErr    error
 	cisGLBClient *cisglbv1.GlobalLoadBalancerV1
 
 	// CIS GLB health check service options
+	cisGLBHealthCheckOnce   sync.Once
 	cisGLBHealthCheckErr    error
 	cisGLBHealthCheckClient *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
 
 	// CIS IP service options
+	cisIPOnce   sync.Once
 	cisIPErr    error
 	cisIPClient *cisipv1.CisIpApiV1
 
 	// CIS Zone Rate Limits service options
+	cisRLOnce   sync.Once
 	cisRLErr    error
 	cisRLClient *cisratelimitv1.ZoneRateLimitsV1
 
 	// CIS Page Rules service options
+	cisPageRuleOnce   sync.Once
 	cisPageRuleErr    error
 	cisPageRuleClient *cispagerulev1.PageRuleApiV1
 
 	// CIS Edge Functions service options
+	cisEdgeFunctionOnce   sync.Once
 	cisEdgeFunctionErr    error
 	cisEdgeFunctionClient *cisedgefunctionv1.EdgeFunctionsApiV1
 
 	// CIS SSL certificate service options
+	cisSSLOnce   sync.Once
 	cisSSLErr    error
 	cisSSLClient *cissslv1.SslCertificateApiV1
 
 	// CIS WAF Package service options
+	cisWAFPackageOnce   sync.Once
 	cisWAFPackageErr    error
 	cisWAFPackageClient *ciswafpackagev1.WafRulePackagesApiV1
 
 	// CIS Zone Setting service options
+	cisDomainSettingsOnce   sync.Once
 	cisDomainSettingsErr    error
 	cisDomainSettingsClient *cisdomainsettingsv1.ZonesSettingsV1
 
 	// CIS Routing service options
+	cisRoutingOnce   sync.Once
 	cisRoutingErr    error
 	cisRoutingClient *cisroutingv1.RoutingV1
 
 	// CIS WAF Group service options
+	cisWAFGroupOnce   sync.Once
 	cisWAFGroupErr    error
 	cisWAFGroupClient *ciswafgroupv1.WafRuleGroupsApiV1
 
 	// CIS Caching service options
+	cisCacheOnce   sync.Once
 	cisCacheErr    error
 	cisCacheClient *ciscachev1.CachingApiV1
 
 	// CIS Custom Pages service options
+	cisCustomPageOnce   sync.Once
 	cisCustomPageErr    error
 	cisCustomPageClient *ciscustompagev1.CustomPagesV1
 
 	// CIS Firewall Access rule service option
+	cisAccessRuleOnce   sync.Once
 	cisAccessRuleErr    error
 	cisAccessRuleClient *cisaccessrulev1.ZoneFirewallAccessRulesV1
 
 	// CIS User Agent Blocking Rule service option
+	cisUARuleOnce   sync.Once
 	cisUARuleErr    error
 	cisUARuleClient *cisuarulev1.UserAgentBlockingRulesV1
 
 	// CIS Firewall Lockdwon Rule service option
+	cisLockdownOnce   sync.Once
 	cisLockdownErr    error
 	cisLockdownClient *cislockdownv1.ZoneLockdownV1
 
 	// CIS Range app service option
+	cisRangeAppOnce   sync.Once
 	cisRangeAppErr    error
 	cisRangeAppClient *cisrangeappv1.RangeApplicationsV1
 
 	// CIS WAF rule service options
+	cisWAFRuleOnce   sync.Once
 	cisWAFRuleErr    error
 	cisWAFRuleClient *ciswafrulev1.WafRulesApiV1
-	//IAM Identity Option
-	iamIdentityErr error
-	iamIdentityAPI *iamidentity.IamIdentityV1
 }
 
-// BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
-	return sess.bmxAccountServiceAPI, sess.accountConfigErr
+// BluemixSession to provide the Bluemix Session, it is authenticated the first time
+// a client of an IBM Cloud service is requested
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
+	sess.bluemixOnce.Do(sess.authenticate)
+	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
-// BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
-	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
+// BluemixUserDetails ...
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
+	sess.bluemixOnce.Do(sess.authenticate)
+	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
-// BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
-	return sess.session.BluemixSession, sess.bluemixSessionErr
+// DefaultTags returns the tags attached to every resource supporting tags
+func (sess *clientSession) DefaultTags() []string {
+	return sess.config.DefaultTags
 }
 
-// BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
-	return sess.bmxUserDetails, sess.bmxUserFetchErr
+// SoftLayerSession providers SoftLayer Session
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
+	if sess.session.SoftLayerSession.IAMToken != "" {
+		// The SoftLayer session uses the IAM token refreshed by the bluemix session
+		sess.bluemixOnce.Do(sess.authenticate)
+	}
+	return sess.session.SoftLayerSession
+}
+
+// authenticate fetches the IAM token of the bluemix session and the user details.
+// Without IBM Cloud credentials every client of an IBM Cloud service fails with
+// errEmptyBluemixCredentials.
+func (sess *clientSession) authenticate() {
+	bmxSession := sess.session.BluemixSession
+	if bmxSession == nil {
+		//Can be nil only  if bluemix_api_key is not provided
+		log.Println("Skipping Bluemix Clients configuration")
+		sess.bluemixSessionErr = errEmptyBluemixCredentials
+		sess.bmxUserFetchErr = errEmptyBluemixCredentials
+		return
+	}
+
+	if bmxSession.Config.BluemixAPIKey != "" {
+		err := authenticateAPIKey(bmxSession)
+		if err != nil {
+			sess.bluemixSessionErr = fmt.Errorf("Error occured while authenticating with the IBM Cloud API key: %q", err)
+			sess.bmxUserFetchErr = fmt.Errorf("Error occured while fetching account user details: %q", err)
+			return
+		}
+		err = authenticateCF(bmxSession)
+		if err != nil {
+			log.Printf("[WARN] Error occured while fetching auth key for function: %q", err)
+		}
+		sess.session.tokens.set(bmxSession.Config.IAMAccessToken, time.Time{}, apiKeyTokenRefresher(bmxSession.Config, sess.iamTokenClient(bmxSession.Config)))
+	}
+
+	if bmxSession.Config.IAMAccessToken != "" && bmxSession.Config.BluemixAPIKey == "" && !sess.config.usesTrustedProfile() {
+		err := refreshToken(bmxSession)
+		if err != nil {
+			sess.bluemixSessionErr = err
+			sess.bmxUserFetchErr = err
+			return
+		}
+		sess.session.tokens.set(bmxSession.Config.IAMAccessToken, time.Time{}, refreshTokenRefresher(bmxSession.Config, sess.iamTokenClient(bmxSession.Config)))
+	}
+
+	sess.bmxUserDetails, sess.bmxUserFetchErr = fetchUserDetails(bmxSession, sess.config.Generation)
+	if sess.bmxUserFetchErr != nil {
+		sess.bmxUserFetchErr = fmt.Errorf("Error occured while fetching account user details: %q", sess.bmxUserFetchErr)
+	}
+
+	if sess.session.SoftLayerSession != nil && sess.session.SoftLayerSession.IAMToken != "" && !sess.config.usesTrustedProfile() {
+		sess.session.SoftLayerSession.IAMToken = bmxSession.Config.IAMAccessToken
+		sess.session.SoftLayerSession.IAMRefreshToken = bmxSession.Config.IAMRefreshToken
+	}
+}
+
+// bluemixServiceSession returns a copy of the authenticated bluemix session whose
+// HTTP client is rate limited for the service
+func (sess *clientSession) bluemixServiceSession(service string) (*bxsession.Session, error) {
+	bmxSession, err := sess.BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	bmxSession = bmxSession.Copy()
+	httpClient := http.NewHTTPClient(bmxSession.Config)
+	httpClient.Transport = sess.session.transport(sess.config.serviceTransport(service, httpClient.Transport))
+	bmxSession.Config.HTTPClient = httpClient
+	return bmxSession, nil
+}
+
+// platformConfig returns the shared token manager and the endpoint of the Platform SDK
+// clients of the service
+func (sess *clientSession) platformConfig(service string) (core.Authenticator, string, error) {
+	_, err := sess.BluemixSession()
+	if err != nil {
+		return nil, "", err
+	}
+	url, err := sess.config.endpointFor(service)
+	if err != nil {
+		return nil, "", err
+	}
+	return sess.session.tokens, url, nil
+}
+
+// httpClient returns a rate limited and retrying HTTP client for the Platform SDK
+// clients of the service
+func (sess *clientSession) httpClient(service string) *gohttp.Client {
+	httpClient := core.DefaultHTTPClient()
+	httpClient.Transport = sess.session.transport(sess.config.serviceTransport(service, httpClient.Transport))
+	return httpClient
+}
+
+// s3HTTPClient returns the HTTP client of the COS s3 clients, nil without a client
+// session. The requests are recorded, audited and logged like those of the other
+// clients, the s3 clients retry them themselves.
+func s3HTTPClient(meta interface{}) *gohttp.Client {
+	sess, operation := auditSessionOf(meta)
+	if sess == nil {
+		return nil
+	}
+	return &gohttp.Client{
+		Transport: operation.transport(sess.session.transport(sess.config.auditLog.transport(cosService, logTransport(cosService, DefaultTransport())))),
+	}
+}
+
+// iamTokenClient returns the HTTP client of the token refreshers. The token requests
+// are recorded and logged, but not authenticated by the token manager.
+func (sess *clientSession) iamTokenClient(config *bluemix.Config) *gohttp.Client {
+	httpClient := http.NewHTTPClient(config)
+	httpClient.Transport = sess.session.recorder.transport(sess.config.serviceTransport(iamService, httpClient.Transport))
+	return httpClient
+}
+
+// BluemixAcccountAPI ...
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.accountOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(accountService)
+		if err != nil {
+			sess.accountConfigErr = err
+			return
+		}
+		sess.bmxAccountServiceAPI, err = accountv2.New(bmxSession)
+		if err != nil {
+			sess.accountConfigErr = fmt.Errorf("Error occured while configuring  Account Service: %q", err)
+		}
+	})
+	return sess.bmxAccountServiceAPI, sess.accountConfigErr
+}
+
+// BluemixAcccountAPI ...
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.accountV1Once.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(accountService)
+		if err != nil {
+			sess.accountV1ConfigErr = err
+			return
+		}
+		sess.bmxAccountv1ServiceAPI, err = accountv1.New(bmxSession)
+		if err != nil {
+			sess.accountV1ConfigErr = fmt.Errorf("Error occured while configuring Bluemix Accountv1 Service: %q", err)
+		}
+	})
+	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.csOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(containerService)
+		if err != nil {
+			sess.csConfigErr = err
+			return
+		}
+		sess.csServiceAPI, err = containerv1.New(bmxSession)
+		if err != nil {
+			sess.csConfigErr = fmt.Errorf("Error occured while configuring Container Service for K8s cluster: %q", err)
+		}
+	})
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.csv2Once.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(containerService)
+		if err != nil {
+			sess.csv2ConfigErr = err
+			return
+		}
+		sess.csv2ServiceAPI, err = containerv2.New(bmxSession)
+		if err != nil {
+			sess.csv2ConfigErr = fmt.Errorf("Error occured while configuring vpc Container Service for K8s cluster: %q", err)
+		}
+	})
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryAPI provides v2Container Service APIs ...
-func (sess clientSession) ContainerRegistryAPI() (registryv1.RegistryServiceAPI, error) {
+func (sess *clientSession) ContainerRegistryAPI() (registryv1.RegistryServiceAPI, error) {
+	sess.crv1Once.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(containerRegistryService)
+		if err != nil {
+			sess.crv1ConfigErr = err
+			return
+		}
+		sess.crv1ServiceAPI, err = registryv1.New(bmxSession)
+		if err != nil {
+			sess.crv1ConfigErr = fmt.Errorf("Error occured while configuring Container Registry: %q", err)
+		}
+	})
 	return sess.crv1ServiceAPI, sess.crv1ConfigErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsAPI() (schematics.SchematicsServiceAPI, error) {
+func (sess *clientSession) SchematicsAPI() (schematics.SchematicsServiceAPI, error) {
+	sess.stxOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(schematicsService)
+		if err != nil {
+			sess.stxConfigErr = err
+			return
+		}
+		sess.stxServiceAPI, err = schematics.New(bmxSession)
+		if err != nil {
+			sess.stxConfigErr = fmt.Errorf("Error occured while fetching schematics Configuration: %q", err)
+		}
+	})
 	return sess.stxServiceAPI, sess.stxConfigErr
 }
 
 // CisAPI provides Cloud Internet Services APIs ...
-func (sess clientSession) CisAPI() (cisv1.CisServiceAPI, error) {
+func (sess *clientSession) CisAPI() (cisv1.CisServiceAPI, error) {
+	sess.cisOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(cisService)
+		if err != nil {
+			sess.cisConfigErr = err
+			return
+		}
+		sess.cisServiceAPI, err = cisv1.New(bmxSession)
+		if err != nil {
+			sess.cisConfigErr = fmt.Errorf("Error occured while configuring Cloud Internet Services: %q", err)
+		}
+	})
 	return sess.cisServiceAPI, sess.cisConfigErr
 }
 
-// FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
-	return sess.functionClient, sess.functionConfigErr
-}
-
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.globalSearchOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(globalSearchService)
+		if err != nil {
+			sess.globalSearchConfigErr = err
+			return
+		}
+		sess.globalSearchServiceAPI, err = globalsearchv2.New(bmxSession)
+		if err != nil {
+			sess.globalSearchConfigErr = fmt.Errorf("Error occured while configuring Global Search: %q", err)
+		}
+	})
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.globalTaggingOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(globalTaggingService)
+		if err != nil {
+			sess.globalTaggingConfigErr = err
+			return
+		}
+		sess.globalTaggingServiceAPI, err = globaltaggingv3.New(bmxSession)
+		if err != nil {
+			sess.globalTaggingConfigErr = fmt.Errorf("Error occured while configuring Global Tagging: %q", err)
+		}
+	})
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.hpcsEndpointOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(hpcsService)
+		if err != nil {
+			sess.hpcsEndpointErr = err
+			return
+		}
+		sess.hpcsEndpointAPI, err = hpcs.New(bmxSession)
+		if err != nil {
+			sess.hpcsEndpointErr = fmt.Errorf("Error occured while configuring hpcs Endpoint: %q", err)
+		}
+	})
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // IAMAPI provides IAM PAP APIs ...
-func (sess clientSession) IAMAPI() (iamv1.IAMServiceAPI, error) {
+func (sess *clientSession) IAMAPI() (iamv1.IAMServiceAPI, error) {
+	sess.iamOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(iamService)
+		if err != nil {
+			sess.iamConfigErr = err
+			return
+		}
+		sess.iamServiceAPI, err = iamv1.New(bmxSession)
+		if err != nil {
+			sess.iamConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAM Service: %q", err)
+		}
+	})
 	return sess.iamServiceAPI, sess.iamConfigErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.userManagementOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(userManagementService)
+		if err != nil {
+			sess.userManagementErr = err
+			return
+		}
+		sess.userManagementAPI, err = usermanagementv2.New(bmxSession)
+		if err != nil {
+			sess.userManagementErr = fmt.Errorf("Error occured while configuring user management service: %q", err)
+		}
+	})
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAMPAPAPI provides IAM PAP APIs ...
-func (sess clientSession) IAMPAPAPI() (iampapv1.IAMPAPAPI, error) {
+func (sess *clientSession) IAMPAPAPI() (iampapv1.IAMPAPAPI, error) {
+	sess.iamPAPOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(iamPAPService)
+		if err != nil {
+			sess.iamPAPConfigErr = err
+			return
+		}
+		sess.iamPAPServiceAPI, err = iampapv1.New(bmxSession)
+		if err != nil {
+			sess.iamPAPConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAMPAP Service: %q", err)
+		}
+	})
 	return sess.iamPAPServiceAPI, sess.iamPAPConfigErr
 }
 
 // IAMPAPAPIV2 provides IAM PAP APIs ...
-func (sess clientSession) IAMPAPAPIV2() (iampapv2.IAMPAPAPIV2, error) {
+func (sess *clientSession) IAMPAPAPIV2() (iampapv2.IAMPAPAPIV2, error) {
+	sess.iamPAPOncev2.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(iamPAPService)
+		if err != nil {
+			sess.iamPAPConfigErrv2 = err
+			return
+		}
+		sess.iamPAPServiceAPIv2, err = iampapv2.New(bmxSession)
+		if err != nil {
+			sess.iamPAPConfigErrv2 = fmt.Errorf("Error occured while configuring Bluemix IAMPAP Service: %q", err)
+		}
+	})
 	return sess.iamPAPServiceAPIv2, sess.iamPAPConfigErrv2
 }
 
 // IAMUUMAPI provides IAM UUM APIs ...
-func (sess clientSession) IAMUUMAPI() (iamuumv1.IAMUUMServiceAPI, error) {
+func (sess *clientSession) IAMUUMAPI() (iamuumv1.IAMUUMServiceAPI, error) {
+	sess.iamUUMOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(iamPAPService)
+		if err != nil {
+			sess.iamUUMConfigErr = err
+			return
+		}
+		sess.iamUUMServiceAPI, err = iamuumv1.New(bmxSession)
+		if err != nil {
+			sess.iamUUMConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAMUUM Service: %q", err)
+		}
+	})
 	return sess.iamUUMServiceAPI, sess.iamUUMConfigErr
 }
 
 // IAMUUMAPIV2 provides IAM UUM APIs ...
-func (sess clientSession) IAMUUMAPIV2() (iamuumv2.IAMUUMServiceAPIv2, error) {
+func (sess *clientSession) IAMUUMAPIV2() (iamuumv2.IAMUUMServiceAPIv2, error) {
+	sess.iamUUMOnceV2.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(iamPAPService)
+		if err != nil {
+			sess.iamUUMConfigErrV2 = err
+			return
+		}
+		sess.iamUUMServiceAPIV2, err = iamuumv2.New(bmxSession)
+		if err != nil {
+			sess.iamUUMConfigErrV2 = fmt.Errorf("Error occured while configuring Bluemix IAMUUM Service: %q", err)
+		}
+	})
 	return sess.iamUUMServiceAPIV2, sess.iamUUMConfigErrV2
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.icdOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(icdService)
+		if err != nil {
+			sess.icdConfigErr = err
+			return
+		}
+		sess.icdServiceAPI, err = icdv4.New(bmxSession)
+		if err != nil {
+			sess.icdConfigErr = fmt.Errorf("Error occured while configuring IBM Cloud Database Services: %q", err)
+		}
+	})
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.cfOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(mccpService)
+		if err != nil {
+			sess.cfConfigErr = err
+			return
+		}
+		sess.cfServiceAPI, err = mccpv2.New(bmxSession)
+		if err != nil {
+			sess.cfConfigErr = fmt.Errorf("Error occured while configuring MCCP service: %q", err)
+		}
+	})
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.resourceCatalogOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(resourceCatalogService)
+		if err != nil {
+			sess.resourceCatalogConfigErr = err
+			return
+		}
+		sess.resourceCatalogServiceAPI, err = catalog.New(bmxSession)
+		if err != nil {
+			sess.resourceCatalogConfigErr = fmt.Errorf("Error occured while configuring Resource Catalog service: %q", err)
+		}
+	})
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPI ...
-func (sess clientSession) ResourceManagementAPI() (management.ResourceManagementAPI, error) {
+func (sess *clientSession) ResourceManagementAPI() (management.ResourceManagementAPI, error) {
+	sess.resourceManagementOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(resourceManagerService)
+		if err != nil {
+			sess.resourceManagementConfigErr = err
+			return
+		}
+		sess.resourceManagementServiceAPI, err = management.New(bmxSession)
+		if err != nil {
+			sess.resourceManagementConfigErr = fmt.Errorf("Error occured while configuring Resource Management service: %q", err)
+		}
+	})
 	return sess.resourceManagementServiceAPI, sess.resourceManagementConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.resourceManagementOncev2.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(resourceManagerService)
+		if err != nil {
+			sess.resourceManagementConfigErrv2 = err
+			return
+		}
+		sess.resourceManagementServiceAPIv2, err = managementv2.New(bmxSession)
+		if err != nil {
+			sess.resourceManagementConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Management service: %q", err)
+		}
+	})
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.resourceControllerOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(resourceControllerService)
+		if err != nil {
+			sess.resourceControllerConfigErr = err
+			return
+		}
+		sess.resourceControllerServiceAPI, err = controller.New(bmxSession)
+		if err != nil {
+			sess.resourceControllerConfigErr = fmt.Errorf("Error occured while configuring Resource Controller service: %q", err)
+		}
+	})
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.resourceControllerOncev2.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(resourceControllerService)
+		if err != nil {
+			sess.resourceControllerConfigErrv2 = err
+			return
+		}
+		sess.resourceControllerServiceAPIv2, err = controllerv2.New(bmxSession)
+		if err != nil {
+			sess.resourceControllerConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Controller v2 service: %q", err)
+		}
+	})
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
-// SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
-	return sess.session.SoftLayerSession
-}
-
 // CertManagementAPI provides Certificate  management APIs ...
-func (sess clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+func (sess *clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+	sess.certManagementOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(certificateManagerService)
+		if err != nil {
+			sess.certManagementErr = err
+			return
+		}
+		sess.certManagementAPI, err = certificatemanager.New(bmxSession)
+		if err != nil {
+			sess.certManagementErr = fmt.Errorf("Error occured while configuring Certificate manager service: %q", err)
+		}
+	})
 	return sess.certManagementAPI, sess.certManagementErr
 }
 
-//apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
-	return sess.apigatewayAPI, sess.apigatewayErr
-}
-
-func (sess clientSession) keyProtectAPI() (*kp.Client, error) {
-	return sess.kpAPI, sess.kpErr
-}
-
-func (sess clientSession) keyManagementAPI() (*kp.Client, error) {
-	return sess.kmsAPI, sess.kmsErr
+// FunctionClient ...
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.functionOnce.Do(func() {
+		bmxSession, err := sess.bluemixServiceSession(functionsService)
+		if err != nil {
+			sess.functionConfigErr = err
+			return
+		}
+		if _, err := sess.config.endpointFor(functionsService); err != nil {
+			sess.functionConfigErr = err
+			return
+		}
+		sess.functionClient, sess.functionConfigErr = FunctionClient(bmxSession.Config)
+	})
+	return sess.functionClient, sess.functionConfigErr
 }
 
-func (sess clientSession) VpcClassicV1API() (*vpcclassic.VpcClassicV1, error) {
-	return sess.vpcClassicAPI, sess.vpcClassicErr
+func (sess *clientSession) keyProtectAPI() (*kp.Client, error) {
+	sess.kpOnce.Do(func() {
+		bmxSession, err := sess.BluemixSession()
+		if err != nil {
+			sess.kpErr = err
+			return
+		}
+		kpurl, err := sess.config.endpointFor(kmsService)
+		if err != nil {
+			sess.kpErr = err
+			return
+		}
+		options := kp.ClientConfig{
+			BaseURL:       kpurl,
+			Authorization: bmxSession.Config.IAMAccessToken,
+			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+			Verbose: kp.VerboseFailOnly,
+		}
+		sess.kpAPI, err = kp.New(options, sess.session.transport(sess.config.serviceTransport(kmsService, kp.DefaultTransport())))
+		if err != nil {
+			sess.kpErr = fmt.Errorf("Error occured while configuring Key Protect Service: %q", err)
+		}
+	})
+	return sess.kpAPI, sess.kpErr
+}
+
+func (sess *clientSession) keyManagementAPI() (*kp.Client, error) {
+	sess.kmsOnce.Do(func() {
+		bmxSession, err := sess.BluemixSession()
+		if err != nil {
+			sess.kmsErr = err
+			return
+		}
+		kpurl, err := sess.config.endpointFor(kmsService)
+		if err != nil {
+			sess.kmsErr = err
+			return
+		}
+		kmsOptions := kp.ClientConfig{
+			BaseURL:       kpurl,
+			Authorization: bmxSession.Config.IAMAccessToken,
+			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+			Verbose: kp.VerboseFailOnly,
+		}
+		sess.kmsAPI, err = kp.New(kmsOptions, sess.session.transport(sess.config.serviceTransport(kmsService, DefaultTransport())))
+		if err != nil {
+			sess.kmsErr = fmt.Errorf("Error occured while configuring key Service: %q", err)
+		}
+	})
+	return sess.kmsAPI, sess.kmsErr
+}
+
+// Session to the Power Colo Service
+
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.powerOnce.Do(func() {
+		sess.ibmpiSession, sess.powerConfigErr = sess.newIBMPISession()
+	})
+	return sess.ibmpiSession, sess.powerConfigErr
+}
+
+func (sess *clientSession) newIBMPISession() (*ibmpisession.IBMPISession, error) {
+	bmxSession, err := sess.BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	c := sess.config
+	ibmpisession, err := ibmpisession.New(bmxSession.Config.IAMAccessToken, c.Region, false, c.BluemixTimeout, sess.bmxUserDetails.userAccount, c.Zone)
+	if err != nil {
+		return nil, err
+	}
+
+	if !powerEndpointOverridden() {
+		powerurl, err := c.endpointFor(powerService)
+		if err != nil {
+			return nil, err
+		}
+		if transport, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+			if u, err := url.Parse(powerurl); err == nil && u.Host != "" {
+				powerTransport := httptransport.New(u.Host, "/", []string{u.Scheme})
+				powerTransport.Consumers = transport.Consumers
+				ibmpisession.Power.SetTransport(powerTransport)
+			}
+		}
+	}
+
+	if transport, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+		transport.Transport = sess.session.transport(c.serviceTransport(powerService, transport.Transport))
+	}
+	return ibmpisession, nil
+}
+
+// apigatewayAPI provides API Gateway APIs
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.apigatewayOnce.Do(func() {
+		_, url, err := sess.platformConfig(apiGatewayService)
+		if err != nil {
+			sess.apigatewayErr = err
+			return
+		}
+		sess.apigatewayAPI, err = apigateway.NewApiGatewayControllerApiV1(&apigateway.ApiGatewayControllerApiV1Options{
+			URL:           url,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		if err != nil {
+			sess.apigatewayErr = fmt.Errorf("Error occured while configuring  APIGateway service: %q", err)
+			return
+		}
+		sess.apigatewayAPI.Service.SetHTTPClient(sess.httpClient(apiGatewayService))
+	})
+	return sess.apigatewayAPI, sess.apigatewayErr
+}
+
+func (sess *clientSession) VpcClassicV1API() (*vpcclassic.VpcClassicV1, error) {
+	sess.vpcClassicOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(vpcClassicService)
+		if err != nil {
+			sess.vpcClassicErr = err
+			return
+		}
+		sess.vpcClassicAPI, err = vpcclassic.NewVpcClassicV1(&vpcclassic.VpcClassicV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			sess.vpcClassicErr = fmt.Errorf("Error occured while configuring vpc classic service: %q", err)
+			return
+		}
+		sess.vpcClassicAPI.Service.SetHTTPClient(sess.httpClient(vpcClassicService))
+	})
+	return sess.vpcClassicAPI, sess.vpcClassicErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.vpcOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(vpcService)
+		if err != nil {
+			sess.vpcErr = err
+			return
+		}
+		sess.vpcAPI, err = vpc.NewVpcV1(&vpc.VpcV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			sess.vpcErr = fmt.Errorf("Error occured while configuring vpc service: %q", err)
+			return
+		}
+		sess.vpcAPI.Service.SetHTTPClient(sess.httpClient(vpcService))
+	})
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.directlinkOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(directlinkService)
+		if err != nil {
+			sess.directlinkErr = err
+			return
+		}
+		sess.directlinkAPI, err = dl.NewDirectLinkV1(&dl.DirectLinkV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		})
+		if err != nil {
+			sess.directlinkErr = fmt.Errorf("Error occured while configuring Direct Link Service: %s", err)
+			return
+		}
+		sess.directlinkAPI.Service.SetHTTPClient(sess.httpClient(directlinkService))
+	})
 	return sess.directlinkAPI, sess.directlinkErr
 }
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.dlProviderOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(directlinkProviderService)
+		if err != nil {
+			sess.dlProviderErr = err
+			return
+		}
+		sess.dlProviderAPI, err = dlProviderV2.NewDirectLinkProviderV2(&dlProviderV2.DirectLinkProviderV2Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		})
+		if err != nil {
+			sess.dlProviderErr = fmt.Errorf("Error occured while configuring Direct Link Provider Service: %s", err)
+			return
+		}
+		sess.dlProviderAPI.Service.SetHTTPClient(sess.httpClient(directlinkProviderService))
+	})
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.cosConfigOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cosConfigService)
+		if err != nil {
+			sess.cosConfigErr = err
+			return
+		}
+		sess.cosConfigAPI, err = cosconfig.NewResourceConfigurationV1(&cosconfig.ResourceConfigurationV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			sess.cosConfigErr = fmt.Errorf("Error occured while configuring COS config service: %q", err)
+			return
+		}
+		sess.cosConfigAPI.Service.SetHTTPClient(sess.httpClient(cosConfigService))
+	})
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.transitgatewayOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(transitGatewayService)
+		if err != nil {
+			sess.transitgatewayErr = err
+			return
+		}
+		sess.transitgatewayAPI, err = tg.NewTransitGatewayApisV1(&tg.TransitGatewayApisV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		})
+		if err != nil {
+			sess.transitgatewayErr = fmt.Errorf("Error occured while configuring Transit Gateway Service: %s", err)
+			return
+		}
+		sess.transitgatewayAPI.Service.SetHTTPClient(sess.httpClient(transitGatewayService))
+	})
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
-// Session to the Power Colo Service
-
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
-	return sess.ibmpiSession, sess.powerConfigErr
-}
-
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.pDNSOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(privateDNSService)
+		if err != nil {
+			sess.pDNSErr = err
+			return
+		}
+		sess.pDNSClient, err = dns.NewDnsSvcsV1(&dns.DnsSvcsV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			sess.pDNSErr = fmt.Errorf("Error occured while configuring PrivateDNS Service: %s", err)
+			return
+		}
+		sess.pDNSClient.Service.SetHTTPClient(sess.httpClient(privateDNSService))
+	})
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) IAMNamespaceAPI() (*ns.IbmCloudFunctionsNamespaceAPIV1, error) {
+func (sess *clientSession) IAMNamespaceAPI() (*ns.IbmCloudFunctionsNamespaceAPIV1, error) {
+	sess.iamNamespaceOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(functionsNamespaceService)
+		if err != nil {
+			sess.iamNamespaceErr = err
+			return
+		}
+		sess.iamNamespaceAPI, err = ns.NewIbmCloudFunctionsNamespaceAPIV1(&ns.IbmCloudFunctionsNamespaceOptions{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			sess.iamNamespaceErr = fmt.Errorf("Error occured while configuring IAM namespace service: %q", err)
+			return
+		}
+		sess.iamNamespaceAPI.Service.SetHTTPClient(sess.httpClient(functionsNamespaceService))
+	})
 	return sess.iamNamespaceAPI, sess.iamNamespaceErr
 }
 
+// IAM Identity Session
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.iamIdentityOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(iamService)
+		if err != nil {
+			sess.iamIdentityErr = err
+			return
+		}
+		sess.iamIdentityAPI, err = iamidentity.NewIamIdentityV1(&iamidentity.IamIdentityV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			sess.iamIdentityErr = fmt.Errorf("Error occured while configuring IAM Identity service: %q", err)
+			return
+		}
+		sess.iamIdentityAPI.Service.SetHTTPClient(sess.httpClient(iamService))
+	})
+	return sess.iamIdentityAPI, sess.iamIdentityErr
+}
+
+// GlobalTaggingAPIv1 returns the Platform SDK client of Global Tagging
+func (sess *clientSession) GlobalTaggingAPIv1() (*globaltaggingv1.GlobalTaggingV1, error) {
+	sess.globalTaggingV1Once.Do(func() {
+		authenticator, url, err := sess.platformConfig(globalTaggingService)
+		if err != nil {
+			sess.globalTaggingV1Err = err
+			return
+		}
+		sess.globalTaggingAPIv1, err = globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			sess.globalTaggingV1Err = fmt.Errorf("Error occured while configuring Global Tagging service: %q", err)
+			return
+		}
+		sess.globalTaggingAPIv1.Service.SetHTTPClient(sess.httpClient(globalTaggingService))
+	})
+	return sess.globalTaggingAPIv1, sess.globalTaggingV1Err
+}
+
+// GlobalSearchAPIv2 provides the Global Search API of the platform SDK, which returns the
+// requested fields of the resources with a limit and a cursor. The results of
+// GlobalSearchAPI only have the name, CRN, service and tags of the resources.
+func (sess *clientSession) GlobalSearchAPIv2() (*searchv2.GlobalSearchV2, error) {
+	sess.globalSearchV2Once.Do(func() {
+		authenticator, url, err := sess.platformConfig(globalSearchService)
+		if err != nil {
+			sess.globalSearchV2Err = err
+			return
+		}
+		sess.globalSearchAPIv2, err = searchv2.NewGlobalSearchV2(&searchv2.GlobalSearchV2Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			sess.globalSearchV2Err = fmt.Errorf("Error occured while configuring Global Search service: %q", err)
+			return
+		}
+		sess.globalSearchAPIv2.Service.SetHTTPClient(sess.httpClient(globalSearchService))
+	})
+	return sess.globalSearchAPIv2, sess.globalSearchV2Err
+}
+
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.cisZonesOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisZonesErr = err
+			return
+		}
+		sess.cisZonesV1Client, err = ciszonesv1.NewZonesV1(&ciszonesv1.ZonesV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisZonesErr = fmt.Errorf("Error occured while configuring CIS Zones service: %s", err)
+			return
+		}
+		sess.cisZonesV1Client.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -646,7 +1462,25 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.cisDNSOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisDNSErr = err
+			return
+		}
+		sess.cisDNSRecordsClient, err = cisdnsrecordsv1.NewDnsRecordsV1(&cisdnsrecordsv1.DnsRecordsV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisDNSErr = fmt.Errorf("Error occured while configuring CIS DNS Service: %s", err)
+			return
+		}
+		sess.cisDNSRecordsClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -654,7 +1488,25 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.cisDNSBulkOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisDNSBulkErr = err
+			return
+		}
+		sess.cisDNSRecordBulkClient, err = cisdnsbulkv1.NewDnsRecordBulkV1(&cisdnsbulkv1.DnsRecordBulkV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisDNSBulkErr = fmt.Errorf("Error occured while configuration CIS DNS bulk service : %s", err)
+			return
+		}
+		sess.cisDNSRecordBulkClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -662,7 +1514,24 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.cisGLBPoolOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisGLBPoolErr = err
+			return
+		}
+		sess.cisGLBPoolClient, err = cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(&cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisGLBPoolErr = fmt.Errorf("Error occured while configuring CIS GLB Pool service: %s", err)
+			return
+		}
+		sess.cisGLBPoolClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -670,7 +1539,25 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.cisGLBOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisGLBErr = err
+			return
+		}
+		sess.cisGLBClient, err = cisglbv1.NewGlobalLoadBalancerV1(&cisglbv1.GlobalLoadBalancerV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisGLBErr = fmt.Errorf("Error occured while configuring CIS GLB service: %s", err)
+			return
+		}
+		sess.cisGLBClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -678,31 +1565,100 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.cisGLBHealthCheckOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisGLBHealthCheckErr = err
+			return
+		}
+		sess.cisGLBHealthCheckClient, err = cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(&cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisGLBHealthCheckErr = fmt.Errorf("Error occured while configuring CIS GLB Health Check service: %s", err)
+			return
+		}
+		sess.cisGLBHealthCheckClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
 	return sess.cisGLBHealthCheckClient.Clone(), nil
 }
 
-// CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
-	if sess.cisRLErr != nil {
-		return sess.cisRLClient, sess.cisRLErr
-	}
-	return sess.cisRLClient.Clone(), nil
-}
-
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.cisIPOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisIPErr = err
+			return
+		}
+		sess.cisIPClient, err = cisipv1.NewCisIpApiV1(&cisipv1.CisIpApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			sess.cisIPErr = fmt.Errorf("Error occured while configuring CIS IP service: %s", err)
+			return
+		}
+		sess.cisIPClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
 	return sess.cisIPClient.Clone(), nil
 }
 
+// CIS Zone Rate Limits
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.cisRLOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisRLErr = err
+			return
+		}
+		sess.cisRLClient, err = cisratelimitv1.NewZoneRateLimitsV1(&cisratelimitv1.ZoneRateLimitsV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisRLErr = fmt.Errorf("Error occured while cofiguring CIS Zone Rate Limit service: %s", err)
+			return
+		}
+		sess.cisRLClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
+	if sess.cisRLErr != nil {
+		return sess.cisRLClient, sess.cisRLErr
+	}
+	return sess.cisRLClient.Clone(), nil
+}
+
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.cisPageRuleOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisPageRuleErr = err
+			return
+		}
+		sess.cisPageRuleClient, err = cispagerulev1.NewPageRuleApiV1(&cispagerulev1.PageRuleApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisPageRuleErr = fmt.Errorf("Error occured while cofiguring CIS Page Rule service: %s", err)
+			return
+		}
+		sess.cisPageRuleClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -710,7 +1666,25 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.cisEdgeFunctionOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisEdgeFunctionErr = err
+			return
+		}
+		sess.cisEdgeFunctionClient, err = cisedgefunctionv1.NewEdgeFunctionsApiV1(&cisedgefunctionv1.EdgeFunctionsApiV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisEdgeFunctionErr = fmt.Errorf("Error occured while configuring CIS Edge Function service: %s", err)
+			return
+		}
+		sess.cisEdgeFunctionClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -718,7 +1692,25 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.cisSSLOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisSSLErr = err
+			return
+		}
+		sess.cisSSLClient, err = cissslv1.NewSslCertificateApiV1(&cissslv1.SslCertificateApiV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisSSLErr = fmt.Errorf("Error occured while configuring CIS SSL certificate service: %s", err)
+			return
+		}
+		sess.cisSSLClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -726,7 +1718,25 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.cisWAFPackageOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisWAFPackageErr = err
+			return
+		}
+		sess.cisWAFPackageClient, err = ciswafpackagev1.NewWafRulePackagesApiV1(&ciswafpackagev1.WafRulePackagesApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisWAFPackageErr = fmt.Errorf("Error occured while configuration CIS WAF Package service: %s", err)
+			return
+		}
+		sess.cisWAFPackageClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -734,7 +1744,25 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.cisDomainSettingsOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisDomainSettingsErr = err
+			return
+		}
+		sess.cisDomainSettingsClient, err = cisdomainsettingsv1.NewZonesSettingsV1(&cisdomainsettingsv1.ZonesSettingsV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisDomainSettingsErr = fmt.Errorf("Error occured while configuring CIS Domain Settings service: %s", err)
+			return
+		}
+		sess.cisDomainSettingsClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -742,7 +1770,25 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.cisRoutingOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisRoutingErr = err
+			return
+		}
+		sess.cisRoutingClient, err = cisroutingv1.NewRoutingV1(&cisroutingv1.RoutingV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisRoutingErr = fmt.Errorf("Error occured while configuring CIS Routing service: %s", err)
+			return
+		}
+		sess.cisRoutingClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -750,7 +1796,25 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.cisWAFGroupOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisWAFGroupErr = err
+			return
+		}
+		sess.cisWAFGroupClient, err = ciswafgroupv1.NewWafRuleGroupsApiV1(&ciswafgroupv1.WafRuleGroupsApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisWAFGroupErr = fmt.Errorf("Error occured while configuring CIS WAF Group service: %s", err)
+			return
+		}
+		sess.cisWAFGroupClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -758,7 +1822,25 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.cisCacheOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisCacheErr = err
+			return
+		}
+		sess.cisCacheClient, err = ciscachev1.NewCachingApiV1(&ciscachev1.CachingApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisCacheErr = fmt.Errorf("Error occured while configuring CIS Caching service: %s", err)
+			return
+		}
+		sess.cisCacheClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -766,7 +1848,25 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.cisCustomPageOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisCustomPageErr = err
+			return
+		}
+		sess.cisCustomPageClient, err = ciscustompagev1.NewCustomPagesV1(&ciscustompagev1.CustomPagesV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisCustomPageErr = fmt.Errorf("Error occured while configuring CIS Custom Pages service: %s", err)
+			return
+		}
+		sess.cisCustomPageClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -774,7 +1874,25 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.cisAccessRuleOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisAccessRuleErr = err
+			return
+		}
+		sess.cisAccessRuleClient, err = cisaccessrulev1.NewZoneFirewallAccessRulesV1(&cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisAccessRuleErr = fmt.Errorf("Error occured while configuring CIS Firewall Access Rule service: %s", err)
+			return
+		}
+		sess.cisAccessRuleClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -782,7 +1900,25 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.cisUARuleOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisUARuleErr = err
+			return
+		}
+		sess.cisUARuleClient, err = cisuarulev1.NewUserAgentBlockingRulesV1(&cisuarulev1.UserAgentBlockingRulesV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisUARuleErr = fmt.Errorf("Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s", err)
+			return
+		}
+		sess.cisUARuleClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -790,7 +1926,25 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.cisLockdownOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisLockdownErr = err
+			return
+		}
+		sess.cisLockdownClient, err = cislockdownv1.NewZoneLockdownV1(&cislockdownv1.ZoneLockdownV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisLockdownErr = fmt.Errorf("Error occured while configuring CIS Firewall Lockdown Rule service: %s", err)
+			return
+		}
+		sess.cisLockdownClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -798,7 +1952,25 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.cisRangeAppOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisRangeAppErr = err
+			return
+		}
+		sess.cisRangeAppClient, err = cisrangeappv1.NewRangeApplicationsV1(&cisrangeappv1.RangeApplicationsV1Options{
+			URL:            url,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisRangeAppErr = fmt.Errorf("Error occured while configuring CIS Range Application rule service: %s", err)
+			return
+		}
+		sess.cisRangeAppClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -806,769 +1978,60 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.cisWAFRuleOnce.Do(func() {
+		authenticator, url, err := sess.platformConfig(cisService)
+		if err != nil {
+			sess.cisWAFRuleErr = err
+			return
+		}
+		sess.cisWAFRuleClient, err = ciswafrulev1.NewWafRulesApiV1(&ciswafrulev1.WafRulesApiV1Options{
+			URL:           url,
+			Authenticator: authenticator,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+		})
+		if err != nil {
+			sess.cisWAFRuleErr = fmt.Errorf("Error occured while configuring CIS WAF Rules service: %s", err)
+			return
+		}
+		sess.cisWAFRuleClient.Service.SetHTTPClient(sess.httpClient(cisService))
+	})
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
 	return sess.cisWAFRuleClient.Clone(), nil
 }
 
-// IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
-	return sess.iamIdentityAPI, sess.iamIdentityErr
-}
-
-// ClientSession configures and returns a fully initialized ClientSession
+// ClientSession configures and returns a ClientSession, the clients are built on
+// first use
 func (c *Config) ClientSession() (interface{}, error) {
-	sess, err := newSession(c)
-	if err != nil {
+	if err := c.loadEndpointsFile(); err != nil {
 		return nil, err
 	}
-	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
-		session: sess,
-	}
-
-	if sess.BluemixSession == nil {
-		//Can be nil only  if bluemix_api_key is not provided
-		log.Println("Skipping Bluemix Clients configuration")
-		session.bluemixSessionErr = errEmptyBluemixCredentials
-		session.accountConfigErr = errEmptyBluemixCredentials
-		session.accountV1ConfigErr = errEmptyBluemixCredentials
-		session.csConfigErr = errEmptyBluemixCredentials
-		session.csv2ConfigErr = errEmptyBluemixCredentials
-		session.crv1ConfigErr = errEmptyBluemixCredentials
-		session.kpErr = errEmptyBluemixCredentials
-		session.kmsErr = errEmptyBluemixCredentials
-		session.stxConfigErr = errEmptyBluemixCredentials
-		session.cfConfigErr = errEmptyBluemixCredentials
-		session.cisConfigErr = errEmptyBluemixCredentials
-		session.functionConfigErr = errEmptyBluemixCredentials
-		session.globalSearchConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErr = errEmptyBluemixCredentials
-		session.hpcsEndpointErr = errEmptyBluemixCredentials
-		session.iamConfigErr = errEmptyBluemixCredentials
-		session.iamPAPConfigErr = errEmptyBluemixCredentials
-		session.iamPAPConfigErrv2 = errEmptyBluemixCredentials
-		session.iamUUMConfigErr = errEmptyBluemixCredentials
-		session.iamUUMConfigErrV2 = errEmptyBluemixCredentials
-		session.icdConfigErr = errEmptyBluemixCredentials
-		session.resourceCatalogConfigErr = errEmptyBluemixCredentials
-		session.resourceManagementConfigErr = errEmptyBluemixCredentials
-		session.resourceManagementConfigErrv2 = errEmptyBluemixCredentials
-		session.resourceControllerConfigErr = errEmptyBluemixCredentials
-		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
-		session.powerConfigErr = errEmptyBluemixCredentials
-		session.ibmpiConfigErr = errEmptyBluemixCredentials
-		session.userManagementErr = errEmptyBluemixCredentials
-		session.certManagementErr = errEmptyBluemixCredentials
-		session.vpcClassicErr = errEmptyBluemixCredentials
-		session.vpcErr = errEmptyBluemixCredentials
-		session.apigatewayErr = errEmptyBluemixCredentials
-		session.pDNSErr = errEmptyBluemixCredentials
-		session.bmxUserFetchErr = errEmptyBluemixCredentials
-		session.directlinkErr = errEmptyBluemixCredentials
-		session.dlProviderErr = errEmptyBluemixCredentials
-		session.cosConfigErr = errEmptyBluemixCredentials
-		session.transitgatewayErr = errEmptyBluemixCredentials
-		session.iamNamespaceErr = errEmptyBluemixCredentials
-		session.cisDNSErr = errEmptyBluemixCredentials
-		session.cisDNSBulkErr = errEmptyBluemixCredentials
-		session.cisGLBPoolErr = errEmptyBluemixCredentials
-		session.cisGLBErr = errEmptyBluemixCredentials
-		session.cisGLBHealthCheckErr = errEmptyBluemixCredentials
-		session.cisIPErr = errEmptyBluemixCredentials
-		session.cisZonesErr = errEmptyBluemixCredentials
-		session.cisRLErr = errEmptyBluemixCredentials
-		session.cisPageRuleErr = errEmptyBluemixCredentials
-		session.cisEdgeFunctionErr = errEmptyBluemixCredentials
-		session.cisSSLErr = errEmptyBluemixCredentials
-		session.cisWAFPackageErr = errEmptyBluemixCredentials
-		session.cisDomainSettingsErr = errEmptyBluemixCredentials
-		session.cisRoutingErr = errEmptyBluemixCredentials
-		session.cisWAFGroupErr = errEmptyBluemixCredentials
-		session.cisCacheErr = errEmptyBluemixCredentials
-		session.cisCustomPageErr = errEmptyBluemixCredentials
-		session.cisAccessRuleErr = errEmptyBluemixCredentials
-		session.cisUARuleErr = errEmptyBluemixCredentials
-		session.cisLockdownErr = errEmptyBluemixCredentials
-		session.cisRangeAppErr = errEmptyBluemixCredentials
-		session.cisWAFRuleErr = errEmptyBluemixCredentials
-		session.iamIdentityErr = errEmptyBluemixCredentials
-
-		return session, nil
-	}
-
-	if sess.BluemixSession.Config.BluemixAPIKey != "" {
-		err = authenticateAPIKey(sess.BluemixSession)
-		if err != nil {
-			session.bmxUserFetchErr = fmt.Errorf("Error occured while fetching account user details: %q", err)
-			session.functionConfigErr = fmt.Errorf("Error occured while fetching auth key for function: %q", err)
-			session.powerConfigErr = fmt.Errorf("Error occured while fetching the auth key for power iaas: %q", err)
-			session.ibmpiConfigErr = fmt.Errorf("Error occured while fetching the auth key for power iaas: %q", err)
-		}
-		err = authenticateCF(sess.BluemixSession)
-		if err != nil {
-			session.functionConfigErr = fmt.Errorf("Error occured while fetching auth key for function: %q", err)
-		}
-	}
-
-	if sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
-		err := refreshToken(sess.BluemixSession)
-		if err != nil {
-			return nil, err
-		}
-
-	}
-	userConfig, err := fetchUserDetails(sess.BluemixSession, c.Generation)
-	if err != nil {
-		session.bmxUserFetchErr = fmt.Errorf("Error occured while fetching account user details: %q", err)
-	}
-	session.bmxUserDetails = userConfig
-
-	if sess.SoftLayerSession != nil && sess.SoftLayerSession.IAMToken != "" {
-		sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
-		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
-	}
-
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
-
-	BluemixRegion = sess.BluemixSession.Config.Region
-
-	accv1API, err := accountv1.New(sess.BluemixSession)
-	if err != nil {
-		session.accountV1ConfigErr = fmt.Errorf("Error occured while configuring Bluemix Accountv1 Service: %q", err)
-	}
-	session.bmxAccountv1ServiceAPI = accv1API
-
-	accAPI, err := accountv2.New(sess.BluemixSession)
-	if err != nil {
-		session.accountConfigErr = fmt.Errorf("Error occured while configuring  Account Service: %q", err)
-	}
-	session.bmxAccountServiceAPI = accAPI
-
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
-	if err != nil {
-		session.cfConfigErr = fmt.Errorf("Error occured while configuring MCCP service: %q", err)
-	}
-	session.cfServiceAPI = cfAPI
-
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
-	if err != nil {
-		session.csConfigErr = fmt.Errorf("Error occured while configuring Container Service for K8s cluster: %q", err)
-	}
-	session.csServiceAPI = clusterAPI
-
-	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.csv2ConfigErr = fmt.Errorf("Error occured while configuring vpc Container Service for K8s cluster: %q", err)
-	}
-	session.csv2ServiceAPI = v2clusterAPI
-
-	v1registryAPI, err := registryv1.New(sess.BluemixSession)
-	if err != nil {
-		session.crv1ConfigErr = fmt.Errorf("Error occured while configuring Container Registry: %q", err)
-	}
-	session.crv1ServiceAPI = v1registryAPI
-
-	hpcsAPI, err := hpcs.New(sess.BluemixSession)
-	if err != nil {
-		session.hpcsEndpointErr = fmt.Errorf("Error occured while configuring hpcs Endpoint: %q", err)
-	}
-	session.hpcsEndpointAPI = hpcsAPI
-
-	kpurl := fmt.Sprintf("https://%s.kms.cloud.ibm.com", c.Region)
-	options := kp.ClientConfig{
-		BaseURL:       envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-		Authorization: sess.BluemixSession.Config.IAMAccessToken,
-		// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-		Verbose: kp.VerboseFailOnly,
-	}
-	kpAPIclient, err := kp.New(options, kp.DefaultTransport())
-	if err != nil {
-		session.kpErr = fmt.Errorf("Error occured while configuring Key Protect Service: %q", err)
-	}
-	session.kpAPI = kpAPIclient
-
-	kmsurl := fmt.Sprintf("https://%s.kms.cloud.ibm.com", c.Region)
-	kmsOptions := kp.ClientConfig{
-		BaseURL:       envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-		Authorization: sess.BluemixSession.Config.IAMAccessToken,
-		// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-		Verbose: kp.VerboseFailOnly,
-	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
-	if err != nil {
-		session.kmsErr = fmt.Errorf("Error occured while configuring key Service: %q", err)
-	}
-	session.kmsAPI = kmsAPIclient
-
-	var authenticator *core.BearerTokenAuthenticator
-	if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
-	}
-
-	vpcclassicurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", c.Region)
-	vpcclassicoptions := &vpcclassic.VpcClassicV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_IS_API_ENDPOINT"}, vpcclassicurl),
-		Authenticator: authenticator,
-	}
-	vpcclassicclient, err := vpcclassic.NewVpcClassicV1(vpcclassicoptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("Error occured while configuring vpc classic service: %q", err)
-	}
-	session.vpcClassicAPI = vpcclassicclient
-
-	vpcurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", c.Region)
-	vpcoptions := &vpc.VpcV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcclient, err := vpc.NewVpcV1(vpcoptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("Error occured while configuring vpc service: %q", err)
-	}
-	session.vpcAPI = vpcclient
-
-	//cosconfigurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", c.Region)
-	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, "https://config.cloud-object-storage.cloud.ibm.com/v1"),
-	}
-	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
-	if err != nil {
-		session.cosConfigErr = fmt.Errorf("Error occured while configuring COS config service: %q", err)
-	}
-	session.cosConfigAPI = cosconfigclient
-
-	schematicService, err := schematics.New(sess.BluemixSession)
-	if err != nil {
-		session.stxConfigErr = fmt.Errorf("Error occured while fetching schematics Configuration: %q", err)
-	}
-	session.stxServiceAPI = schematicService
-
-	cisAPI, err := cisv1.New(sess.BluemixSession)
-	if err != nil {
-		session.cisConfigErr = fmt.Errorf("Error occured while configuring Cloud Internet Services: %q", err)
-	}
-	session.cisServiceAPI = cisAPI
-
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
-	if err != nil {
-		session.globalSearchConfigErr = fmt.Errorf("Error occured while configuring Global Search: %q", err)
-	}
-	session.globalSearchServiceAPI = globalSearchAPI
-
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
-	if err != nil {
-		session.globalTaggingConfigErr = fmt.Errorf("Error occured while configuring Global Tagging: %q", err)
-	}
-	session.globalTaggingServiceAPI = globalTaggingAPI
-
-	iampap, err := iampapv1.New(sess.BluemixSession)
-	if err != nil {
-		session.iamPAPConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAMPAP Service: %q", err)
-	}
-	session.iamPAPServiceAPI = iampap
-
-	iampapv2, err := iampapv2.New(sess.BluemixSession)
-	if err != nil {
-		session.iamPAPConfigErrv2 = fmt.Errorf("Error occured while configuring Bluemix IAMPAP Service: %q", err)
-	}
-	session.iamPAPServiceAPIv2 = iampapv2
-
-	iam, err := iamv1.New(sess.BluemixSession)
-	if err != nil {
-		session.iamConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAM Service: %q", err)
-	}
-	session.iamServiceAPI = iam
-
-	iamuum, err := iamuumv1.New(sess.BluemixSession)
-	if err != nil {
-		session.iamUUMConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAMUUM Service: %q", err)
-	}
-	session.iamUUMServiceAPI = iamuum
-
-	iamuumv2, err := iamuumv2.New(sess.BluemixSession)
-	if err != nil {
-		session.iamUUMConfigErrV2 = fmt.Errorf("Error occured while configuring Bluemix IAMUUM Service: %q", err)
-	}
-	session.iamUUMServiceAPIV2 = iamuumv2
-
-	icdAPI, err := icdv4.New(sess.BluemixSession)
-	if err != nil {
-		session.icdConfigErr = fmt.Errorf("Error occured while configuring IBM Cloud Database Services: %q", err)
-	}
-	session.icdServiceAPI = icdAPI
-
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceCatalogConfigErr = fmt.Errorf("Error occured while configuring Resource Catalog service: %q", err)
-	}
-	session.resourceCatalogServiceAPI = resourceCatalogAPI
-
-	resourceManagementAPI, err := management.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceManagementConfigErr = fmt.Errorf("Error occured while configuring Resource Management service: %q", err)
-	}
-	session.resourceManagementServiceAPI = resourceManagementAPI
-
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceManagementConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Management service: %q", err)
-	}
-	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
-
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErr = fmt.Errorf("Error occured while configuring Resource Controller service: %q", err)
-	}
-	session.resourceControllerServiceAPI = resourceControllerAPI
-
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Controller v2 service: %q", err)
-	}
-	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
-
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.userManagementErr = fmt.Errorf("Error occured while configuring user management service: %q", err)
-	}
-	session.userManagementAPI = userManagementAPI
-	certManagementAPI, err := certificatemanager.New(sess.BluemixSession)
-	if err != nil {
-		session.certManagementErr = fmt.Errorf("Error occured while configuring Certificate manager service: %q", err)
-	}
-	session.certManagementAPI = certManagementAPI
-
-	apicurl := fmt.Sprintf("https://api.%s.apigw.cloud.ibm.com/controller", c.Region)
-	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
-		Authenticator: &core.NoAuthAuthenticator{},
-	}
-	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
-	if err != nil {
-		session.apigatewayErr = fmt.Errorf("Error occured while configuring  APIGateway service: %q", err)
-	}
-	session.apigatewayAPI = apigatewayAPI
-
-	ibmpisession, err := ibmpisession.New(sess.BluemixSession.Config.IAMAccessToken, c.Region, false, c.BluemixTimeout, session.bmxUserDetails.userAccount, c.Zone)
-	if err != nil {
-		session.ibmpiConfigErr = err
+	if err := c.loadRateLimits(); err != nil {
 		return nil, err
 	}
-
-	session.ibmpiSession = ibmpisession
-
-	bluemixToken := ""
-	if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		bluemixToken = sess.BluemixSession.Config.IAMAccessToken[7:len(sess.BluemixSession.Config.IAMAccessToken)]
-	} else {
-		bluemixToken = sess.BluemixSession.Config.IAMAccessToken
-	}
-
-	dnsOptions := &dns.DnsSvcsV1Options{
-		URL: envFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, "https://api.dns-svcs.cloud.ibm.com/v1"),
-		Authenticator: &core.BearerTokenAuthenticator{
-			BearerToken: bluemixToken,
-		},
-	}
-
-	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
-	if session.pDNSErr != nil {
-		session.pDNSErr = fmt.Errorf("Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
-	}
-	version := time.Now().Format("2006-01-02")
-
-	directlinkOptions := &dl.DirectLinkV1Options{
-		URL: envFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, "https://directlink.cloud.ibm.com/v1"),
-		Authenticator: &core.BearerTokenAuthenticator{
-			BearerToken: bluemixToken,
-		},
-		Version: &version,
-	}
-
-	session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
-	if session.directlinkErr != nil {
-		session.directlinkErr = fmt.Errorf("Error occured while configuring Direct Link Service: %s", session.directlinkErr)
-	}
-
-	//Direct link provider
-	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL: envFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, "https://directlink.cloud.ibm.com/provider/v2"),
-		Authenticator: &core.BearerTokenAuthenticator{
-			BearerToken: bluemixToken,
-		},
-		Version: &version,
-	}
-
-	session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
-	if session.dlProviderErr != nil {
-		session.dlProviderErr = fmt.Errorf("Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
-	}
-	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL: envFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, "https://transit.cloud.ibm.com/v1"),
-		Authenticator: &core.BearerTokenAuthenticator{
-			BearerToken: bluemixToken,
-		},
-		Version: CreateVersionDate(),
-	}
-
-	session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
-	if session.transitgatewayErr != nil {
-		session.transitgatewayErr = fmt.Errorf("Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
+	if err := c.loadRecorder(); err != nil {
+		return nil, err
 	}
-
-	cfcurl := fmt.Sprintf("https://%s.functions.cloud.ibm.com/api/v1", c.Region)
-	ibmCloudFunctionsNamespaceOptions := &ns.IbmCloudFunctionsNamespaceOptions{
-		URL: envFallBack([]string{"IBMCLOUD_NAMESPACE_API_ENDPOINT"}, cfcurl),
-		Authenticator: &core.BearerTokenAuthenticator{
-			BearerToken: bluemixToken,
-		},
+	if err := c.loadAuditLog(); err != nil {
+		return nil, err
 	}
-
-	session.iamNamespaceAPI, err = ns.NewIbmCloudFunctionsNamespaceAPIV1(ibmCloudFunctionsNamespaceOptions)
+	logRedactor.addSecrets(c.BluemixAPIKey, c.SoftLayerAPIKey, c.IAMToken, c.IAMRefreshToken)
+	redactLogOutput()
+	sess, err := newSession(c)
 	if err != nil {
-		session.iamNamespaceErr = fmt.Errorf("Error occured while configuring IAM namespace service: %q", err)
-	}
-
-	// CIS Service instances starts here.
-	cisEndPoint := envFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, "https://api.cis.cloud.ibm.com")
-
-	// IBM Network CIS Zones service
-	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
-	if session.cisZonesErr != nil {
-		session.cisZonesErr = fmt.Errorf(
-			"Error occured while configuring CIS Zones service: %s",
-			session.cisZonesErr)
-	}
-
-	// IBM Network CIS DNS Record service
-	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
-	if session.cisDNSErr != nil {
-		session.cisDNSErr = fmt.Errorf("Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
-	}
-
-	// IBM Network CIS DNS Record bulk service
-	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
-	if session.cisDNSBulkErr != nil {
-		session.cisDNSBulkErr = fmt.Errorf(
-			"Error occured while configuration CIS DNS bulk service : %s",
-			session.cisDNSBulkErr)
-	}
-
-	// IBM Network CIS Global load balancer pool
-	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBPoolClient, session.cisGLBPoolErr =
-		cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
-	if session.cisGLBPoolErr != nil {
-		session.cisGLBPoolErr =
-			fmt.Errorf("Error occured while configuring CIS GLB Pool service: %s",
-				session.cisGLBPoolErr)
-	}
-
-	// IBM Network CIS Global load balancer
-	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
-	session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
-	if session.cisGLBErr != nil {
-		session.cisGLBErr =
-			fmt.Errorf("Error occured while configuring CIS GLB service: %s",
-				session.cisGLBErr)
-	}
-
-	// IBM Network CIS Global load balancer health check/monitor
-	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr =
-		cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
-	if session.cisGLBHealthCheckErr != nil {
-		session.cisGLBHealthCheckErr =
-			fmt.Errorf("Error occured while configuring CIS GLB Health Check service: %s",
-				session.cisGLBHealthCheckErr)
-	}
-
-	// IBM Network CIS IP
-	cisIPOpt := &cisipv1.CisIpApiV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
-	if session.cisIPErr != nil {
-		session.cisIPErr = fmt.Errorf("Error occured while configuring CIS IP service: %s",
-			session.cisIPErr)
-	}
-
-	// IBM Network CIS Zone Rate Limit
-	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
-	if session.cisRLErr != nil {
-		session.cisRLErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Zone Rate Limit service: %s",
-			session.cisRLErr)
-	}
-
-	// IBM Network CIS Page Rules
-	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
-	if session.cisPageRuleErr != nil {
-		session.cisPageRuleErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Page Rule service: %s",
-			session.cisPageRuleErr)
-	}
-
-	// IBM Network CIS Edge Function
-	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisEdgeFunctionClient, session.cisEdgeFunctionErr =
-		cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
-	if session.cisEdgeFunctionErr != nil {
-		session.cisEdgeFunctionErr =
-			fmt.Errorf("Error occured while configuring CIS Edge Function service: %s",
-				session.cisEdgeFunctionErr)
-	}
-
-	// IBM Network CIS SSL certificate
-	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-
-	session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
-	if session.cisSSLErr != nil {
-		session.cisSSLErr =
-			fmt.Errorf("Error occured while configuring CIS SSL certificate service: %s",
-				session.cisSSLErr)
-	}
-
-	// IBM Network CIS WAF Package
-	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFPackageClient, session.cisWAFPackageErr =
-		ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
-	if session.cisWAFPackageErr != nil {
-		session.cisWAFPackageErr =
-			fmt.Errorf("Error occured while configuration CIS WAF Package service: %s",
-				session.cisWAFPackageErr)
-	}
-
-	// IBM Network CIS Domain settings
-	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDomainSettingsClient, session.cisDomainSettingsErr =
-		cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
-	if session.cisDomainSettingsErr != nil {
-		session.cisDomainSettingsErr =
-			fmt.Errorf("Error occured while configuring CIS Domain Settings service: %s",
-				session.cisDomainSettingsErr)
-	}
-
-	// IBM Network CIS Routing
-	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRoutingClient, session.cisRoutingErr =
-		cisroutingv1.NewRoutingV1(cisRoutingOpt)
-	if session.cisRoutingErr != nil {
-		session.cisRoutingErr =
-			fmt.Errorf("Error occured while configuring CIS Routing service: %s",
-				session.cisRoutingErr)
-	}
-
-	// IBM Network CIS WAF Group
-	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFGroupClient, session.cisWAFGroupErr =
-		ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
-	if session.cisWAFGroupErr != nil {
-		session.cisWAFGroupErr =
-			fmt.Errorf("Error occured while configuring CIS WAF Group service: %s",
-				session.cisWAFGroupErr)
-	}
-
-	// IBM Network CIS Cache service
-	cisCacheOpt := &ciscachev1.CachingApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisCacheClient, session.cisCacheErr =
-		ciscachev1.NewCachingApiV1(cisCacheOpt)
-	if session.cisCacheErr != nil {
-		session.cisCacheErr =
-			fmt.Errorf("Error occured while configuring CIS Caching service: %s",
-				session.cisCacheErr)
-	}
-
-	// IBM Network CIS Custom pages service
-	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-
-	session.cisCustomPageClient, session.cisCustomPageErr =
-		ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
-	if session.cisCustomPageErr != nil {
-		session.cisCustomPageErr =
-			fmt.Errorf("Error occured while configuring CIS Custom Pages service: %s",
-				session.cisCustomPageErr)
-	}
-
-	// IBM Network CIS Firewall Access rule
-	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisAccessRuleClient, session.cisAccessRuleErr =
-		cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
-	if session.cisAccessRuleErr != nil {
-		session.cisAccessRuleErr =
-			fmt.Errorf("Error occured while configuring CIS Firewall Access Rule service: %s",
-				session.cisAccessRuleErr)
-	}
-
-	// IBM Network CIS Firewall User Agent Blocking rule
-	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisUARuleClient, session.cisUARuleErr =
-		cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
-	if session.cisUARuleErr != nil {
-		session.cisUARuleErr =
-			fmt.Errorf("Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
-				session.cisUARuleErr)
-	}
-
-	// IBM Network CIS Firewall Lockdown rule
-	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisLockdownClient, session.cisLockdownErr =
-		cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
-	if session.cisLockdownErr != nil {
-		session.cisLockdownErr =
-			fmt.Errorf("Error occured while configuring CIS Firewall Lockdown Rule service: %s",
-				session.cisLockdownErr)
-	}
-
-	// IBM Network CIS Range Application rule
-	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRangeAppClient, session.cisRangeAppErr =
-		cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
-	if session.cisRangeAppErr != nil {
-		session.cisRangeAppErr =
-			fmt.Errorf("Error occured while configuring CIS Range Application rule service: %s",
-				session.cisRangeAppErr)
-	}
-
-	// IBM Network CIS WAF Rule Service
-	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFRuleClient, session.cisWAFRuleErr =
-		ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
-	if session.cisWAFRuleErr != nil {
-		session.cisWAFRuleErr = fmt.Errorf(
-			"Error occured while configuring CIS WAF Rules service: %s",
-			session.cisWAFRuleErr)
-	}
-	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
-	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
-		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, "https://iam.cloud.ibm.com"),
+		return nil, err
 	}
-	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("Error occured while configuring IAM Identity service: %q", err)
+	log.Printf("[INFO] Configured Region: %s\n", c.Region)
+	if sess.BluemixSession != nil {
+		BluemixRegion = sess.BluemixSession.Config.Region
 	}
-	session.iamIdentityAPI = iamIdentityClient
-
-	return session, nil
+	return &clientSession{
+		config:  c,
+		session: sess,
+	}, nil
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -1578,14 +2041,23 @@ func CreateVersionDate() *string {
 }
 
 func newSession(c *Config) (*Session, error) {
-	ibmSession := &Session{}
+	ibmSession := &Session{tokens: newIAMTokenManager(), recorder: c.recorder}
+	// The bluemix-go clients are retried by the shared transport
+	noRetries := 0
+
+	softlayerEndpoint, err := c.softlayerEndpointFor()
+	if err != nil {
+		return nil, err
+	}
 
 	softlayerSession := &slsession.Session{
-		Endpoint:  c.SoftLayerEndpointURL,
-		Timeout:   c.SoftLayerTimeout,
-		UserName:  c.SoftLayerUserName,
-		APIKey:    c.SoftLayerAPIKey,
-		Debug:     os.Getenv("TF_LOG") != "",
+		Endpoint: softlayerEndpoint,
+		Timeout:  c.SoftLayerTimeout,
+		UserName: c.SoftLayerUserName,
+		APIKey:   c.SoftLayerAPIKey,
+		// The requests are dumped in the debug logs by the logging transport. The
+		// session retries the timeouts and the rate limit exceptions, which the
+		// shared transport does not retry, and refreshes the IAM token on a 500.
 		Retries:   c.RetryCount,
 		RetryWait: c.RetryDelay,
 	}
@@ -1615,11 +2087,12 @@ func newSession(c *Config) (*Session, error) {
 			IAMRefreshToken: c.IAMRefreshToken,
 			//Comment out debug mode for v0.12
 			//Debug:           os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			RetryDelay:      &c.RetryDelay,
+			MaxRetries:      &noRetries,
+			EndpointLocator: newConfigEndpointLocator(c),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -1628,6 +2101,41 @@ func newSession(c *Config) (*Session, error) {
 		ibmSession.BluemixSession = sess
 	}
 
+	if c.usesTrustedProfile() {
+		if c.BluemixAPIKey != "" || c.IAMToken != "" {
+			return nil, fmt.Errorf("iam_profile_id and iam_profile_name cannot be used with ibmcloud_api_key or iam_token")
+		}
+		log.Println("Configuring IBM Cloud Session with trusted profile")
+		tokenSource, err := newIAMProfileTokenSource(c)
+		if err != nil {
+			return nil, err
+		}
+		token, expiry, err := tokenSource.exchange()
+		if err != nil {
+			return nil, err
+		}
+		bmxConfig := &bluemix.Config{
+			IAMAccessToken: "Bearer " + token,
+			// Trusted profile tokens are refreshed by exchanging a new compute
+			// resource token, IAM does not issue a refresh token for them
+			IAMRefreshToken: "not_supported",
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			RetryDelay:      &c.RetryDelay,
+			MaxRetries:      &noRetries,
+			EndpointLocator: newConfigEndpointLocator(c),
+		}
+		sess, err := bxsession.New(bmxConfig)
+		if err != nil {
+			return nil, err
+		}
+		ibmSession.BluemixSession = sess
+		ibmSession.tokens.set(token, expiry, tokenSource.exchange)
+
+		softlayerSession.IAMToken = bmxConfig.IAMAccessToken
+	}
+
 	if c.BluemixAPIKey != "" {
 		log.Println("Configuring IBM Cloud Session with API key")
 		var sess *bxsession.Session
@@ -1635,11 +2143,12 @@ func newSession(c *Config) (*Session, error) {
 			BluemixAPIKey: c.BluemixAPIKey,
 			//Comment out debug mode for v0.12
 			//Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			RetryDelay:      &c.RetryDelay,
+			MaxRetries:      &noRetries,
+			EndpointLocator: newConfigEndpointLocator(c),
 			//PowerServiceInstance: c.PowerServiceInstance,
 		}
 		sess, err := bxsession.New(bmxConfig)
@@ -1649,15 +2158,34 @@ func newSession(c *Config) (*Session, error) {
 		ibmSession.BluemixSession = sess
 	}
 
+	// The SoftLayer session retries the timeouts and the rate limit exceptions and
+	// refreshes the IAM tokens, the shared transport retries the throttled requests
+	// and the gateway errors of each of its attempts
+	softlayerSession.HTTPClient = &gohttp.Client{
+		Transport: ibmSession.transport(c.serviceTransport(softlayerService, DefaultTransport())),
+	}
+	if ibmSession.BluemixSession != nil {
+		httpClient := http.NewHTTPClient(ibmSession.BluemixSession.Config)
+		httpClient.Transport = ibmSession.transport(c.retryTransport(c.auditLog.transport(bluemixService, logTransport(bluemixService, httpClient.Transport))))
+		ibmSession.BluemixSession.Config.HTTPClient = httpClient
+	}
+
 	return ibmSession, nil
 }
 
+// transport wraps next to keep the IAM tokens of the session current, the requests
+// are recorded or replayed after the retries
+func (s *Session) transport(next gohttp.RoundTripper) gohttp.RoundTripper {
+	return s.tokens.transport(s.recorder.transport(next))
+}
+
 func authenticateAPIKey(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
 		DefaultHeader: gohttp.Header{
 			"User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -1671,6 +2199,7 @@ func authenticateCF(sess *bxsession.Session) error {
 		DefaultHeader: gohttp.Header{
 			"User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -1720,6 +2249,7 @@ func refreshToken(sess *bxsession.Session) error {
 		DefaultHeader: gohttp.Header{
 			"User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
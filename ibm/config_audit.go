@@ -0,0 +1,439 @@
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	slsession "github.com/softlayer/softlayer-go/session"
+)
+
+// The audit log appends a JSON line for every mutating call of the provider to the
+// file of audit_log_path. Each line is written in a single write to the file opened
+// in append mode, so the provider configurations of a run can share the file. The
+// calls are logged before the retries, every attempt has its line.
+//
+// The calls are attributed to the resource or the data source whose operation sends
+// them. The operation is read from the context of the requests: the operations get a
+// view of the client session sharing its clients, whose SoftLayer sessions and s3 and
+// Kafka clients, built for each call, put the operation on their requests. The other
+// clients are built once and do not take a context, their calls are attributed when
+// a single operation is in progress.
+
+// auditTransactionHeaders are the headers holding the IBM transaction ID of a request,
+// in order of preference
+var auditTransactionHeaders = []string{"Transaction-Id", "X-Transaction-Id", "X-Request-Id", "X-Correlation-Id", "Ibm-Cloud-Request-Id"}
+
+// auditRedactedValue replaces the secrets in the audit log
+const auditRedactedValue = "REDACTED"
+
+// auditEntry is a line of the audit log. Status is 0 when no response was received,
+// the error is set instead.
+type auditEntry struct {
+	Time          string `json:"time"`
+	Service       string `json:"service"`
+	Method        string `json:"method"`
+	URL           string `json:"url"`
+	ResourceType  string `json:"resource_type,omitempty"`
+	ResourceID    string `json:"resource_id,omitempty"`
+	Status        int    `json:"status,omitempty"`
+	TransactionID string `json:"transaction_id,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// auditOperation is the operation of a resource or a data source. The ID is read when
+// a call is written, a create is attributed to the new resource once its ID is set.
+type auditOperation struct {
+	resourceType string
+	resource     *schema.ResourceData
+}
+
+// auditOperationKey is the context key of the operation sending a request
+type auditOperationKey struct{}
+
+// withAuditOperation returns a copy of ctx carrying the operation
+func withAuditOperation(ctx context.Context, operation *auditOperation) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, auditOperationKey{}, operation)
+}
+
+// auditOperationOf returns the operation carried by ctx, nil when there is none
+func auditOperationOf(ctx context.Context) *auditOperation {
+	operation, _ := ctx.Value(auditOperationKey{}).(*auditOperation)
+	return operation
+}
+
+type auditLog struct {
+	path string
+
+	mu      sync.Mutex
+	secrets map[string]bool
+	// operations are the resource and data source operations in progress
+	operations map[*auditOperation]bool
+}
+
+var (
+	auditLogsMu sync.Mutex
+	auditLogs   = map[string]*auditLog{}
+)
+
+// loadAuditLog opens the audit log when audit_log_path is set
+func (c *Config) loadAuditLog() error {
+	if c.AuditLogPath == "" {
+		return nil
+	}
+	audit, err := openAuditLog(c.AuditLogPath)
+	if err != nil {
+		return err
+	}
+	audit.addSecrets(c.BluemixAPIKey, c.SoftLayerAPIKey, strings.TrimPrefix(c.IAMToken, "Bearer "), c.IAMRefreshToken)
+	c.auditLog = audit
+	return nil
+}
+
+// openAuditLog returns the audit log of the file, which is created the first time it
+// is opened by the process
+func openAuditLog(path string) (*auditLog, error) {
+	auditLogsMu.Lock()
+	defer auditLogsMu.Unlock()
+	if audit, ok := auditLogs[path]; ok {
+		return audit, nil
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening the audit log: %s", err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, fmt.Errorf("Error opening the audit log: %s", err)
+	}
+	audit := &auditLog{
+		path:       path,
+		secrets:    map[string]bool{},
+		operations: map[*auditOperation]bool{},
+	}
+	auditLogs[path] = audit
+	return audit, nil
+}
+
+// addSecrets registers credentials of a provider configuration to redact
+func (a *auditLog) addSecrets(values ...string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, value := range values {
+		if value != "" {
+			a.secrets[value] = true
+		}
+	}
+}
+
+// begin records an operation in progress and returns the function ending it
+func (a *auditLog) begin(operation *auditOperation) func() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.operations[operation] = true
+	return func() {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		delete(a.operations, operation)
+	}
+}
+
+// auditSession is the client session of a resource or data source operation. It
+// shares the clients of the provider session.
+type auditSession struct {
+	*clientSession
+	operation *auditOperation
+}
+
+// SoftLayerSession returns a copy of the SoftLayer session putting the operation on
+// the context of its requests
+func (sess *auditSession) SoftLayerSession() *slsession.Session {
+	softlayerSession := *sess.clientSession.SoftLayerSession()
+	softlayerSession.Context = withAuditOperation(softlayerSession.Context, sess.operation)
+	return &softlayerSession
+}
+
+// auditSessionOf returns the client session of meta and the operation of an audit
+// session, nil when meta is not a client session
+func auditSessionOf(meta interface{}) (*clientSession, *auditOperation) {
+	switch sess := meta.(type) {
+	case *clientSession:
+		return sess, nil
+	case *auditSession:
+		return sess.clientSession, sess.operation
+	}
+	return nil, nil
+}
+
+// write appends the entry of a call of the operation to the audit log, the failures
+// are logged and do not fail the call. A call without operation is attributed to the
+// operation in progress, if there is a single one.
+func (a *auditLog) write(entry auditEntry, operation *auditOperation) {
+	entry.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if operation == nil && len(a.operations) == 1 {
+		for inProgress := range a.operations {
+			operation = inProgress
+		}
+	}
+	if operation != nil {
+		entry.ResourceType = operation.resourceType
+		entry.ResourceID = operation.resource.Id()
+	}
+	entry.URL = a.redact(entry.URL)
+	entry.Error = a.redact(entry.Error)
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] Error encoding the audit log entry of %s %s: %s", entry.Method, entry.URL, err)
+		return
+	}
+	file, err := os.OpenFile(a.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		log.Printf("[WARN] Error writing the audit log entry of %s %s: %s", entry.Method, entry.URL, err)
+		return
+	}
+	defer file.Close()
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] Error writing the audit log entry of %s %s: %s", entry.Method, entry.URL, err)
+	}
+}
+
+// redact replaces the credentials of the provider, the values redacted in the logs
+// and the values of the query parameters holding credentials
+func (a *auditLog) redact(s string) string {
+	s = logRedactor.redactValues(s)
+	for secret := range a.secrets {
+		s = strings.Replace(s, secret, auditRedactedValue, -1)
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return s
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), auditRedactedValue)
+	}
+	query := u.Query()
+	for name := range query {
+		if auditSecretName(name) {
+			query.Set(name, auditRedactedValue)
+		}
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// auditSecretName returns whether a query parameter holds a credential
+func auditSecretName(name string) bool {
+	if scrubbedKeys[name] {
+		return true
+	}
+	name = strings.ToLower(name)
+	for _, secret := range []string{"token", "secret", "password", "apikey", "api_key", "signature", "credential"} {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// transport wraps next to audit the mutating requests of the service. A nil audit
+// log returns next.
+func (a *auditLog) transport(service string, next gohttp.RoundTripper) gohttp.RoundTripper {
+	if a == nil {
+		return next
+	}
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	return &auditTransport{audit: a, service: service, next: next}
+}
+
+type auditTransport struct {
+	audit   *auditLog
+	service string
+	next    gohttp.RoundTripper
+}
+
+func (t *auditTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	switch req.Method {
+	case gohttp.MethodGet, gohttp.MethodHead, gohttp.MethodOptions:
+		return resp, err
+	}
+	entry := auditEntry{
+		Service: t.service,
+		Method:  req.Method,
+		URL:     req.URL.String(),
+	}
+	if err != nil {
+		if urlErr, ok := err.(*url.Error); ok {
+			entry.Error = urlErr.Err.Error()
+		} else {
+			entry.Error = err.Error()
+		}
+	} else {
+		entry.Status = resp.StatusCode
+	}
+	for _, header := range auditTransactionHeaders {
+		if resp != nil && resp.Header.Get(header) != "" {
+			entry.TransactionID = resp.Header.Get(header)
+			break
+		}
+		if req.Header.Get(header) != "" {
+			entry.TransactionID = req.Header.Get(header)
+			break
+		}
+	}
+	t.audit.write(entry, auditOperationOf(req.Context()))
+	return resp, err
+}
+
+// transport wraps next to put the operation on the context of the requests.
+// A nil operation returns next.
+func (operation *auditOperation) transport(next gohttp.RoundTripper) gohttp.RoundTripper {
+	if operation == nil {
+		return next
+	}
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	return &auditOperationTransport{operation: operation, next: next}
+}
+
+type auditOperationTransport struct {
+	operation *auditOperation
+	next      gohttp.RoundTripper
+}
+
+func (t *auditOperationTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	return t.next.RoundTrip(req.WithContext(withAuditOperation(req.Context(), t.operation)))
+}
+
+// auditResources wraps the operations of the resources and the data sources to
+// attribute the audited calls to them
+func auditResources(provider *schema.Provider) {
+	for name, r := range provider.ResourcesMap {
+		if r.Create != nil {
+			r.Create = auditOperationFunc(name, r.Create)
+		}
+		if r.Read != nil {
+			r.Read = auditOperationFunc(name, r.Read)
+		}
+		if r.Update != nil {
+			r.Update = auditOperationFunc(name, r.Update)
+		}
+		if r.Delete != nil {
+			r.Delete = auditOperationFunc(name, r.Delete)
+		}
+		if r.Exists != nil {
+			name, exists := name, r.Exists
+			r.Exists = func(d *schema.ResourceData, meta interface{}) (ok bool, err error) {
+				auditOperationDo(meta, name, d, func(meta interface{}) {
+					ok, err = exists(d, meta)
+				})
+				return
+			}
+		}
+	}
+	for name, r := range provider.DataSourcesMap {
+		if r.Read != nil {
+			r.Read = auditOperationFunc(name, r.Read)
+		}
+	}
+}
+
+func auditOperationFunc(resourceType string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) (err error) {
+		auditOperationDo(meta, resourceType, d, func(meta interface{}) {
+			err = f(d, meta)
+		})
+		return
+	}
+}
+
+// auditOperationDo calls f with the audit session of the operation while it is in
+// progress, or with meta when the calls are not audited
+func auditOperationDo(meta interface{}, resourceType string, d *schema.ResourceData, f func(meta interface{})) {
+	sess, ok := meta.(*clientSession)
+	if !ok || sess.config.auditLog == nil {
+		f(meta)
+		return
+	}
+	operation := &auditOperation{resourceType: resourceType, resource: d}
+	defer sess.config.auditLog.begin(operation)()
+	f(&auditSession{clientSession: sess, operation: operation})
+}
+
+// auditClusterAdmin audits the mutating operations of a Kafka admin client, which
+// does not use HTTP
+type auditClusterAdmin struct {
+	sarama.ClusterAdmin
+	audit     *auditLog
+	operation *auditOperation
+	service   string
+	brokers   []string
+}
+
+// auditKafka wraps the admin client of the brokers with the audit log of the session,
+// the calls are attributed to the operation of the session
+func auditKafka(meta interface{}, service string, brokers []string, admin sarama.ClusterAdmin) sarama.ClusterAdmin {
+	sess, operation := auditSessionOf(meta)
+	if sess == nil || sess.config.auditLog == nil {
+		return admin
+	}
+	return &auditClusterAdmin{ClusterAdmin: admin, audit: sess.config.auditLog, operation: operation, service: service, brokers: brokers}
+}
+
+func (a *auditClusterAdmin) write(operation, topic string, err error) {
+	entry := auditEntry{
+		Service: a.service,
+		Method:  operation,
+		URL:     fmt.Sprintf("kafka://%s/%s", strings.Join(a.brokers, ","), topic),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	a.audit.write(entry, a.operation)
+}
+
+func (a *auditClusterAdmin) CreateTopic(topic string, detail *sarama.TopicDetail, validateOnly bool) error {
+	err := a.ClusterAdmin.CreateTopic(topic, detail, validateOnly)
+	a.write("CreateTopic", topic, err)
+	return err
+}
+
+func (a *auditClusterAdmin) DeleteTopic(topic string) error {
+	err := a.ClusterAdmin.DeleteTopic(topic)
+	a.write("DeleteTopic", topic, err)
+	return err
+}
+
+func (a *auditClusterAdmin) CreatePartitions(topic string, count int32, assignment [][]int32, validateOnly bool) error {
+	err := a.ClusterAdmin.CreatePartitions(topic, count, assignment, validateOnly)
+	a.write("CreatePartitions", topic, err)
+	return err
+}
+
+func (a *auditClusterAdmin) AlterConfig(resourceType sarama.ConfigResourceType, name string, entries map[string]*string, validateOnly bool) error {
+	err := a.ClusterAdmin.AlterConfig(resourceType, name, entries, validateOnly)
+	a.write("AlterConfig", name, err)
+	return err
+}
+
+func (a *auditClusterAdmin) DeleteRecords(topic string, partitionOffsets map[int32]int64) error {
+	err := a.ClusterAdmin.DeleteRecords(topic, partitionOffsets)
+	a.write("DeleteRecords", topic, err)
+	return err
+}
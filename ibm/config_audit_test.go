@@ -0,0 +1,238 @@
+package ibm
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	slsession "github.com/softlayer/softlayer-go/session"
+)
+
+func testAuditLog(t *testing.T, config *Config) func() {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	config.AuditLogPath = filepath.Join(dir, "audit.jsonl")
+	if err := config.loadAuditLog(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func testAuditResourceData(id string) *schema.ResourceData {
+	d := (&schema.Resource{Schema: map[string]*schema.Schema{}}).Data(nil)
+	d.SetId(id)
+	return d
+}
+
+func readAuditLog(t *testing.T, config *Config) []auditEntry {
+	src, err := ioutil.ReadFile(config.AuditLogPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	entries := []auditEntry{}
+	for _, line := range strings.Split(strings.TrimSpace(string(src)), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry auditEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("invalid audit log line %q: %s", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestAuditLog(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Method == http.MethodPost && calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("X-Request-Id", "request-"+r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &Config{BluemixAPIKey: "my-api-key", RetryCount: 3, RetryDelay: time.Millisecond, RetryMaxWait: 10 * time.Millisecond}
+	done := testAuditLog(t, config)
+	defer done()
+	sess := &clientSession{config: config, session: &Session{tokens: newIAMTokenManager()}}
+	client := sess.httpClient(vpcService)
+	bucket := testAuditResourceData("")
+
+	auditOperationDo(sess, "ibm_is_vpc", testAuditResourceData("r006-1"), func(meta interface{}) {
+		// The calls of the shared clients are attributed to the single operation in progress
+		if _, err := client.Post(server.URL+"/vpcs?version=2020-10-06&apikey=my-api-key", "application/json", strings.NewReader(`{}`)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, err := client.Get(server.URL + "/vpcs/r006-1"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		// The calls of the clients built for a call of concurrent operations are
+		// attributed to their operation, a create to the new resource once its ID is set
+		auditOperationDo(sess, "ibm_cos_bucket", bucket, func(meta interface{}) {
+			req, _ := http.NewRequest(http.MethodDelete, server.URL+"/keys/my-api-key", nil)
+			if _, err := s3HTTPClient(meta).Do(req); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			bucket.SetId("my-bucket")
+			if _, err := s3HTTPClient(meta).Post(server.URL+"/my-bucket?tagging", "application/xml", strings.NewReader(`<Tagging/>`)); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			client.Post("http://127.0.0.1:0/vpcs?token=my-token", "application/json", strings.NewReader(`{}`))
+		})
+	})
+	if len(config.auditLog.operations) != 0 {
+		t.Errorf("expected the operations to end, got %+v", config.auditLog.operations)
+	}
+
+	entries := readAuditLog(t, config)
+	if len(entries) != 5 {
+		t.Fatalf("expected an entry per attempt of the mutating calls, got %+v", entries)
+	}
+	throttled, created := entries[0], entries[1]
+	if throttled.Status != http.StatusTooManyRequests || created.Status != http.StatusOK || created.TransactionID != "request-POST" {
+		t.Errorf("unexpected status or transaction ID: %+v %+v", throttled, created)
+	}
+	if created.Service != vpcService || created.Method != http.MethodPost || created.ResourceType != "ibm_is_vpc" || created.ResourceID != "r006-1" || created.Time == "" {
+		t.Errorf("unexpected entry: %+v", created)
+	}
+	if created.URL != server.URL+"/vpcs?apikey=REDACTED&version=2020-10-06" {
+		t.Errorf("expected the API key to be redacted, got %s", created.URL)
+	}
+	deleted := entries[2]
+	if deleted.URL != server.URL+"/keys/REDACTED" || deleted.Service != cosService || deleted.ResourceType != "ibm_cos_bucket" || deleted.ResourceID != "" {
+		t.Errorf("expected the secret to be redacted and the bucket without ID, got %+v", deleted)
+	}
+	if tagged := entries[3]; tagged.ResourceType != "ibm_cos_bucket" || tagged.ResourceID != "my-bucket" {
+		t.Errorf("expected the call to be attributed to the new bucket, got %+v", tagged)
+	}
+	failed := entries[4]
+	if failed.Status != 0 || failed.Error == "" || strings.Contains(failed.Error+failed.URL, "my-token") || failed.ResourceType != "" {
+		t.Errorf("expected the error without the token and no resource during concurrent operations, got %+v", failed)
+	}
+}
+
+func TestAuditLogDisabled(t *testing.T) {
+	config := &Config{}
+	if err := config.loadAuditLog(); err != nil || config.auditLog != nil {
+		t.Fatalf("expected no audit log, got %v", err)
+	}
+	admin := &testClusterAdmin{}
+	if auditKafka(&clientSession{config: config}, eventStreamsService, nil, admin) != admin {
+		t.Errorf("expected the admin client not to be wrapped")
+	}
+	sess := &clientSession{config: config}
+	auditOperationDo(sess, "ibm_is_vpc", testAuditResourceData("r006-1"), func(meta interface{}) {
+		if meta != sess {
+			t.Errorf("expected the session not to be replaced")
+		}
+	})
+}
+
+func TestAuditLogShared(t *testing.T) {
+	config := &Config{BluemixAPIKey: "my-api-key"}
+	done := testAuditLog(t, config)
+	defer done()
+	other := &Config{AuditLogPath: config.AuditLogPath, SoftLayerAPIKey: "my-softlayer-key"}
+	if err := other.loadAuditLog(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if other.auditLog != config.auditLog {
+		t.Fatalf("expected the provider configurations to share the audit log of the path")
+	}
+	config.auditLog.write(auditEntry{Method: http.MethodPost, URL: "https://iam.cloud.ibm.com/keys/my-softlayer-key"}, nil)
+	if entries := readAuditLog(t, config); len(entries) != 1 || entries[0].URL != "https://iam.cloud.ibm.com/keys/REDACTED" {
+		t.Errorf("expected the secrets of every configuration to be redacted, got %+v", entries)
+	}
+}
+
+func TestAuditResources(t *testing.T) {
+	config := &Config{}
+	done := testAuditLog(t, config)
+	defer done()
+
+	var operation *auditSession
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"ibm_test": {
+				Create: func(d *schema.ResourceData, meta interface{}) error {
+					if _, ok := meta.(ClientSession); !ok {
+						t.Errorf("expected the session of the operation to be a client session")
+					}
+					operation = meta.(*auditSession)
+					if !config.auditLog.operations[operation.operation] {
+						t.Errorf("expected the operation to be in progress")
+					}
+					return nil
+				},
+			},
+		},
+	}
+	auditResources(provider)
+	r := provider.ResourcesMap["ibm_test"]
+	sess := &clientSession{config: config, session: &Session{SoftLayerSession: &slsession.Session{}}}
+	if err := r.Create(r.Data(nil), sess); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if operation.clientSession != sess || operation.operation.resourceType != "ibm_test" || len(config.auditLog.operations) != 0 {
+		t.Fatalf("expected the create to share the session during its operation, got %+v", operation.operation)
+	}
+	if auditOperationOf(operation.SoftLayerSession().Context) != operation.operation || sess.SoftLayerSession().Context != nil {
+		t.Errorf("expected the SoftLayer requests of the operation only to carry it")
+	}
+}
+
+type testClusterAdmin struct {
+	sarama.ClusterAdmin
+}
+
+func (a *testClusterAdmin) CreateTopic(topic string, detail *sarama.TopicDetail, validateOnly bool) error {
+	return nil
+}
+
+func (a *testClusterAdmin) DeleteTopic(topic string) error {
+	return errors.New("unknown topic " + topic)
+}
+
+func TestAuditKafka(t *testing.T) {
+	config := &Config{}
+	done := testAuditLog(t, config)
+	defer done()
+
+	sess := &auditSession{
+		clientSession: &clientSession{config: config},
+		operation:     &auditOperation{resourceType: "ibm_event_streams_topic", resource: testAuditResourceData("orders")},
+	}
+	admin := auditKafka(sess, eventStreamsService, []string{"broker-0:9093", "broker-1:9093"}, &testClusterAdmin{})
+	admin.CreateTopic("orders", &sarama.TopicDetail{}, false)
+	admin.DeleteTopic("payments")
+
+	entries := readAuditLog(t, config)
+	if len(entries) != 2 {
+		t.Fatalf("expected an entry per operation, got %+v", entries)
+	}
+	if entries[0].Service != eventStreamsService || entries[0].Method != "CreateTopic" || entries[0].URL != "kafka://broker-0:9093,broker-1:9093/orders" || entries[0].Error != "" || entries[0].ResourceID != "orders" {
+		t.Errorf("unexpected entry: %+v", entries[0])
+	}
+	if entries[1].Method != "DeleteTopic" || entries[1].Error != "unknown topic payments" {
+		t.Errorf("expected the error of the operation, got %+v", entries[1])
+	}
+}
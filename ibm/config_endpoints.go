@@ -0,0 +1,491 @@
+package ibm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/ghodss/yaml"
+)
+
+// Supported values of the provider visibility argument
+const (
+	publicVisibility           = "public"
+	privateVisibility          = "private"
+	publicAndPrivateVisibility = "public-and-private"
+)
+
+// Service names used to resolve the endpoint of each client
+const (
+	accountService            = "account"
+	apiGatewayService         = "api_gateway"
+	certificateManagerService = "certificate_manager"
+	cfService                 = "cf"
+	cisService                = "cis"
+	containerService          = "container"
+	containerRegistryService  = "container_registry"
+	cosConfigService          = "cos_config"
+	cseService                = "cse"
+	directlinkService         = "directlink"
+	directlinkProviderService = "directlink_provider"
+	functionsService          = "functions"
+	functionsNamespaceService = "functions_namespace"
+	globalSearchService       = "global_search"
+	globalTaggingService      = "global_tagging"
+	hpcsService               = "hpcs"
+	iamService                = "iam"
+	iamPAPService             = "iam_pap"
+	icdService                = "icd"
+	kmsService                = "kms"
+	mccpService               = "mccp"
+	powerService              = "power"
+	privateDNSService         = "private_dns"
+	resourceCatalogService    = "resource_catalog"
+	resourceControllerService = "resource_controller"
+	resourceManagerService    = "resource_manager"
+	schematicsService         = "schematics"
+	softlayerService          = "softlayer"
+	transitGatewayService     = "transit_gateway"
+	uaaService                = "uaa"
+	userManagementService     = "user_management"
+	vpcService                = "vpc"
+	vpcClassicService         = "vpc_classic"
+)
+
+// Service names of the clients which have no endpoint in serviceEndpoints. They name
+// the requests of these clients in the logs, the rate limits and the audit log.
+const (
+	bluemixService      = "bluemix"
+	cosService          = "cos"
+	eventStreamsService = "event_streams"
+)
+
+// softlayerPublicEndpoint is the default classic infrastructure endpoint
+const softlayerPublicEndpoint = "https://api.softlayer.com/rest/v3"
+
+// serviceEndpoint describes how the endpoint of a service is resolved
+type serviceEndpoint struct {
+	// envs are the environment variables that override the endpoint
+	envs []string
+	// public returns the public endpoint for a region. It is nil for services
+	// whose public endpoint is resolved by bluemix-go
+	public func(region string) string
+	// private returns the private endpoint for a region. It is nil when the
+	// service has no private endpoint
+	private func(region string) string
+}
+
+func globalEndpoint(url string) func(string) string {
+	return func(string) string {
+		return url
+	}
+}
+
+func regionalEndpoint(format string) func(string) string {
+	return func(region string) string {
+		return fmt.Sprintf(format, region)
+	}
+}
+
+// registryRegions maps a region to the registry prefix of its private endpoint
+var registryRegions = map[string]string{
+	"us-south": "us",
+	"us-east":  "us",
+	"eu-gb":    "uk",
+	"eu-de":    "de",
+	"au-syd":   "au",
+	"jp-tok":   "jp",
+}
+
+// schematicsRegions maps a region to the schematics location of its endpoint
+var schematicsRegions = map[string]string{
+	"us-south": "us",
+	"us-east":  "us",
+	"eu-gb":    "eu-gb",
+	"eu-de":    "eu-de",
+}
+
+var serviceEndpoints = map[string]serviceEndpoint{
+	accountService: {
+		envs: []string{"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT"},
+	},
+	apiGatewayService: {
+		envs:   []string{"IBMCLOUD_API_GATEWAY_ENDPOINT"},
+		public: regionalEndpoint("https://api.%s.apigw.cloud.ibm.com/controller"),
+	},
+	certificateManagerService: {
+		envs:    []string{"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT"},
+		private: regionalEndpoint("https://private.%s.certificate-manager.cloud.ibm.com"),
+	},
+	cfService: {
+		envs: []string{"IBMCLOUD_CF_API_ENDPOINT"},
+	},
+	cisService: {
+		envs:    []string{"IBMCLOUD_CIS_API_ENDPOINT"},
+		public:  globalEndpoint("https://api.cis.cloud.ibm.com"),
+		private: globalEndpoint("https://api.private.cis.cloud.ibm.com"),
+	},
+	containerService: {
+		envs:    []string{"IBMCLOUD_CS_API_ENDPOINT"},
+		private: regionalEndpoint("https://private.%s.containers.cloud.ibm.com/global"),
+	},
+	containerRegistryService: {
+		envs: []string{"IBMCLOUD_CR_API_ENDPOINT"},
+		private: func(region string) string {
+			if r, ok := registryRegions[region]; ok {
+				return fmt.Sprintf("https://private.%s.icr.io", r)
+			}
+			return ""
+		},
+	},
+	cosConfigService: {
+		envs:    []string{"IBMCLOUD_COS_CONFIG_ENDPOINT"},
+		public:  globalEndpoint("https://config.cloud-object-storage.cloud.ibm.com/v1"),
+		private: globalEndpoint("https://config.private.cloud-object-storage.cloud.ibm.com/v1"),
+	},
+	cseService: {
+		envs: []string{"IBMCLOUD_CSE_ENDPOINT"},
+	},
+	directlinkService: {
+		envs:    []string{"IBMCLOUD_DL_API_ENDPOINT"},
+		public:  globalEndpoint("https://directlink.cloud.ibm.com/v1"),
+		private: globalEndpoint("https://private.directlink.cloud.ibm.com/v1"),
+	},
+	directlinkProviderService: {
+		envs:    []string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"},
+		public:  globalEndpoint("https://directlink.cloud.ibm.com/provider/v2"),
+		private: globalEndpoint("https://private.directlink.cloud.ibm.com/provider/v2"),
+	},
+	functionsService: {
+		envs:   []string{"IBMCLOUD_FUNCTIONS_API_ENDPOINT"},
+		public: getBaseURL,
+	},
+	functionsNamespaceService: {
+		envs:   []string{"IBMCLOUD_NAMESPACE_API_ENDPOINT"},
+		public: regionalEndpoint("https://%s.functions.cloud.ibm.com/api/v1"),
+	},
+	globalSearchService: {
+		envs:    []string{"IBMCLOUD_GS_API_ENDPOINT"},
+		public:  globalEndpoint("https://api.global-search-tagging.cloud.ibm.com"),
+		private: globalEndpoint("https://api.private.global-search-tagging.cloud.ibm.com"),
+	},
+	globalTaggingService: {
+		envs:    []string{"IBMCLOUD_GT_API_ENDPOINT"},
+		public:  globalEndpoint("https://tags.global-search-tagging.cloud.ibm.com"),
+		private: globalEndpoint("https://tags.private.global-search-tagging.cloud.ibm.com"),
+	},
+	hpcsService: {
+		envs:    []string{"IBMCLOUD_HPCS_API_ENDPOINT"},
+		private: regionalEndpoint("https://private.%s.broker.hs-crypto.cloud.ibm.com/crypto_v2/"),
+	},
+	iamService: {
+		envs:    []string{"IBMCLOUD_IAM_API_ENDPOINT"},
+		public:  globalEndpoint("https://iam.cloud.ibm.com"),
+		private: globalEndpoint("https://private.iam.cloud.ibm.com"),
+	},
+	iamPAPService: {
+		envs:    []string{"IBMCLOUD_IAMPAP_API_ENDPOINT"},
+		private: globalEndpoint("https://private.iam.cloud.ibm.com"),
+	},
+	icdService: {
+		envs:    []string{"IBMCLOUD_ICD_API_ENDPOINT"},
+		private: regionalEndpoint("https://api.%s.private.databases.cloud.ibm.com"),
+	},
+	kmsService: {
+		envs:    []string{"IBMCLOUD_KP_API_ENDPOINT"},
+		public:  regionalEndpoint("https://%s.kms.cloud.ibm.com"),
+		private: regionalEndpoint("https://private.%s.kms.cloud.ibm.com"),
+	},
+	mccpService: {
+		envs: []string{"IBMCLOUD_MCCP_API_ENDPOINT"},
+	},
+	powerService: {
+		public:  regionalEndpoint("https://%s.power-iaas.cloud.ibm.com"),
+		private: regionalEndpoint("https://private.%s.power-iaas.cloud.ibm.com"),
+	},
+	privateDNSService: {
+		envs:    []string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"},
+		public:  globalEndpoint("https://api.dns-svcs.cloud.ibm.com/v1"),
+		private: globalEndpoint("https://api.private.dns-svcs.cloud.ibm.com/v1"),
+	},
+	resourceCatalogService: {
+		envs:    []string{"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT"},
+		private: globalEndpoint("https://private.globalcatalog.cloud.ibm.com"),
+	},
+	resourceControllerService: {
+		envs:    []string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"},
+		private: globalEndpoint("https://private.resource-controller.cloud.ibm.com"),
+	},
+	resourceManagerService: {
+		envs:    []string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"},
+		private: globalEndpoint("https://private.resource-controller.cloud.ibm.com"),
+	},
+	schematicsService: {
+		envs: []string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"},
+		private: func(region string) string {
+			if r, ok := schematicsRegions[region]; ok {
+				return fmt.Sprintf("https://private-%s.schematics.cloud.ibm.com", r)
+			}
+			return ""
+		},
+	},
+	softlayerService: {
+		public:  globalEndpoint(softlayerPublicEndpoint),
+		private: globalEndpoint("https://api.service.softlayer.com/rest/v3"),
+	},
+	transitGatewayService: {
+		envs:    []string{"IBMCLOUD_TG_API_ENDPOINT"},
+		public:  globalEndpoint("https://transit.cloud.ibm.com/v1"),
+		private: globalEndpoint("https://private.transit.cloud.ibm.com/v1"),
+	},
+	uaaService: {
+		envs: []string{"IBMCLOUD_UAA_ENDPOINT"},
+	},
+	userManagementService: {
+		envs:    []string{"IBMCLOUD_USER_MANAGEMENT_ENDPOINT"},
+		private: globalEndpoint("https://private.user-management.cloud.ibm.com"),
+	},
+	vpcService: {
+		envs:    []string{"IBMCLOUD_IS_NG_API_ENDPOINT"},
+		public:  regionalEndpoint("https://%s.iaas.cloud.ibm.com/v1"),
+		private: regionalEndpoint("https://%s.private.iaas.cloud.ibm.com/v1"),
+	},
+	vpcClassicService: {
+		envs:    []string{"IBMCLOUD_IS_API_ENDPOINT"},
+		public:  regionalEndpoint("https://%s.iaas.cloud.ibm.com/v1"),
+		private: regionalEndpoint("https://%s.private.iaas.cloud.ibm.com/v1"),
+	},
+}
+
+// endpointsFile maps a service name and a visibility to the endpoint of each
+// region, for example {"vpc": {"private": {"us-south": "https://..."}}}
+type endpointsFile map[string]map[string]map[string]string
+
+// loadEndpointsFile reads the JSON or YAML file set in endpoints_file_path
+func (c *Config) loadEndpointsFile() error {
+	if c.EndpointsFile == "" {
+		return nil
+	}
+	content, err := ioutil.ReadFile(c.EndpointsFile)
+	if err != nil {
+		return fmt.Errorf("Error reading the endpoints file %s: %s", c.EndpointsFile, err)
+	}
+	var file endpointsFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return fmt.Errorf("Error parsing the endpoints file %s: %s", c.EndpointsFile, err)
+	}
+	for service, visibilities := range file {
+		if _, ok := serviceEndpoints[service]; !ok {
+			return fmt.Errorf("Error in the endpoints file %s: unknown service %q, expected one of %s", c.EndpointsFile, service, strings.Join(endpointServiceNames(), ", "))
+		}
+		for visibility := range visibilities {
+			if visibility != publicVisibility && visibility != privateVisibility {
+				return fmt.Errorf("Error in the endpoints file %s: unknown visibility %q for service %q, expected public or private", c.EndpointsFile, visibility, service)
+			}
+		}
+	}
+	c.endpoints = file
+	return nil
+}
+
+// endpointServiceNames returns the sorted names of the services whose endpoint can be configured
+func endpointServiceNames() []string {
+	names := make([]string, 0, len(serviceEndpoints))
+	for name := range serviceEndpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fileEndpoint returns the endpoint set in the endpoints file for a service, visibility and the configured region
+func (c *Config) fileEndpoint(service, visibility string) string {
+	return c.endpoints[service][visibility][c.Region]
+}
+
+// privateEndpoint returns the private endpoint of a service in the given region
+func privateEndpoint(service, region string) (string, error) {
+	if ep, ok := serviceEndpoints[service]; ok && ep.private != nil {
+		if url := ep.private(region); url != "" {
+			return url, nil
+		}
+	}
+	return "", fmt.Errorf("The %s service does not support private endpoints in region %q, set visibility to \"public\" or \"public-and-private\" to use it", service, region)
+}
+
+// privateEndpointFor returns the private endpoint of a service from the endpoints file or the built-in endpoints
+func (c *Config) privateEndpointFor(service string) (string, error) {
+	if url := c.fileEndpoint(service, privateVisibility); url != "" {
+		return url, nil
+	}
+	return privateEndpoint(service, c.Region)
+}
+
+// visibleEndpoint resolves the endpoint of a service for the configured visibility.
+// public is called when neither the endpoints file nor the visibility provide one.
+func (c *Config) visibleEndpoint(service string, public func() (string, error)) (string, error) {
+	switch c.Visibility {
+	case privateVisibility:
+		return c.privateEndpointFor(service)
+	case publicAndPrivateVisibility:
+		if url, err := c.privateEndpointFor(service); err == nil {
+			return url, nil
+		}
+	}
+	if url := c.fileEndpoint(service, publicVisibility); url != "" {
+		return url, nil
+	}
+	return public()
+}
+
+// endpointFor returns the endpoint of a service for the configured region and
+// visibility. An endpoint set through the environment always takes precedence,
+// followed by the endpoints file and the built-in endpoints.
+func (c *Config) endpointFor(service string) (string, error) {
+	ep := serviceEndpoints[service]
+	if url := envFallBack(ep.envs, ""); url != "" {
+		return url, nil
+	}
+	return c.visibleEndpoint(service, func() (string, error) {
+		if ep.public == nil {
+			return "", fmt.Errorf("No endpoint is defined for the %s service in region %q", service, c.Region)
+		}
+		return ep.public(c.Region), nil
+	})
+}
+
+// softlayerEndpointFor returns the classic infrastructure endpoint. The private
+// endpoint is only used when no custom endpoint was configured.
+func (c *Config) softlayerEndpointFor() (string, error) {
+	if c.SoftLayerEndpointURL != "" && c.SoftLayerEndpointURL != softlayerPublicEndpoint {
+		return c.SoftLayerEndpointURL, nil
+	}
+	return c.endpointFor(softlayerService)
+}
+
+// configEndpointLocator resolves the bluemix-go service endpoints for the
+// provider visibility and endpoints file. Public endpoints that are not
+// overridden are delegated to the bluemix-go locator.
+type configEndpointLocator struct {
+	config *Config
+	public endpoints.EndpointLocator
+}
+
+func newConfigEndpointLocator(c *Config) endpoints.EndpointLocator {
+	return &configEndpointLocator{
+		config: c,
+		public: endpoints.NewEndpointLocator(c.Region),
+	}
+}
+
+func (l *configEndpointLocator) locate(service string, public func() (string, error)) (string, error) {
+	if url := helpers.EnvFallBack(serviceEndpoints[service].envs, ""); url != "" {
+		return url, nil
+	}
+	return l.config.visibleEndpoint(service, public)
+}
+
+func (l *configEndpointLocator) AccountManagementEndpoint() (string, error) {
+	return l.locate(accountService, l.public.AccountManagementEndpoint)
+}
+
+func (l *configEndpointLocator) CertificateManagerEndpoint() (string, error) {
+	return l.locate(certificateManagerService, l.public.CertificateManagerEndpoint)
+}
+
+func (l *configEndpointLocator) CFAPIEndpoint() (string, error) {
+	return l.locate(cfService, l.public.CFAPIEndpoint)
+}
+
+func (l *configEndpointLocator) ContainerEndpoint() (string, error) {
+	return l.locate(containerService, l.public.ContainerEndpoint)
+}
+
+func (l *configEndpointLocator) ContainerRegistryEndpoint() (string, error) {
+	return l.locate(containerRegistryService, l.public.ContainerRegistryEndpoint)
+}
+
+func (l *configEndpointLocator) CisEndpoint() (string, error) {
+	return l.locate(cisService, l.public.CisEndpoint)
+}
+
+func (l *configEndpointLocator) GlobalSearchEndpoint() (string, error) {
+	return l.locate(globalSearchService, l.public.GlobalSearchEndpoint)
+}
+
+func (l *configEndpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return l.locate(globalTaggingService, l.public.GlobalTaggingEndpoint)
+}
+
+func (l *configEndpointLocator) IAMEndpoint() (string, error) {
+	return l.locate(iamService, l.public.IAMEndpoint)
+}
+
+func (l *configEndpointLocator) IAMPAPEndpoint() (string, error) {
+	return l.locate(iamPAPService, l.public.IAMPAPEndpoint)
+}
+
+func (l *configEndpointLocator) ICDEndpoint() (string, error) {
+	return l.locate(icdService, l.public.ICDEndpoint)
+}
+
+func (l *configEndpointLocator) MCCPAPIEndpoint() (string, error) {
+	return l.locate(mccpService, l.public.MCCPAPIEndpoint)
+}
+
+func (l *configEndpointLocator) ResourceManagementEndpoint() (string, error) {
+	return l.locate(resourceManagerService, l.public.ResourceManagementEndpoint)
+}
+
+func (l *configEndpointLocator) ResourceControllerEndpoint() (string, error) {
+	return l.locate(resourceControllerService, l.public.ResourceControllerEndpoint)
+}
+
+func (l *configEndpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return l.locate(resourceCatalogService, l.public.ResourceCatalogEndpoint)
+}
+
+func (l *configEndpointLocator) UAAEndpoint() (string, error) {
+	return l.locate(uaaService, l.public.UAAEndpoint)
+}
+
+func (l *configEndpointLocator) CseEndpoint() (string, error) {
+	return l.locate(cseService, l.public.CseEndpoint)
+}
+
+func (l *configEndpointLocator) SchematicsEndpoint() (string, error) {
+	return l.locate(schematicsService, l.public.SchematicsEndpoint)
+}
+
+func (l *configEndpointLocator) UserManagementEndpoint() (string, error) {
+	return l.locate(userManagementService, l.public.UserManagementEndpoint)
+}
+
+func (l *configEndpointLocator) HpcsEndpoint() (string, error) {
+	return l.locate(hpcsService, l.public.HpcsEndpoint)
+}
+
+func (l *configEndpointLocator) FunctionsEndpoint() (string, error) {
+	return l.locate(functionsService, l.public.FunctionsEndpoint)
+}
+
+// powerEndpointOverridden reports whether the power endpoint was set through
+// the environment, in which case power-go-client already honours it
+func powerEndpointOverridden() bool {
+	return os.Getenv("IBMCLOUD_POWER_API_ENDPOINT") != ""
+}
+
+// functionsBaseURL returns the Cloud Functions endpoint of a bluemix-go config
+func functionsBaseURL(c *bluemix.Config) string {
+	if c.EndpointLocator != nil {
+		if url, err := c.EndpointLocator.FunctionsEndpoint(); err == nil {
+			return url
+		}
+	}
+	return getBaseURL(c.Region)
+}
@@ -0,0 +1,135 @@
+package ibm
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestEndpointForVisibility(t *testing.T) {
+	os.Unsetenv("IBMCLOUD_IS_NG_API_ENDPOINT")
+	cases := []struct {
+		visibility string
+		service    string
+		expected   string
+		expectErr  bool
+	}{
+		{publicVisibility, vpcService, "https://us-south.iaas.cloud.ibm.com/v1", false},
+		{privateVisibility, vpcService, "https://us-south.private.iaas.cloud.ibm.com/v1", false},
+		{publicAndPrivateVisibility, vpcService, "https://us-south.private.iaas.cloud.ibm.com/v1", false},
+		{publicVisibility, apiGatewayService, "https://api.us-south.apigw.cloud.ibm.com/controller", false},
+		{privateVisibility, apiGatewayService, "", true},
+		{publicAndPrivateVisibility, apiGatewayService, "https://api.us-south.apigw.cloud.ibm.com/controller", false},
+	}
+	for _, tc := range cases {
+		c := &Config{Region: "us-south", Visibility: tc.visibility}
+		url, err := c.endpointFor(tc.service)
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("%s/%s: expected an error, got %q", tc.visibility, tc.service, url)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s/%s: unexpected error: %s", tc.visibility, tc.service, err)
+		}
+		if url != tc.expected {
+			t.Errorf("%s/%s: expected %q, got %q", tc.visibility, tc.service, tc.expected, url)
+		}
+	}
+}
+
+func TestEndpointForEnvOverride(t *testing.T) {
+	os.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "https://vpc.example.com/v1")
+	defer os.Unsetenv("IBMCLOUD_IS_NG_API_ENDPOINT")
+
+	c := &Config{Region: "us-south", Visibility: privateVisibility}
+	url, err := c.endpointFor(vpcService)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if url != "https://vpc.example.com/v1" {
+		t.Fatalf("expected the environment endpoint, got %q", url)
+	}
+}
+
+func TestVisibilityEndpointLocator(t *testing.T) {
+	os.Unsetenv("IBMCLOUD_IAM_API_ENDPOINT")
+	os.Unsetenv("IBMCLOUD_MCCP_API_ENDPOINT")
+
+	private := newConfigEndpointLocator(&Config{Region: "us-south", Visibility: privateVisibility})
+	if url, err := private.IAMEndpoint(); err != nil || url != "https://private.iam.cloud.ibm.com" {
+		t.Errorf("expected the private IAM endpoint, got %q (%v)", url, err)
+	}
+	if _, err := private.MCCPAPIEndpoint(); err == nil {
+		t.Errorf("expected an error for a service without private endpoint")
+	}
+
+	mixed := newConfigEndpointLocator(&Config{Region: "us-south", Visibility: publicAndPrivateVisibility})
+	if url, err := mixed.MCCPAPIEndpoint(); err != nil || url != "https://mccp.us-south.cf.cloud.ibm.com" {
+		t.Errorf("expected the public MCCP endpoint, got %q (%v)", url, err)
+	}
+
+	public := newConfigEndpointLocator(&Config{Region: "us-south", Visibility: publicVisibility})
+	if url, err := public.IAMEndpoint(); err != nil || url != "https://iam.cloud.ibm.com" {
+		t.Errorf("expected the public IAM endpoint, got %q (%v)", url, err)
+	}
+}
+
+func TestEndpointsFile(t *testing.T) {
+	os.Unsetenv("IBMCLOUD_IS_NG_API_ENDPOINT")
+	os.Unsetenv("IBMCLOUD_GT_API_ENDPOINT")
+
+	file, err := ioutil.TempFile("", "endpoints*.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString(`
+vpc:
+  public:
+    us-south: http://localhost:8080/v1
+  private:
+    us-south: http://localhost:8081/v1
+global_tagging:
+  public:
+    us-south: http://localhost:8082
+`)
+	file.Close()
+
+	c := &Config{Region: "us-south", Visibility: publicVisibility, EndpointsFile: file.Name()}
+	if err := c.loadEndpointsFile(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if url, _ := c.endpointFor(vpcService); url != "http://localhost:8080/v1" {
+		t.Errorf("expected the public file endpoint, got %q", url)
+	}
+	if url, _ := newConfigEndpointLocator(c).GlobalTaggingEndpoint(); url != "http://localhost:8082" {
+		t.Errorf("expected the file endpoint for bluemix-go clients, got %q", url)
+	}
+
+	c.Visibility = privateVisibility
+	if url, _ := c.endpointFor(vpcService); url != "http://localhost:8081/v1" {
+		t.Errorf("expected the private file endpoint, got %q", url)
+	}
+
+	c.Region = "eu-de"
+	if url, _ := c.endpointFor(vpcService); url != "https://eu-de.private.iaas.cloud.ibm.com/v1" {
+		t.Errorf("expected the built-in endpoint for a region missing from the file, got %q", url)
+	}
+}
+
+func TestEndpointsFileUnknownService(t *testing.T) {
+	file, err := ioutil.TempFile("", "endpoints*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString(`{"vcp": {"public": {"us-south": "https://example.com"}}}`)
+	file.Close()
+
+	c := &Config{Region: "us-south", EndpointsFile: file.Name()}
+	if err := c.loadEndpointsFile(); err == nil {
+		t.Fatal("expected an error for an unknown service")
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"os"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
 	"github.com/IBM/go-sdk-core/core"
@@ -17,10 +16,14 @@ const DefaultServiceURL = "https://us-south.functions.cloud.ibm.com"
 
 //FunctionClient ...
 func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
-	baseEndpoint := getBaseURL(c.Region)
+	baseEndpoint := functionsBaseURL(c)
 	u, _ := url.Parse(fmt.Sprintf("%s/api", baseEndpoint))
 
-	functionsClient, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	functionsClient, err := whisk.NewClient(httpClient, &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -46,7 +49,7 @@ func getBaseURL(region string) string {
  *
  */
 func setupOpenWhiskClientConfig(namespace string, c *bluemix.Config, wskClient *whisk.Client) (*whisk.Client, error) {
-	baseEndpoint := getBaseURL(c.Region)
+	baseEndpoint := functionsBaseURL(c)
 	apiOptions := &namespaceapi.IbmCloudFunctionsNamespaceOptions{
 		URL:           fmt.Sprintf("%s", baseEndpoint),
 		Authenticator: &core.NoAuthAuthenticator{},
@@ -58,7 +61,10 @@ func setupOpenWhiskClientConfig(namespace string, c *bluemix.Config, wskClient *
 		Headers: payload,
 	}
 
-	nsService, _ := namespaceapi.NewIbmCloudFunctionsNamespaceAPIV1(apiOptions)
+	nsService, err := functionsNamespaceAPI(c, apiOptions)
+	if err != nil {
+		return nil, err
+	}
 	nsList, _, err := nsService.GetNamespaces(namespaceOptions)
 	if err != nil {
 		return nil, err
@@ -70,9 +76,6 @@ func setupOpenWhiskClientConfig(namespace string, c *bluemix.Config, wskClient *
 	for _, n := range nsList.Namespaces {
 		allNamespaces = append(allNamespaces, n.GetName())
 		if n.GetName() == namespace || n.GetID() == namespace {
-			if os.Getenv("TF_LOG") != "" {
-				whisk.SetDebug(true)
-			}
 			if n.IsCf() {
 				isCFNamespace = true
 				break
@@ -107,7 +110,10 @@ func setupOpenWhiskClientConfig(namespace string, c *bluemix.Config, wskClient *
 			Headers: payload,
 		}
 
-		nsService, err := namespaceapi.NewIbmCloudFunctionsNamespaceAPIV1(apiOptions)
+		nsService, err := functionsNamespaceAPI(c, apiOptions)
+		if err != nil {
+			return nil, err
+		}
 		nsList, _, err := nsService.GetCloudFoundaryNamespaces(namespaceOptions)
 		if err != nil {
 			return nil, err
@@ -128,3 +134,17 @@ func setupOpenWhiskClientConfig(namespace string, c *bluemix.Config, wskClient *
 
 	return nil, fmt.Errorf("Failed to create whisk config object for namespace '%s'", namespace)
 }
+
+// functionsNamespaceAPI returns the namespace API of the functions endpoint. It sends
+// its requests with the HTTP client of the functions session, so they are recorded,
+// retried, audited and logged like those of the whisk client.
+func functionsNamespaceAPI(c *bluemix.Config, options *namespaceapi.IbmCloudFunctionsNamespaceOptions) (*namespaceapi.IbmCloudFunctionsNamespaceAPIV1, error) {
+	nsService, err := namespaceapi.NewIbmCloudFunctionsNamespaceAPIV1(options)
+	if err != nil {
+		return nil, err
+	}
+	if c.HTTPClient != nil {
+		nsService.Service.SetHTTPClient(c.HTTPClient)
+	}
+	return nsService, nil
+}
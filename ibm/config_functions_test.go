@@ -0,0 +1,44 @@
+package ibm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM/go-sdk-core/core"
+	namespaceapi "github.ibm.com/ibmcloud/namespace-go-sdk/ibmcloudfunctionsnamespaceapiv1"
+)
+
+type testCountingTransport struct {
+	requests int
+}
+
+func (t *testCountingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestFunctionsNamespaceAPI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"namespaces":[]}`))
+	}))
+	defer server.Close()
+
+	transport := &testCountingTransport{}
+	c := &bluemix.Config{HTTPClient: &http.Client{Transport: transport}}
+	nsService, err := functionsNamespaceAPI(c, &namespaceapi.IbmCloudFunctionsNamespaceOptions{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, _, err := nsService.GetNamespaces(&namespaceapi.GetNamespacesOptions{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if transport.requests != 1 {
+		t.Errorf("expected the namespace request to be sent with the client of the functions session, got %d requests", transport.requests)
+	}
+}
@@ -0,0 +1,356 @@
+package ibm
+
+import (
+	"io"
+	"log"
+	gohttp "net/http"
+	"net/http/httputil"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// The provider logs go through a redacting writer, which masks the credentials of the
+// log lines by key name, e.g. the Authorization header or the apikey and password
+// attributes, and by value: the credentials of the provider and the values of the
+// sensitive attributes of the resources are replaced wherever they appear. The HTTP
+// requests and responses of every client are dumped in the debug logs by the logging
+// transport, so they are redacted as well.
+
+const (
+	redactedValue = "REDACTED"
+
+	// redactedMinLength is the minimum length of the values redacted wherever they
+	// appear, shorter values are only redacted by key name
+	redactedMinLength = 8
+
+	// logDumpMaxSize is the maximum size of the HTTP dumps of the debug logs
+	logDumpMaxSize = 64 * 1024
+
+	// redactedKeyNames are the suffixes of the keys holding credentials
+	redactedKeyNames = `password|passwd|passphrase|secret|apikey|api_key|api-key|token|authorization|credentials|private_key|privatekey|private-key`
+)
+
+var (
+	// redactedKeyPattern matches a key holding a credential and its separator in JSON,
+	// escaped JSON, Go values, headers, forms and query strings
+	redactedKeyPattern     = regexp.MustCompile(`(?i)(\\?")?[\w.-]*(` + redactedKeyNames + `)(\\?")?[ \t]*[:=][ \t]*`)
+	redactedKeyNamePattern = regexp.MustCompile(`(?i)(` + redactedKeyNames + `)$`)
+
+	// The credentials recognized by their format: private keys in PEM, JSON web tokens
+	// such as the IAM access tokens and passwords in URLs
+	redactedPrivateKeyPattern  = regexp.MustCompile(`-----BEGIN[A-Z ]*PRIVATE KEY-----[\s\S]*?-----END[A-Z ]*PRIVATE KEY-----`)
+	redactedJWTPattern         = regexp.MustCompile(`eyJ[\w-]+\.eyJ[\w-]+\.[\w-]+`)
+	redactedURLPasswordPattern = regexp.MustCompile(`(://[^/:@\s"]+:)[^/@\s"]+@`)
+
+	// redactedAuthSchemes are the schemes of an Authorization header, redacted with
+	// the credential which follows them
+	redactedAuthSchemes = []string{"bearer ", "basic "}
+)
+
+// logRedactor redacts the logs of every provider configuration
+var logRedactor = &redactor{secrets: map[string]bool{}}
+
+// redactor masks the credentials of the log lines
+type redactor struct {
+	mu      sync.RWMutex
+	secrets map[string]bool
+}
+
+// addSecrets registers values which are redacted wherever they appear
+func (r *redactor) addSecrets(values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, value := range values {
+		value = strings.TrimPrefix(value, "Bearer ")
+		if len(value) >= redactedMinLength {
+			r.secrets[value] = true
+		}
+	}
+}
+
+// redact returns s with the credentials replaced by REDACTED
+func (r *redactor) redact(s string) string {
+	s = r.redactValues(s)
+	matches := redactedKeyPattern.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return s
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		if m[0] < last {
+			// The key is part of a value already redacted
+			continue
+		}
+		b.WriteString(s[last:m[1]])
+		value := s[m[1]:]
+		end := redactedValueEnd(value)
+		if end == 0 {
+			last = m[1]
+			continue
+		}
+		// The strings stay strings and the JSON objects become strings
+		quote := ""
+		switch {
+		case strings.HasPrefix(value, `\"`):
+			quote = `\"`
+		case strings.HasPrefix(value, `"`), m[2] >= 0 && strings.IndexAny(value[:1], "{[") == 0:
+			quote = `"`
+		}
+		b.WriteString(quote + redactedValue + quote)
+		last = m[1] + end
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// redactValues replaces the registered secrets and the credentials recognized by
+// their format
+func (r *redactor) redactValues(s string) string {
+	r.mu.RLock()
+	for secret := range r.secrets {
+		s = strings.Replace(s, secret, redactedValue, -1)
+	}
+	r.mu.RUnlock()
+	s = redactedPrivateKeyPattern.ReplaceAllString(s, redactedValue)
+	s = redactedJWTPattern.ReplaceAllString(s, redactedValue)
+	return redactedURLPasswordPattern.ReplaceAllString(s, "${1}"+redactedValue+"@")
+}
+
+// redactedValueEnd returns the length of the value at the start of s: a string, an
+// object, an array or a Go map, or else a word. The credential of an Authorization
+// header follows its scheme.
+func redactedValueEnd(s string) int {
+	switch {
+	case strings.HasPrefix(s, `\"`):
+		if end := strings.Index(s[2:], `\"`); end >= 0 {
+			return end + 4
+		}
+		return len(s)
+	case strings.HasPrefix(s, `"`):
+		return redactedStringEnd(s)
+	case strings.HasPrefix(s, "{"), strings.HasPrefix(s, "["), strings.HasPrefix(s, "map["):
+		return redactedBlockEnd(s)
+	}
+	for _, scheme := range redactedAuthSchemes {
+		if len(s) > len(scheme) && strings.EqualFold(s[:len(scheme)], scheme) {
+			return len(scheme) + redactedValueEnd(s[len(scheme):])
+		}
+	}
+	if end := strings.IndexAny(s, " \t\r\n,;&\"'}])"); end >= 0 {
+		return end
+	}
+	return len(s)
+}
+
+// redactedStringEnd returns the length of the JSON string at the start of s
+func redactedStringEnd(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// redactedBlockEnd returns the length of the object or array at the start of s, up to
+// its closing bracket
+func redactedBlockEnd(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			i += redactedStringEnd(s[i:]) - 1
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(s)
+}
+
+// redactingWriter redacts the log lines written to next
+type redactingWriter struct {
+	next io.Writer
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := w.next.Write([]byte(logRedactor.redact(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+var redactLogOutputMu sync.Mutex
+
+// redactLogOutput makes the standard logger redact its lines. The output of the logger
+// is set again by the plugin server and the tests, so it is wrapped when a provider
+// is configured.
+func redactLogOutput() {
+	redactLogOutputMu.Lock()
+	defer redactLogOutputMu.Unlock()
+	if _, ok := log.Writer().(*redactingWriter); !ok {
+		log.SetOutput(&redactingWriter{next: log.Writer()})
+	}
+}
+
+// logTransport wraps next to dump the requests and the responses of the service in
+// the debug logs
+func logTransport(service string, next gohttp.RoundTripper) gohttp.RoundTripper {
+	if !logging.IsDebugOrHigher() {
+		return next
+	}
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	return &loggingTransport{service: service, next: next}
+}
+
+type loggingTransport struct {
+	service string
+	next    gohttp.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	start := time.Now()
+	if dump, err := httputil.DumpRequestOut(req, logDumpBody(req.Header)); err == nil {
+		log.Printf("[DEBUG] %s request:\n%s", t.service, logDump(dump))
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		log.Printf("[DEBUG] %s request %s %s failed after %s: %s", t.service, req.Method, req.URL, time.Since(start), err)
+		return resp, err
+	}
+	if dump, err := httputil.DumpResponse(resp, logDumpBody(resp.Header)); err == nil {
+		log.Printf("[DEBUG] %s response after %s:\n%s", t.service, time.Since(start), logDump(dump))
+	}
+	return resp, err
+}
+
+// logDumpBody returns whether the body is text, the other bodies are not dumped
+func logDumpBody(header gohttp.Header) bool {
+	contentType := strings.ToLower(header.Get("Content-Type"))
+	for _, text := range []string{"json", "xml", "text/", "x-www-form-urlencoded", "yaml"} {
+		if strings.Contains(contentType, text) {
+			return true
+		}
+	}
+	return false
+}
+
+func logDump(dump []byte) string {
+	if len(dump) > logDumpMaxSize {
+		return string(dump[:logDumpMaxSize]) + "\n[TRUNCATED]"
+	}
+	return string(dump)
+}
+
+// redactResources wraps the operations of the resources and the data sources to
+// redact the values of their sensitive attributes, registered before the operation for
+// the values of the configuration and after it for the values read
+func redactResources(provider *schema.Provider) {
+	for _, r := range provider.ResourcesMap {
+		sch := r.Schema
+		if r.Create != nil {
+			r.Create = redactOperationFunc(sch, r.Create)
+		}
+		if r.Read != nil {
+			r.Read = redactOperationFunc(sch, r.Read)
+		}
+		if r.Update != nil {
+			r.Update = redactOperationFunc(sch, r.Update)
+		}
+		if r.Delete != nil {
+			r.Delete = redactOperationFunc(sch, r.Delete)
+		}
+	}
+	for _, r := range provider.DataSourcesMap {
+		if r.Read != nil {
+			r.Read = redactOperationFunc(r.Schema, r.Read)
+		}
+	}
+}
+
+func redactOperationFunc(sch map[string]*schema.Schema, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		redactSensitiveValues(sch, d)
+		err := f(d, meta)
+		redactSensitiveValues(sch, d)
+		return err
+	}
+}
+
+// redactSensitiveValues registers the values of the sensitive attributes of the
+// resource
+func redactSensitiveValues(sch map[string]*schema.Schema, d *schema.ResourceData) {
+	values := map[string]interface{}{}
+	for k := range sch {
+		values[k] = d.Get(k)
+	}
+	logRedactor.addSecrets(sensitiveValues(sch, values)...)
+}
+
+func sensitiveValues(sch map[string]*schema.Schema, values map[string]interface{}) []string {
+	var secrets []string
+	for k, s := range sch {
+		if s.Sensitive {
+			secrets = append(secrets, sensitiveStrings(values[k])...)
+			continue
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			for _, v := range sensitiveElements(values[k]) {
+				if m, ok := v.(map[string]interface{}); ok {
+					secrets = append(secrets, sensitiveValues(elem.Schema, m)...)
+				}
+			}
+		}
+	}
+	return secrets
+}
+
+// sensitiveStrings returns the strings of a value of any type. The values of a map
+// are those of the keys holding credentials, e.g. the apikey of the credentials of a
+// resource key, the other values such as the CRNs are not redacted.
+func sensitiveStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case map[string]interface{}:
+		var values []string
+		for k, e := range v {
+			if redactedKeyNamePattern.MatchString(k) {
+				values = append(values, sensitiveStrings(e)...)
+			} else if _, ok := e.(map[string]interface{}); ok {
+				values = append(values, sensitiveStrings(e)...)
+			}
+		}
+		return values
+	}
+	var values []string
+	for _, e := range sensitiveElements(value) {
+		values = append(values, sensitiveStrings(e)...)
+	}
+	return values
+}
+
+func sensitiveElements(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
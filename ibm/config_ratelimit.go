@@ -0,0 +1,130 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	gohttp "net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultRateLimits are the requests per second allowed by default for the services
+// known to throttle large applies. A rate of zero disables the limit of a service.
+var defaultRateLimits = map[string]float64{
+	// CIS allows 1200 requests per 5 minutes for a user
+	cisService:                4,
+	globalSearchService:       5,
+	globalTaggingService:      5,
+	resourceControllerService: 10,
+}
+
+// rateLimiter is a token bucket allowing rate requests per second with bursts of up
+// to burst requests. Requests beyond the burst wait for their reserved token.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64) *rateLimiter {
+	burst := rate
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.After(l.last) {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+	}
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back a token reserved by a request which did not wait for it
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// loadRateLimits creates the limiters of the services from the defaults and the
+// rate_limits configured in the provider.
+func (c *Config) loadRateLimits() error {
+	rates := map[string]float64{}
+	for service, rate := range defaultRateLimits {
+		rates[service] = rate
+	}
+	for service, rate := range c.RateLimits {
+		if _, ok := serviceEndpoints[service]; !ok {
+			return fmt.Errorf("Unknown service %q in rate_limits, supported services are %s", service, strings.Join(endpointServiceNames(), ", "))
+		}
+		rates[service] = rate
+	}
+	c.rateLimiters = map[string]*rateLimiter{}
+	for service, rate := range rates {
+		if rate > 0 {
+			c.rateLimiters[service] = newRateLimiter(rate)
+		}
+	}
+	return nil
+}
+
+// serviceTransport wraps next with the debug logs, the audit log, the rate limit of
+// the service and the retry policy, retries are rate limited, audited and logged as
+// well.
+func (c *Config) serviceTransport(service string, next gohttp.RoundTripper) gohttp.RoundTripper {
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	next = c.auditLog.transport(service, logTransport(service, next))
+	if limiter, ok := c.rateLimiters[service]; ok {
+		next = &rateLimitTransport{service: service, limiter: limiter, next: next}
+	}
+	return c.retryTransport(next)
+}
+
+type rateLimitTransport struct {
+	service string
+	limiter *rateLimiter
+	next    gohttp.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if wait := t.limiter.reserve(time.Now()); wait > 0 {
+		log.Printf("[DEBUG] Delaying %s %s by %s to stay within the rate limit of %g requests per second of the %s service",
+			req.Method, req.URL.Redacted(), wait, t.limiter.rate, t.service)
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			t.limiter.cancel()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+	return t.next.RoundTrip(req)
+}
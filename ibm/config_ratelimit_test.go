@@ -0,0 +1,48 @@
+package ibm
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(2)
+	now := limiter.last
+
+	// The burst is served right away
+	for i := 0; i < 2; i++ {
+		if wait := limiter.reserve(now); wait != 0 {
+			t.Fatalf("request %d: expected no wait within the burst, got %s", i, wait)
+		}
+	}
+	if wait := limiter.reserve(now); wait != 500*time.Millisecond {
+		t.Fatalf("expected the third request to wait 500ms, got %s", wait)
+	}
+	if wait := limiter.reserve(now); wait != time.Second {
+		t.Fatalf("expected the fourth request to wait 1s, got %s", wait)
+	}
+	if wait := limiter.reserve(now.Add(2 * time.Second)); wait != 0 {
+		t.Fatalf("expected the bucket to be refilled, got %s", wait)
+	}
+}
+
+func TestLoadRateLimits(t *testing.T) {
+	c := &Config{RateLimits: map[string]float64{globalTaggingService: 1, cisService: 0}}
+	if err := c.loadRateLimits(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if limiter, ok := c.rateLimiters[globalTaggingService]; !ok || limiter.rate != 1 {
+		t.Errorf("expected the configured rate limit for global_tagging")
+	}
+	if _, ok := c.rateLimiters[cisService]; ok {
+		t.Errorf("expected a rate of 0 to disable the default limit of cis")
+	}
+	if limiter, ok := c.rateLimiters[globalSearchService]; !ok || limiter.rate != defaultRateLimits[globalSearchService] {
+		t.Errorf("expected the default rate limit for global_search")
+	}
+
+	c = &Config{RateLimits: map[string]float64{"global_tags": 1}}
+	if err := c.loadRateLimits(); err == nil {
+		t.Errorf("expected an error for an unknown service")
+	}
+}
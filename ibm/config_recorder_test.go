@@ -0,0 +1,219 @@
+package ibm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+const (
+	testRecorderAPIKey  = "my-secret-api-key"
+	testRecorderAccount = "0123456789abcdef0123456789abcdef"
+)
+
+// testRecorderToken returns an IAM access token of the test account
+func testRecorderToken() string {
+	payload, _ := json.Marshal(map[string]interface{}{
+		"id":      "IBMid-123",
+		"email":   "user@example.com",
+		"account": map[string]string{"bss": testRecorderAccount},
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"exp":     1600000000,
+	})
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(payload) + ".c2lnbmF0dXJl"
+}
+
+// testRecorderSession sends a token request, a versioned list of the VPCs of the
+// account and the creation of a VPC named name, and returns the created VPC
+func testRecorderSession(t *testing.T, client *http.Client, endpoint, account, name, version string) (string, map[string]interface{}) {
+	form := url.Values{"grant_type": {"urn:ibm:params:oauth:grant-type:apikey"}, "apikey": {testRecorderAPIKey}}
+	resp, err := client.PostForm(endpoint+"/identity/token", form)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	token := map[string]interface{}{}
+	json.NewDecoder(resp.Body).Decode(&token)
+	resp.Body.Close()
+
+	resp, err = client.Get(endpoint + "/v1/vpcs?account_id=" + account + "&version=" + version)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	resp, err = client.Post(endpoint+"/v1/vpcs", "application/json", strings.NewReader(`{"name":"`+name+`"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected the VPC to be created, got %d", resp.StatusCode)
+	}
+	vpc := map[string]interface{}{}
+	json.NewDecoder(resp.Body).Decode(&vpc)
+	accessToken, _ := token["access_token"].(string)
+	return accessToken, vpc
+}
+
+func TestHTTPRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "nested", "TestHTTPRecorder.jsonl")
+	scale := asyncWaiterTimeScale
+	defer func() {
+		asyncWaiterTimeScale = scale
+		recordersMu.Lock()
+		delete(recorders, recordMode+":"+cassette)
+		delete(recorders, replayMode+":"+cassette)
+		recordersMu.Unlock()
+	}()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		switch {
+		case r.URL.Path == "/identity/token":
+			json.NewEncoder(w).Encode(map[string]string{"access_token": testRecorderToken(), "refresh_token": "my-refresh-token"})
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`{"vpcs":[]}`))
+		default:
+			body := map[string]string{}
+			json.NewDecoder(r.Body).Decode(&body)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]string{
+				"id":   "r006-1",
+				"name": body["name"],
+				"crn":  "crn:v1:bluemix:public:is:us-south:a/" + testRecorderAccount + "::vpc:r006-1",
+			})
+		}
+	}))
+
+	config := &Config{BluemixAPIKey: testRecorderAPIKey}
+	os.Setenv(recorderModeEnv, recordMode)
+	os.Setenv(recorderCassetteEnv, cassette)
+	defer os.Unsetenv(recorderModeEnv)
+	defer os.Unsetenv(recorderCassetteEnv)
+	if err := config.loadRecorder(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if asyncWaiterTimeScale != scale {
+		t.Errorf("expected the waits of the recording not to be scaled, got %v", asyncWaiterTimeScale)
+	}
+	client := &http.Client{Transport: config.recorder.transport(nil)}
+	if _, vpc := testRecorderSession(t, client, server.URL, testRecorderAccount, "tf-vpc-42", "2020-06-01"); vpc["name"] != "tf-vpc-42" {
+		t.Fatalf("expected the recorded VPC, got %v", vpc)
+	}
+	server.Close()
+
+	recorded, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, secret := range []string{testRecorderAPIKey, testRecorderAccount, testRecorderToken(), "my-refresh-token", "session=secret"} {
+		if strings.Contains(string(recorded), secret) {
+			t.Errorf("expected %q to be scrubbed from the cassette:\n%s", secret, recorded)
+		}
+	}
+	if n := strings.Count(string(recorded), "\n"); n != 3 {
+		t.Errorf("expected 3 recorded interactions, got %d", n)
+	}
+
+	// The replay needs no server, the dates and the random names may differ and the
+	// account is the scrubbed account of the replayed token
+	os.Setenv(recorderModeEnv, replayMode)
+	config = &Config{BluemixAPIKey: "replayed-api-key"}
+	if err := config.loadRecorder(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if asyncWaiterTimeScale != replayTimeScale {
+		t.Errorf("expected the waits of the replay to be scaled, got %v", asyncWaiterTimeScale)
+	}
+	client = &http.Client{Transport: config.recorder.transport(nil)}
+	token, vpc := testRecorderSession(t, client, server.URL, scrubbedAccountID, "tf-vpc-77", "2020-06-02")
+	if vpc["name"] != "tf-vpc-77" || !strings.Contains(vpc["crn"].(string), "a/"+scrubbedAccountID+":") {
+		t.Errorf("expected the replayed VPC with the replayed name, got %v", vpc)
+	}
+	user, err := fetchUserDetails(&bxsession.Session{Config: &bluemix.Config{IAMAccessToken: "Bearer " + token}}, 2)
+	if err != nil {
+		t.Fatalf("expected the scrubbed access token to be valid, got %s", err)
+	}
+	if user.userAccount != scrubbedAccountID || user.cloudName != "bluemix" {
+		t.Errorf("expected the scrubbed account, got %+v", user)
+	}
+	if expiry := tokenExpiry(token); expiry.Unix() != scrubbedTokenExp {
+		t.Errorf("expected the scrubbed access token to expire in 2100, got %s", expiry)
+	}
+
+	if _, err := client.Get(server.URL + "/v1/vpcs?version=2020-06-02"); err == nil || !strings.Contains(err.Error(), "no interaction left") {
+		t.Errorf("expected an error for a request which was not recorded, got %v", err)
+	}
+}
+
+func TestHTTPRecorderInvalidMode(t *testing.T) {
+	os.Setenv(recorderModeEnv, "playback")
+	defer os.Unsetenv(recorderModeEnv)
+	if err := (&Config{}).loadRecorder(); err == nil {
+		t.Errorf("expected an error for an invalid mode")
+	}
+}
+
+func TestHTTPRecorderClients(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "TestHTTPRecorderClients.jsonl")
+	defer func() {
+		recordersMu.Lock()
+		delete(recorders, recordMode+":"+cassette)
+		recordersMu.Unlock()
+	}()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	config := &Config{}
+	os.Setenv(recorderModeEnv, recordMode)
+	os.Setenv(recorderCassetteEnv, cassette)
+	defer os.Unsetenv(recorderModeEnv)
+	defer os.Unsetenv(recorderCassetteEnv)
+	if err := config.loadRecorder(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	sess := &clientSession{config: config, session: &Session{tokens: newIAMTokenManager(), recorder: config.recorder}}
+
+	// The s3 clients and the token refreshers are not built by the session
+	if _, err := s3HTTPClient(sess).Get(server.URL + "/my-bucket"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	form := url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"my-refresh-token"}}
+	if _, err := sess.iamTokenClient(&bluemix.Config{}).PostForm(server.URL+"/identity/token", form); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	recorded, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.Contains(string(recorded), "/my-bucket") || !strings.Contains(string(recorded), "/identity/token") {
+		t.Errorf("expected the requests of the s3 client and the token refresher to be recorded:\n%s", recorded)
+	}
+	if s3HTTPClient(nil) != nil {
+		t.Errorf("expected the default HTTP client without a client session")
+	}
+}
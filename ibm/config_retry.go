@@ -0,0 +1,177 @@
+package ibm
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	gohttp "net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// DefaultRetryMaxWait is the default upper bound of the wait between two retries
+const DefaultRetryMaxWait = 30 * time.Second
+
+// retryTransport retries requests that failed because the service is throttling or
+// temporarily unavailable. The wait between attempts grows exponentially from
+// minWait up to maxWait with jitter, unless the service asks for a delay with the
+// Retry-After header.
+type retryTransport struct {
+	next       gohttp.RoundTripper
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+}
+
+func newRetryTransport(next gohttp.RoundTripper, maxRetries int, minWait, maxWait time.Duration) gohttp.RoundTripper {
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	if minWait <= 0 {
+		minWait = time.Second
+	}
+	if maxWait < minWait {
+		maxWait = minWait
+	}
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		minWait:    minWait,
+		maxWait:    maxWait,
+	}
+}
+
+// retryTransport wraps next with the retry policy configured in the provider
+func (c *Config) retryTransport(next gohttp.RoundTripper) gohttp.RoundTripper {
+	maxWait := c.RetryMaxWait
+	if maxWait == 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+	return newRetryTransport(next, c.RetryCount, c.RetryDelay, maxWait)
+}
+
+func (t *retryTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	attempt := req
+	for retry := 0; ; retry++ {
+		resp, err := t.next.RoundTrip(attempt)
+		if retry >= t.maxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+		next, rewindErr := rewindRequest(req)
+		if rewindErr != nil {
+			return resp, err
+		}
+
+		wait := t.backoff(retry, resp)
+		if err != nil {
+			log.Printf("[DEBUG] Retrying %s %s in %s after error: %s (retry %d of %d)", req.Method, req.URL.Redacted(), wait, err, retry+1, t.maxRetries)
+		} else {
+			log.Printf("[DEBUG] Retrying %s %s in %s after status %s (retry %d of %d)", req.Method, req.URL.Redacted(), wait, resp.Status, retry+1, t.maxRetries)
+			// Drain the body so that the connection can be reused
+			io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		attempt = next
+	}
+}
+
+// shouldRetry retries throttled requests, which were not processed by the service,
+// and idempotent requests on gateway errors and connection resets.
+func (t *retryTransport) shouldRetry(req *gohttp.Request, resp *gohttp.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(req) && isConnectionReset(err)
+	}
+	switch resp.StatusCode {
+	case gohttp.StatusTooManyRequests:
+		return true
+	case gohttp.StatusBadGateway, gohttp.StatusServiceUnavailable, gohttp.StatusGatewayTimeout:
+		return isIdempotent(req)
+	}
+	return false
+}
+
+// backoff returns the wait before the given retry, honoring Retry-After
+func (t *retryTransport) backoff(retry int, resp *gohttp.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > t.maxWait {
+				return t.maxWait
+			}
+			return wait
+		}
+	}
+	wait := t.maxWait
+	if retry < 32 {
+		if exp := t.minWait << uint(retry); exp > 0 && exp < t.maxWait {
+			wait = exp
+		}
+	}
+	// Equal jitter keeps at least half of the exponential wait
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// retryAfter parses the Retry-After header, given in seconds or as an HTTP date
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := gohttp.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+func isIdempotent(req *gohttp.Request) bool {
+	switch req.Method {
+	case gohttp.MethodGet, gohttp.MethodHead, gohttp.MethodOptions, gohttp.MethodPut, gohttp.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != "" || req.Header.Get("X-Idempotency-Key") != ""
+}
+
+func isConnectionReset(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	return strings.Contains(err.Error(), "connection reset by peer")
+}
+
+// rewindRequest returns a copy of req with a fresh body for the next attempt
+func rewindRequest(req *gohttp.Request) (*gohttp.Request, error) {
+	next := req.Clone(req.Context())
+	if req.Body == nil || req.Body == gohttp.NoBody {
+		return next, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("request body cannot be replayed")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	next.Body = body
+	return next, nil
+}
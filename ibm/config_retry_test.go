@@ -0,0 +1,74 @@
+package ibm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransportThrottled(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(nil, 5, time.Millisecond, 10*time.Millisecond)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name": "test"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK || calls != 3 {
+		t.Fatalf("expected success after 3 calls, got status %d after %d calls", resp.StatusCode, calls)
+	}
+}
+
+func TestRetryTransportNotIdempotent(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(nil, 3, time.Millisecond, 10*time.Millisecond)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable || calls != 1 {
+		t.Fatalf("expected a POST not to be retried on 503, got %d calls", calls)
+	}
+
+	calls = 0
+	resp, err = client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 4 {
+		t.Fatalf("expected a GET to be retried 3 times on 503, got %d calls", calls)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := newRetryTransport(nil, 10, time.Second, 8*time.Second).(*retryTransport)
+	for retry, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second} {
+		wait := transport.backoff(retry, nil)
+		if wait < max/2 || wait > max {
+			t.Errorf("retry %d: expected a wait between %s and %s, got %s", retry, max/2, max, wait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if wait := transport.backoff(0, resp); wait != 8*time.Second {
+		t.Errorf("expected Retry-After to be capped by the maximum wait, got %s", wait)
+	}
+}
@@ -0,0 +1,35 @@
+package ibm
+
+import (
+	"os"
+	"testing"
+)
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	for _, env := range []string{"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY", "IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN", "IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN"} {
+		if v, ok := os.LookupEnv(env); ok {
+			os.Unsetenv(env)
+			defer os.Setenv(env, v)
+		}
+	}
+
+	c := &Config{Region: "us-south", Visibility: publicVisibility}
+	meta, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	sess := meta.(ClientSession)
+
+	if _, err := sess.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Errorf("expected errEmptyBluemixCredentials for the VPC client, got %v", err)
+	}
+	if _, err := sess.CisDNSRecordClientSession(); err != errEmptyBluemixCredentials {
+		t.Errorf("expected errEmptyBluemixCredentials for the CIS client, got %v", err)
+	}
+	if _, err := sess.GlobalTaggingAPI(); err != errEmptyBluemixCredentials {
+		t.Errorf("expected errEmptyBluemixCredentials for the Global Tagging client, got %v", err)
+	}
+	if sess.SoftLayerSession() == nil {
+		t.Errorf("expected a SoftLayer session")
+	}
+}
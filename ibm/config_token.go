@@ -0,0 +1,267 @@
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	gohttp "net/http"
+	"strings"
+	"sync"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+	"github.com/IBM/go-sdk-core/v4/core"
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// tokenRefreshWindow is the fraction of the token lifetime left when it is refreshed
+const tokenRefreshWindow = 0.2
+
+// tokenRefreshFunc obtains a new IAM access token and its expiry
+type tokenRefreshFunc func() (string, time.Time, error)
+
+// iamTokenManager holds the IAM access token shared by every client of a session.
+// The token is refreshed before it expires and when a service rejects it, a single
+// refresh is made for the requests which failed concurrently with the same token.
+type iamTokenManager struct {
+	refresh tokenRefreshFunc
+
+	// refreshMu serializes the refreshes, mu guards the token
+	refreshMu   sync.Mutex
+	mu          sync.RWMutex
+	accessToken string
+	expiry      time.Time
+	refreshAt   time.Time
+	// issued holds every access token handed out, so stale tokens can be recognised
+	// in requests built from an earlier copy of the session configuration
+	issued map[string]bool
+}
+
+func newIAMTokenManager() *iamTokenManager {
+	return &iamTokenManager{
+		issued: map[string]bool{},
+	}
+}
+
+// set records the current token and the function refreshing it. An expiry of zero
+// is read from the token claims.
+func (m *iamTokenManager) set(token string, expiry time.Time, refresh tokenRefreshFunc) {
+	m.refreshMu.Lock()
+	defer m.refreshMu.Unlock()
+	m.refresh = refresh
+	m.store(token, expiry)
+}
+
+func (m *iamTokenManager) store(token string, expiry time.Time) {
+	token = strings.TrimPrefix(token, "Bearer ")
+	now := time.Now()
+	if expiry.IsZero() {
+		expiry = tokenExpiry(token)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.accessToken = token
+	m.expiry = expiry
+	m.refreshAt = time.Time{}
+	if !expiry.IsZero() {
+		m.refreshAt = expiry.Add(-time.Duration(float64(expiry.Sub(now)) * tokenRefreshWindow))
+	}
+	m.issued[token] = true
+}
+
+func (m *iamTokenManager) current() (token string, refreshAt, expiry time.Time) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.accessToken, m.refreshAt, m.expiry
+}
+
+// token returns a valid IAM access token, refreshing it once it enters its refresh
+// window.
+func (m *iamTokenManager) token() (string, error) {
+	token, refreshAt, expiry := m.current()
+	now := time.Now()
+	if token != "" && (refreshAt.IsZero() || now.Before(refreshAt)) {
+		return token, nil
+	}
+	refreshed, err := m.refreshToken(token)
+	if err != nil {
+		// Keep using the current token while it is still valid, the refresh is
+		// retried on the next request
+		if token != "" && now.Before(expiry) {
+			log.Printf("[WARN] Error refreshing the IAM token, using the current token: %s", err)
+			return token, nil
+		}
+		return "", err
+	}
+	return refreshed, nil
+}
+
+// refreshToken replaces the stale token. Callers which waited for another refresh of
+// the same stale token get its result without refreshing again.
+func (m *iamTokenManager) refreshToken(stale string) (string, error) {
+	m.refreshMu.Lock()
+	defer m.refreshMu.Unlock()
+	token, refreshAt, _ := m.current()
+	if token != stale && token != "" && (refreshAt.IsZero() || time.Now().Before(refreshAt)) {
+		return token, nil
+	}
+	if m.refresh == nil {
+		if token == "" {
+			return "", errors.New("No IAM token is available")
+		}
+		return token, nil
+	}
+	log.Println("[DEBUG] Refreshing the IAM token")
+	refreshed, expiry, err := m.refresh()
+	if err != nil {
+		return "", err
+	}
+	m.store(refreshed, expiry)
+	return strings.TrimPrefix(refreshed, "Bearer "), nil
+}
+
+// isIssued reports whether the bearer token was handed out by the manager
+func (m *iamTokenManager) isIssued(token string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.issued[token]
+}
+
+// AuthenticationType implements core.Authenticator
+func (m *iamTokenManager) AuthenticationType() string {
+	return core.AUTHTYPE_BEARER_TOKEN
+}
+
+// Authenticate implements core.Authenticator
+func (m *iamTokenManager) Authenticate(req *gohttp.Request) error {
+	token, err := m.token()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Validate implements core.Authenticator
+func (m *iamTokenManager) Validate() error {
+	if token, _, _ := m.current(); token == "" {
+		return errors.New("No IAM token is available")
+	}
+	return nil
+}
+
+// transport wraps next so that requests carrying a token issued by the manager are
+// sent with the current token, and replayed once with a refreshed token when the
+// service rejects it. Clients which copy the token when they are built, like the
+// bluemix-go services, Key Protect, Power and SoftLayer, stay authenticated for the
+// whole apply.
+func (m *iamTokenManager) transport(next gohttp.RoundTripper) gohttp.RoundTripper {
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	return &iamTokenTransport{tokens: m, next: next}
+}
+
+type iamTokenTransport struct {
+	tokens *iamTokenManager
+	next   gohttp.RoundTripper
+}
+
+func (t *iamTokenTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") || !t.tokens.isIssued(auth[7:]) {
+		return t.next.RoundTrip(req)
+	}
+	token, err := t.tokens.token()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.send(req, token)
+	if err != nil || resp.StatusCode != gohttp.StatusUnauthorized {
+		return resp, err
+	}
+
+	replay, rewindErr := rewindRequest(req)
+	if rewindErr != nil {
+		return resp, nil
+	}
+	refreshed, refreshErr := t.tokens.refreshToken(token)
+	if refreshErr != nil {
+		log.Printf("[WARN] Error refreshing the IAM token rejected by %s: %s", req.URL.Host, refreshErr)
+		return resp, nil
+	}
+	if refreshed == token {
+		return resp, nil
+	}
+	log.Printf("[DEBUG] Replaying %s %s with a refreshed IAM token", req.Method, req.URL.Redacted())
+	resp.Body.Close()
+	return t.send(replay, refreshed)
+}
+
+func (t *iamTokenTransport) send(req *gohttp.Request, token string) (*gohttp.Response, error) {
+	if req.Header.Get("Authorization") != "Bearer "+token {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// tokenExpiry reads the expiry from the claims of a JWT token, it returns the zero
+// time when the token has no expiry.
+func tokenExpiry(token string) time.Time {
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, claims); err != nil {
+		return time.Time{}
+	}
+	if exp, ok := claims["exp"].(float64); ok {
+		return time.Unix(int64(exp), 0)
+	}
+	return time.Time{}
+}
+
+// apiKeyTokenRefresher authenticates again with the API key of the configuration
+func apiKeyTokenRefresher(config *bluemix.Config, client *gohttp.Client) tokenRefreshFunc {
+	config = config.Copy()
+	// The token requests must not go through the token manager transport
+	config.HTTPClient = client
+	return func() (string, time.Time, error) {
+		tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+			DefaultHeader: gohttp.Header{
+				"User-Agent": []string{http.UserAgent()},
+			},
+			HTTPClient: client,
+		})
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		if err := tokenRefresher.AuthenticateAPIKey(config.BluemixAPIKey); err != nil {
+			return "", time.Time{}, fmt.Errorf("Error occured while authenticating with the IBM Cloud API key: %q", err)
+		}
+		return config.IAMAccessToken, time.Time{}, nil
+	}
+}
+
+// refreshTokenRefresher exchanges the IAM refresh token of the configuration
+func refreshTokenRefresher(config *bluemix.Config, client *gohttp.Client) tokenRefreshFunc {
+	config = config.Copy()
+	config.HTTPClient = client
+	return func() (string, time.Time, error) {
+		tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+			DefaultHeader: gohttp.Header{
+				"User-Agent": []string{http.UserAgent()},
+			},
+			HTTPClient: client,
+		})
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		token, err := tokenRefresher.RefreshToken()
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("Error occured while refreshing the IAM token: %q", err)
+		}
+		return token, time.Time{}, nil
+	}
+}
@@ -0,0 +1,98 @@
+package ibm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIAMTokenTransportReplaysUnauthorized(t *testing.T) {
+	var current atomic.Value
+	current.Store("token-1")
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+current.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer api.Close()
+
+	var refreshes int32
+	tokens := newIAMTokenManager()
+	tokens.set("Bearer token-1", time.Now().Add(time.Hour), func() (string, time.Time, error) {
+		n := atomic.AddInt32(&refreshes, 1)
+		// Let the concurrent requests pile up behind the refresh
+		time.Sleep(50 * time.Millisecond)
+		return fmt.Sprintf("token-%d", n+1), time.Now().Add(time.Hour), nil
+	})
+
+	// The token is revoked, every request is rejected once and replayed
+	current.Store("token-2")
+	client := &http.Client{Transport: tokens.transport(nil)}
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodPost, api.URL, strings.NewReader(`{}`))
+			req.Header.Set("Authorization", "Bearer token-1")
+			resp, err := client.Do(req)
+			if err != nil {
+				errs <- err
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				errs <- fmt.Errorf("expected the request to be replayed, got status %d", resp.StatusCode)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+	if refreshes != 1 {
+		t.Errorf("expected a single refresh, got %d", refreshes)
+	}
+
+	// A token still rejected after the refresh is not replayed again
+	current.Store("other")
+	req, _ := http.NewRequest(http.MethodGet, api.URL, nil)
+	req.Header.Set("Authorization", "Bearer token-2")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized || refreshes != 2 {
+		t.Errorf("expected the 401 to be returned after one refresh, got status %d after %d refreshes", resp.StatusCode, refreshes)
+	}
+}
+
+func TestIAMTokenManagerRefreshWindow(t *testing.T) {
+	tokens := newIAMTokenManager()
+	tokens.set("token-1", time.Now().Add(-time.Second), func() (string, time.Time, error) {
+		return "", time.Time{}, fmt.Errorf("IAM is unavailable")
+	})
+	if _, err := tokens.token(); err == nil {
+		t.Errorf("expected an error for an expired token which cannot be refreshed")
+	}
+
+	tokens.set("token-1", time.Now().Add(time.Millisecond), func() (string, time.Time, error) {
+		return "token-2", time.Now().Add(time.Hour), nil
+	})
+	time.Sleep(time.Millisecond)
+	if token, err := tokens.token(); err != nil || token != "token-2" {
+		t.Errorf("expected the token to be refreshed once expired, got %q, %v", token, err)
+	}
+	if !tokens.isIssued("token-1") || !tokens.isIssued("token-2") {
+		t.Errorf("expected both tokens to be recognised as issued")
+	}
+}
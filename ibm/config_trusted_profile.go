@@ -0,0 +1,180 @@
+package ibm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// crTokenGrantType is the IAM grant used to exchange a compute resource token
+	crTokenGrantType = "urn:ibm:params:oauth:grant-type:cr-token"
+
+	// defaultInstanceMetadataEndpoint is the link-local address of the VPC instance metadata service
+	defaultInstanceMetadataEndpoint = "http://169.254.169.254"
+	instanceMetadataTokenPath       = "/instance_identity/v1/token"
+	instanceMetadataVersion         = "2022-03-01"
+
+	// crTokenLifetime is the lifetime in seconds requested for instance identity tokens
+	crTokenLifetime = 3600
+)
+
+// crTokenFunc returns a compute resource token identifying the workload running the provider
+type crTokenFunc func() (string, error)
+
+// crTokenFromFile reads the compute resource token from a file, e.g. the projected
+// service account token of an IKS or OpenShift pod. The file is read on every
+// exchange as the token is rotated by the platform.
+func crTokenFromFile(path string) crTokenFunc {
+	return func() (string, error) {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("Error reading the compute resource token file %s: %s", path, err)
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", fmt.Errorf("The compute resource token file %s is empty", path)
+		}
+		return token, nil
+	}
+}
+
+// crTokenFromInstanceMetadata fetches an instance identity token from the VPC
+// instance metadata service of the virtual server instance running the provider.
+func crTokenFromInstanceMetadata(endpoint string, client *gohttp.Client) crTokenFunc {
+	return func() (string, error) {
+		body, _ := json.Marshal(map[string]int{"expires_in": crTokenLifetime})
+		req, err := gohttp.NewRequest(gohttp.MethodPut,
+			fmt.Sprintf("%s%s?version=%s", strings.TrimRight(endpoint, "/"), instanceMetadataTokenPath, instanceMetadataVersion),
+			bytes.NewReader(body))
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Metadata-Flavor", "ibm")
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+
+		var token struct {
+			AccessToken string `json:"access_token"`
+		}
+		if err := doTokenRequest(client, req, &token); err != nil {
+			return "", fmt.Errorf("Error fetching the instance identity token from %s: %s", endpoint, err)
+		}
+		return token.AccessToken, nil
+	}
+}
+
+// iamProfileTokenSource exchanges compute resource tokens for IAM access tokens of a
+// trusted profile. The tokens are held and refreshed by the token manager of the
+// session.
+type iamProfileTokenSource struct {
+	iamURL      string
+	profileID   string
+	profileName string
+	crToken     crTokenFunc
+	client      *gohttp.Client
+}
+
+func newIAMProfileTokenSource(c *Config) (*iamProfileTokenSource, error) {
+	if c.IAMProfileID != "" && c.IAMProfileName != "" {
+		return nil, fmt.Errorf("Only one of iam_profile_id or iam_profile_name can be provided")
+	}
+	iamURL, err := c.endpointFor(iamService)
+	if err != nil {
+		return nil, err
+	}
+	client := &gohttp.Client{
+		Transport: DefaultTransport(),
+		Timeout:   c.BluemixTimeout,
+	}
+	crToken := crTokenFromInstanceMetadata(instanceMetadataEndpoint(), client)
+	if c.IAMCRTokenFile != "" {
+		crToken = crTokenFromFile(c.IAMCRTokenFile)
+	}
+	return &iamProfileTokenSource{
+		iamURL:      iamURL,
+		profileID:   c.IAMProfileID,
+		profileName: c.IAMProfileName,
+		crToken:     crToken,
+		// The token requests are recorded and logged, but not authenticated by the
+		// token manager
+		client: &gohttp.Client{
+			Transport: c.recorder.transport(c.serviceTransport(iamService, DefaultTransport())),
+			Timeout:   c.BluemixTimeout,
+		},
+	}, nil
+}
+
+// usesTrustedProfile reports whether the provider authenticates with a trusted profile
+func (c *Config) usesTrustedProfile() bool {
+	return c.IAMProfileID != "" || c.IAMProfileName != ""
+}
+
+func instanceMetadataEndpoint() string {
+	if endpoint := os.Getenv("IBMCLOUD_INSTANCE_METADATA_ENDPOINT"); endpoint != "" {
+		return endpoint
+	}
+	return defaultInstanceMetadataEndpoint
+}
+
+// exchange obtains a trusted profile token for a new compute resource token, it is
+// the tokenRefreshFunc of sessions authenticated with a trusted profile
+func (s *iamProfileTokenSource) exchange() (string, time.Time, error) {
+	crToken, err := s.crToken()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", crTokenGrantType)
+	form.Set("cr_token", crToken)
+	if s.profileID != "" {
+		form.Set("profile_id", s.profileID)
+	} else {
+		form.Set("profile_name", s.profileName)
+	}
+	req, err := gohttp.NewRequest(gohttp.MethodPost, strings.TrimRight(s.iamURL, "/")+"/identity/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+		Expiration  int64  `json:"expiration"`
+	}
+	if err := doTokenRequest(s.client, req, &token); err != nil {
+		return "", time.Time{}, fmt.Errorf("Error occured while exchanging the compute resource token for a trusted profile token: %q", err)
+	}
+
+	expiry := time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	if token.Expiration > 0 {
+		expiry = time.Unix(token.Expiration, 0)
+	}
+	log.Printf("[DEBUG] Obtained trusted profile token valid until %s", expiry.Format(time.RFC3339))
+	return token.AccessToken, expiry, nil
+}
+
+func doTokenRequest(client *gohttp.Client, req *gohttp.Request, result interface{}) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, result)
+}
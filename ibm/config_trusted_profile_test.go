@@ -0,0 +1,97 @@
+package ibm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestIAMProfileTokenSourceInstanceMetadata(t *testing.T) {
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != instanceMetadataTokenPath || r.Header.Get("Metadata-Flavor") != "ibm" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{"access_token": "cr-token"}`)
+	}))
+	defer metadata.Close()
+
+	exchanges := 0
+	iam := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.URL.Path != "/identity/token" || r.Form.Get("grant_type") != crTokenGrantType ||
+			r.Form.Get("cr_token") != "cr-token" || r.Form.Get("profile_id") != "iam-Profile-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		exchanges++
+		// An expiry of zero puts the token in its refresh window right away
+		fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": 0}`, exchanges)
+	}))
+	defer iam.Close()
+
+	source := &iamProfileTokenSource{
+		iamURL:    iam.URL,
+		profileID: "iam-Profile-1",
+		crToken:   crTokenFromInstanceMetadata(metadata.URL, http.DefaultClient),
+		client:    http.DefaultClient,
+	}
+	token, expiry, err := source.exchange()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != "token-1" {
+		t.Fatalf("expected token-1, got %q", token)
+	}
+	tokens := newIAMTokenManager()
+	tokens.set(token, expiry, source.exchange)
+
+	var received string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("Authorization")
+	}))
+	defer api.Close()
+
+	client := &http.Client{Transport: tokens.transport(nil)}
+	req, _ := http.NewRequest(http.MethodGet, api.URL, nil)
+	req.Header.Set("Authorization", "Bearer token-1")
+	if _, err := client.Do(req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if received != "Bearer token-2" {
+		t.Fatalf("expected the stale token to be replaced by the refreshed one, got %q", received)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, api.URL, nil)
+	req.Header.Set("Authorization", "Bearer other")
+	if _, err := client.Do(req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if received != "Bearer other" {
+		t.Fatalf("expected a token not issued by the session to be kept, got %q", received)
+	}
+}
+
+func TestCRTokenFromFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "cr-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString("cr-token\n")
+	file.Close()
+
+	token, err := crTokenFromFile(file.Name())()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != "cr-token" {
+		t.Fatalf("expected cr-token, got %q", token)
+	}
+	if _, err := crTokenFromFile(file.Name() + ".missing")(); err == nil {
+		t.Fatal("expected an error for a missing token file")
+	}
+}
@@ -229,7 +229,7 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
-	s3Client := s3.New(s3Sess, s3Conf)
+	s3Client := s3.New(s3Sess, s3Conf.WithHTTPClient(s3HTTPClient(meta)))
 
 	headInput := &s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
@@ -57,7 +57,7 @@ func dataSourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{}
 
 			instance, response, err := nsClient.GetNamespace(getOptions)
 			if err != nil {
-				if response != nil && response.StatusCode == 404 {
+				if isNotFound(responseError(response, err)) {
 					d.SetId("")
 					return nil
 				}
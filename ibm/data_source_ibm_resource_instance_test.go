@@ -120,3 +120,31 @@ data "ibm_resource_instance" "testacc_ds_resource_instance2" {
 `, instanceName, instanceName)
 
 }
+
+func TestUnitIBMResourceInstanceDataSource_basic(t *testing.T) {
+	cloud := newFakeCloud(t)
+	defer cloud.close()
+	cloud.controller.addPlan("fake-kms-service", "kms", "fake-kms-tiered-plan", "tiered-pricing")
+	crn := cloud.controller.addInstance("fake-kms", "fake-kms-tiered-plan", fakeResourceGroupID, "us-south")
+	cloud.controller.addInstance("other-kms", "fake-kms-tiered-plan", fakeResourceGroupID, "us-south")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: cloud.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "ibm_resource_instance" "instance" {
+					name              = "fake-kms"
+					resource_group_id = "%s"
+				}`, fakeResourceGroupID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resource_instance.instance", "id", crn),
+					resource.TestCheckResourceAttr("data.ibm_resource_instance.instance", "service", "kms"),
+					resource.TestCheckResourceAttr("data.ibm_resource_instance.instance", "plan", "tiered-pricing"),
+					resource.TestCheckResourceAttr("data.ibm_resource_instance.instance", "location", "us-south"),
+					resource.TestCheckResourceAttr("data.ibm_resource_instance.instance", ResourceStatus, "active"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,210 @@
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	searchv2 "github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	resourcesQuery      = "query"
+	resourcesFields     = "fields"
+	resourcesLimit      = "limit"
+	resourcesList       = "resources"
+	resourcesCRN        = "crn"
+	resourcesName       = "name"
+	resourcesType       = "type"
+	resourcesRegion     = "region"
+	resourcesGroupID    = "resource_group_id"
+	resourcesService    = "service_name"
+	resourcesTags       = "tags"
+	resourcesProperties = "properties"
+
+	// resourcesMaxPageSize is the maximum number of resources returned by a search
+	resourcesMaxPageSize = 1000
+)
+
+// resourcesBaseFields are the fields of every resource returned by the search
+var resourcesBaseFields = []string{resourcesName, resourcesType, resourcesRegion, resourcesGroupID, resourcesService, resourcesTags}
+
+func dataSourceIBMResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			resourcesQuery: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Lucene query of the search, e.g. service_name:kms AND tags:\"shared:true\"",
+			},
+			resourcesFields: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The additional fields of the resources returned in their properties, e.g. doc.state",
+			},
+			resourcesLimit: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validateAllowedRangeInt(1, 10000),
+				Description:  "The maximum number of resources returned, the search is paged through until it is reached",
+			},
+			resourcesList: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources matching the query",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						resourcesCRN: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource",
+						},
+						resourcesName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource",
+						},
+						resourcesType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource, e.g. resource-instance or vpc",
+						},
+						resourcesRegion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the resource, global for the resources which are not regional",
+						},
+						resourcesGroupID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource group of the resource",
+						},
+						resourcesService: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the service of the resource",
+						},
+						resourcesTags: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The user tags of the resource",
+						},
+						resourcesProperties: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The values of the additional fields, the values which are not strings are encoded in JSON",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	searchClient, err := meta.(ClientSession).GlobalSearchAPIv2()
+	if err != nil {
+		return err
+	}
+	query := d.Get(resourcesQuery).(string)
+	extraFields := expandStringList(d.Get(resourcesFields).([]interface{}))
+	fields := append(append([]string{}, resourcesBaseFields...), extraFields...)
+	limit := d.Get(resourcesLimit).(int)
+
+	var items []searchv2.ResultItem
+	cursor := ""
+	for len(items) < limit {
+		pageSize := limit - len(items)
+		if pageSize > resourcesMaxPageSize {
+			pageSize = resourcesMaxPageSize
+		}
+		opt := searchClient.NewSearchOptions()
+		opt.SetQuery(query)
+		opt.SetFields(fields)
+		opt.SetLimit(int64(pageSize))
+		if cursor != "" {
+			opt.SetSearchCursor(cursor)
+		}
+		result, response, err := searchClient.Search(opt)
+		if err != nil {
+			return fmt.Errorf("Error searching the resources matching %q: %s\n%s", query, err, response)
+		}
+		items = append(items, result.Items...)
+		// The last page has no cursor, an empty page is the end of the results too
+		if len(result.Items) == 0 || result.SearchCursor == nil {
+			break
+		}
+		cursor = *result.SearchCursor
+	}
+
+	resources := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		resource := map[string]interface{}{
+			resourcesCRN:     *item.CRN,
+			resourcesName:    resourcesStringField(item, resourcesName),
+			resourcesType:    resourcesStringField(item, resourcesType),
+			resourcesRegion:  resourcesStringField(item, resourcesRegion),
+			resourcesGroupID: resourcesStringField(item, resourcesGroupID),
+			resourcesService: resourcesStringField(item, resourcesService),
+		}
+		tags := []string{}
+		if values, ok := item.GetProperty(resourcesTags).([]interface{}); ok {
+			for _, tag := range values {
+				tags = append(tags, fmt.Sprint(tag))
+			}
+		}
+		resource[resourcesTags] = tags
+		properties := map[string]interface{}{}
+		for _, field := range extraFields {
+			if value, ok := resourcesField(item, field); ok {
+				properties[field] = value
+			}
+		}
+		resource[resourcesProperties] = properties
+		resources = append(resources, resource)
+	}
+	d.SetId(dataSourceIBMResourcesID(d))
+	d.Set(resourcesList, resources)
+	return nil
+}
+
+// resourcesField returns the value of a field of a resource, the fields of the nested
+// objects are separated by dots, e.g. doc.state. The values which are not strings are
+// encoded in JSON.
+func resourcesField(item searchv2.ResultItem, field string) (string, bool) {
+	var value interface{} = item.GetProperties()
+	for _, name := range strings.Split(field, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if value, ok = object[name]; !ok {
+			return "", false
+		}
+	}
+	if s, ok := value.(string); ok {
+		return s, true
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+	return string(encoded), true
+}
+
+func resourcesStringField(item searchv2.ResultItem, field string) string {
+	value, _ := item.GetProperty(field).(string)
+	return value
+}
+
+func dataSourceIBMResourcesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
@@ -0,0 +1,71 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitIBMResourcesDataSource_basic(t *testing.T) {
+	cloud := newFakeCloud(t)
+	defer cloud.close()
+	for i := 1; i <= 3; i++ {
+		cloud.search.addItem(map[string]interface{}{
+			"crn":               fakeCRN("kms", "us-south", "", fmt.Sprintf("key-protect-%d", i)),
+			"name":              fmt.Sprintf("keys-%d", i),
+			"type":              "resource-instance",
+			"region":            "us-south",
+			"resource_group_id": fakeResourceGroupID,
+			"service_name":      "kms",
+			"tags":              []interface{}{"env:prod", fmt.Sprintf("team:%d", i)},
+			"doc": map[string]interface{}{
+				"state":      "active",
+				"extensions": map[string]interface{}{"endpoints": []interface{}{"private"}},
+			},
+		})
+	}
+	cloud.search.addItem(map[string]interface{}{
+		"crn":          fakeCRN("is", "us-south", "", "vpc-1"),
+		"name":         "web-vpc",
+		"type":         "vpc",
+		"region":       "us-south",
+		"service_name": "is",
+		"tags":         []interface{}{"env:dev"},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: cloud.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "ibm_resources" "keys" {
+					query  = "service_name:kms AND tags:\"env:prod\""
+					fields = ["doc.state", "doc.extensions", "doc.missing"]
+					limit  = 2
+				}
+
+				data "ibm_resources" "all" {
+					query = "*"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.0.crn", fakeCRN("kms", "us-south", "", "key-protect-1")),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.0.name", "keys-1"),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.0.type", "resource-instance"),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.0.region", "us-south"),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.0.resource_group_id", fakeResourceGroupID),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.0.service_name", "kms"),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.0.tags.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.1.tags.1", "team:2"),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.0.properties.%", "2"),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.0.properties.doc.state", "active"),
+					resource.TestCheckResourceAttr("data.ibm_resources.keys", "resources.0.properties.doc.extensions", `{"endpoints":["private"]}`),
+					resource.TestCheckResourceAttr("data.ibm_resources.all", "resources.#", "4"),
+					resource.TestCheckResourceAttr("data.ibm_resources.all", "resources.0.name", "web-vpc"),
+					resource.TestCheckResourceAttr("data.ibm_resources.all", "resources.0.resource_group_id", ""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,723 @@
+package ibm
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev1/controller"
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	// exportPageSize is the number of resources requested per page of the CIS lists
+	exportPageSize = 1000
+
+	cisServiceName         = "internet-svcs"
+	iamPublicAccessGroupID = "AccessGroupId-PublicAccess"
+	serviceInstanceType    = "service_instance"
+	exportGlobalLocation   = "global"
+	exportImportsFile      = "imports.tf"
+	exportProvidersFile    = "providers.tf"
+	exportProviderSource   = "IBM-Cloud/ibm"
+)
+
+// exporter lists the resources of a type for the export command. The resources are
+// then read with the importer and the Read function of the resource, so the IDs listed
+// must be the ones the importer accepts.
+type exporter struct {
+	resource string
+	// global is set for the types which are not regional, they are listed once with
+	// the session of the first region
+	global bool
+	// references are the attributes, besides the ID, the other resources refer to,
+	// e.g. the domain_id of ibm_cis_domain set in the DNS records
+	references []string
+	list       func(sess ClientSession, filter exportFilter) ([]exportListed, error)
+}
+
+// exportListed is a resource listed by an exporter
+type exportListed struct {
+	id   string
+	name string
+	// global is set for the resources which are not in a region, e.g. the resource
+	// instances of the global location
+	global bool
+}
+
+// exportFilter selects the resources listed by the exporters
+type exportFilter struct {
+	region string
+	// primary is set for the first region, the global resources are listed with it
+	primary        bool
+	resourceGroups []string
+}
+
+func (f exportFilter) inResourceGroup(id string) bool {
+	if len(f.resourceGroups) == 0 {
+		return true
+	}
+	for _, group := range f.resourceGroups {
+		if group == id {
+			return true
+		}
+	}
+	return false
+}
+
+// exporters are the supported types, exported in this order
+var exporters = []*exporter{
+	{resource: "ibm_is_vpc", list: exportVPCs},
+	{resource: "ibm_is_subnet", list: exportSubnets},
+	{resource: "ibm_cis", global: true, list: exportCISInstances},
+	{resource: "ibm_cis_domain", global: true, references: []string{cisDomainID}, list: exportCISDomains},
+	{resource: "ibm_cis_dns_record", global: true, list: exportCISDNSRecords},
+	{resource: "ibm_iam_access_group", global: true, list: exportIAMAccessGroups},
+	{resource: "ibm_resource_instance", list: exportResourceInstances},
+}
+
+// exportSession returns the client session of a region, configured like the provider
+// with the environment variables, e.g. IC_API_KEY
+var exportSession = func(region string) (ClientSession, error) {
+	provider := Provider().(*schema.Provider)
+	if err := provider.Configure(terraform.NewResourceConfigRaw(map[string]interface{}{"region": region})); err != nil {
+		return nil, err
+	}
+	return provider.Meta().(ClientSession), nil
+}
+
+// ExportCommand writes the configuration and the import blocks of the resources of
+// the account, found with the clients of the provider
+func ExportCommand(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(out)
+	types := flags.String("types", "", "Comma separated resource types to export, all the supported types when empty")
+	regions := flags.String("regions", "", "Comma separated regions of the regional resources, the region of the provider environment variables when empty")
+	resourceGroups := flags.String("resource-groups", "", "Comma separated IDs of the resource groups of the resources, all the resource groups when empty")
+	dir := flags.String("dir", ".", "Directory of the generated files")
+	flags.Usage = func() {
+		fmt.Fprintf(out, "Usage: terraform-provider-ibm export [-types TYPES] [-regions REGIONS] [-resource-groups IDS] [-dir DIR]\n\n")
+		fmt.Fprintf(out, "Writes the configuration and the import blocks of the resources of the account. The credentials are read from the environment variables of the provider, e.g. IC_API_KEY.\n\n")
+		fmt.Fprintf(out, "Supported types: %s\n\n", strings.Join(exportTypes(), ", "))
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		flags.Usage()
+		return fmt.Errorf("Unexpected arguments %s", strings.Join(flags.Args(), " "))
+	}
+
+	selected := splitExportFlag(*types)
+	if len(selected) == 0 {
+		selected = exportTypes()
+	}
+	for _, t := range selected {
+		if exporterOf(t) == nil {
+			return fmt.Errorf("Unsupported type %s, the supported types are %s", t, strings.Join(exportTypes(), ", "))
+		}
+	}
+	regionList := splitExportFlag(*regions)
+	if len(regionList) == 0 {
+		region, err := Provider().(*schema.Provider).Schema["region"].DefaultValue()
+		if err != nil {
+			return err
+		}
+		regionList = []string{region.(string)}
+	}
+
+	resources, failed, err := exportResources(selected, regionList, splitExportFlag(*resourceGroups), out)
+	if err != nil {
+		return err
+	}
+	if err := writeExport(*dir, resources, regionList, out); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d resources could not be exported", failed)
+	}
+	return nil
+}
+
+func exportTypes() []string {
+	types := make([]string, len(exporters))
+	for i, e := range exporters {
+		types[i] = e.resource
+	}
+	return types
+}
+
+func exporterOf(resource string) *exporter {
+	for _, e := range exporters {
+		if e.resource == resource {
+			return e
+		}
+	}
+	return nil
+}
+
+func splitExportFlag(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// exportedResource is a resource read for the export
+type exportedResource struct {
+	exporter *exporter
+	label    string
+	id       string
+	// region is the region of the provider of the resource, empty for the global resources
+	region string
+	schema map[string]*schema.Schema
+	data   *schema.ResourceData
+}
+
+func (r *exportedResource) address() string {
+	return r.exporter.resource + "." + r.label
+}
+
+// exportResources lists the resources of the types in the regions and reads them. The
+// resources which cannot be read are reported in out and counted as failed.
+func exportResources(types, regions, resourceGroups []string, out io.Writer) ([]*exportedResource, int, error) {
+	provider := Provider().(*schema.Provider)
+	var resources []*exportedResource
+	labels := map[string]bool{}
+	failed := 0
+	for i, region := range regions {
+		sess, err := exportSession(region)
+		if err != nil {
+			return nil, 0, fmt.Errorf("Error configuring the session of the region %s: %s", region, err)
+		}
+		filter := exportFilter{region: region, primary: i == 0, resourceGroups: resourceGroups}
+		for _, t := range types {
+			e := exporterOf(t)
+			if e.global && !filter.primary {
+				continue
+			}
+			listed, err := e.list(sess, filter)
+			if err != nil {
+				return nil, 0, err
+			}
+			r := provider.ResourcesMap[e.resource]
+			exported := 0
+			for _, l := range listed {
+				d, err := exportRead(r, l.id, sess)
+				if err != nil {
+					fmt.Fprintf(out, "Error reading %s %s, skipped: %s\n", e.resource, l.id, err)
+					failed++
+					continue
+				}
+				if d == nil {
+					continue
+				}
+				resource := &exportedResource{
+					exporter: e,
+					label:    uniqueExportLabel(labels, e.resource, l.name),
+					id:       l.id,
+					schema:   r.Schema,
+					data:     d,
+				}
+				if !e.global && !l.global {
+					resource.region = region
+				}
+				resources = append(resources, resource)
+				exported++
+			}
+			if e.global {
+				fmt.Fprintf(out, "Exported %d %s\n", exported, e.resource)
+			} else {
+				fmt.Fprintf(out, "Exported %d %s of %s\n", exported, e.resource, region)
+			}
+		}
+	}
+	return resources, failed, nil
+}
+
+// exportRead imports and reads the resource like terraform import, it returns nil
+// when the resource no longer exists
+func exportRead(r *schema.Resource, id string, meta interface{}) (*schema.ResourceData, error) {
+	d := r.Data(nil)
+	d.SetId(id)
+	if r.Importer != nil && r.Importer.State != nil {
+		imported, err := r.Importer.State(d, meta)
+		if err != nil {
+			return nil, err
+		}
+		if len(imported) > 0 {
+			d = imported[0]
+		}
+	}
+	if err := r.Read(d, meta); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, nil
+	}
+	return d, nil
+}
+
+var exportLabelInvalidChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// uniqueExportLabel returns the label of a resource derived from its name, suffixed
+// with a number when another resource of the type has the same label
+func uniqueExportLabel(labels map[string]bool, resource, name string) string {
+	label := strings.Trim(exportLabelInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	switch {
+	case label == "":
+		label = "resource"
+	case label[0] < 'a' || label[0] > 'z':
+		label = "r_" + label
+	}
+	unique := label
+	for i := 2; labels[resource+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	labels[resource+"."+unique] = true
+	return unique
+}
+
+// exportWriter renders the exported resources, the values of the IDs and of the
+// references of the exported resources are replaced with references to them
+type exportWriter struct {
+	references map[string]exportReference
+	// providers maps the regions to the provider aliases
+	providers map[string]string
+	warnings  []string
+}
+
+// exportReference is an attribute of an exported resource
+type exportReference struct {
+	address   string
+	traversal hcl.Traversal
+}
+
+func newExportWriter(resources []*exportedResource, regions []string) *exportWriter {
+	w := &exportWriter{references: map[string]exportReference{}, providers: map[string]string{}}
+	for _, r := range resources {
+		w.references[r.id] = exportReference{r.address(), exportTraversal(r.exporter.resource, r.label, "id")}
+		for _, attribute := range r.exporter.references {
+			if v, ok := r.data.Get(attribute).(string); ok && v != "" {
+				w.references[v] = exportReference{r.address(), exportTraversal(r.exporter.resource, r.label, attribute)}
+			}
+		}
+	}
+	for _, region := range regions[1:] {
+		w.providers[region] = strings.Replace(region, "-", "_", -1)
+	}
+	return w
+}
+
+func exportTraversal(root string, attributes ...string) hcl.Traversal {
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: root}}
+	for _, attribute := range attributes {
+		traversal = append(traversal, hcl.TraverseAttr{Name: attribute})
+	}
+	return traversal
+}
+
+// writeExport writes a file per type, the import blocks and the providers in dir. It
+// fails without writing anything when one of the files already exists.
+func writeExport(dir string, resources []*exportedResource, regions []string, out io.Writer) error {
+	w := newExportWriter(resources, regions)
+	files := map[string]*hclwrite.File{}
+	var order []string
+	imports := hclwrite.NewEmptyFile()
+	for _, r := range resources {
+		filename := r.exporter.resource + ".tf"
+		f, ok := files[filename]
+		if !ok {
+			f = hclwrite.NewEmptyFile()
+			files[filename] = f
+			order = append(order, filename)
+		} else {
+			f.Body().AppendNewline()
+		}
+		w.writeResource(f.Body(), r)
+
+		if len(imports.Body().Blocks()) > 0 {
+			imports.Body().AppendNewline()
+		}
+		block := imports.Body().AppendNewBlock("import", nil).Body()
+		block.SetAttributeTraversal("to", exportTraversal(r.exporter.resource, r.label))
+		block.SetAttributeValue("id", cty.StringVal(r.id))
+		if alias, ok := w.providers[r.region]; ok {
+			block.SetAttributeTraversal("provider", exportTraversal("ibm", alias))
+		}
+	}
+	files[exportImportsFile] = imports
+	files[exportProvidersFile] = w.providersFile(regions)
+	order = append(order, exportImportsFile, exportProvidersFile)
+
+	for _, filename := range order {
+		if _, err := os.Stat(filepath.Join(dir, filename)); err == nil {
+			return fmt.Errorf("Error writing %s: the file already exists", filepath.Join(dir, filename))
+		}
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, filename := range order {
+		path := filepath.Join(dir, filename)
+		if err := ioutil.WriteFile(path, hclwrite.Format(files[filename].Bytes()), 0644); err != nil {
+			return fmt.Errorf("Error writing %s: %s", path, err)
+		}
+	}
+	for _, warning := range w.warnings {
+		fmt.Fprintln(out, warning)
+	}
+	fmt.Fprintf(out, "Wrote %d resources in %s, run terraform plan to review the imports\n", len(resources), dir)
+	return nil
+}
+
+func (w *exportWriter) providersFile(regions []string) *hclwrite.File {
+	f := hclwrite.NewEmptyFile()
+	required := f.Body().AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil).Body()
+	required.SetAttributeValue("ibm", cty.ObjectVal(map[string]cty.Value{"source": cty.StringVal(exportProviderSource)}))
+	for _, region := range regions {
+		f.Body().AppendNewline()
+		provider := f.Body().AppendNewBlock("provider", []string{"ibm"}).Body()
+		if alias, ok := w.providers[region]; ok {
+			provider.SetAttributeValue("alias", cty.StringVal(alias))
+		}
+		provider.SetAttributeValue("region", cty.StringVal(region))
+	}
+	return f
+}
+
+func (w *exportWriter) writeResource(body *hclwrite.Body, r *exportedResource) {
+	block := body.AppendNewBlock("resource", []string{r.exporter.resource, r.label}).Body()
+	if alias, ok := w.providers[r.region]; ok {
+		block.SetAttributeTraversal("provider", exportTraversal("ibm", alias))
+	}
+	values := map[string]interface{}{}
+	for name := range r.schema {
+		values[name] = r.data.Get(name)
+	}
+	w.writeBody(block, r.address(), r.schema, values)
+}
+
+// writeBody writes the arguments of the schema, the attributes first and then the
+// blocks of the nested resources. The computed attributes, the deprecated arguments
+// and the values which are empty or equal to the default are omitted, as well as the
+// arguments conflicting with an argument already written. The sensitive arguments
+// are reported instead of being written.
+func (w *exportWriter) writeBody(body *hclwrite.Body, address string, s map[string]*schema.Schema, values map[string]interface{}) {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	written := map[string]bool{}
+	var blocks []string
+	for _, name := range names {
+		argument := s[name]
+		value := values[name]
+		if (!argument.Required && !argument.Optional) || argument.Deprecated != "" || argument.Removed != "" {
+			continue
+		}
+		if argument.Default != nil && fmt.Sprint(value) == fmt.Sprint(argument.Default) {
+			continue
+		}
+		// An empty value is not set by Read, except false which overrides a true default
+		if !argument.Required && isEmptyExportValue(value) && (argument.Default == nil || argument.Type != schema.TypeBool) {
+			continue
+		}
+		conflict := false
+		for _, other := range argument.ConflictsWith {
+			conflict = conflict || written[other]
+		}
+		if conflict {
+			continue
+		}
+		if argument.Sensitive {
+			w.warnings = append(w.warnings, fmt.Sprintf("%s: the sensitive argument %s is not exported, set it in the configuration", address, name))
+			continue
+		}
+		written[name] = true
+		if _, ok := argument.Elem.(*schema.Resource); ok && (argument.Type == schema.TypeList || argument.Type == schema.TypeSet) {
+			blocks = append(blocks, name)
+			continue
+		}
+		if v, ok := value.(string); ok {
+			if reference, ok := w.references[v]; ok && reference.address != address {
+				body.SetAttributeTraversal(name, reference.traversal)
+				continue
+			}
+		}
+		body.SetAttributeValue(name, exportValue(value))
+	}
+	for _, name := range blocks {
+		elem := s[name].Elem.(*schema.Resource)
+		for _, item := range exportList(values[name]) {
+			if m, ok := item.(map[string]interface{}); ok {
+				w.writeBody(body.AppendNewBlock(name, nil).Body(), address, elem.Schema, m)
+			}
+		}
+	}
+}
+
+func exportList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+func isEmptyExportValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return len(exportList(value)) == 0
+}
+
+// exportValue converts a value of schema.ResourceData to its HCL value, the lists and
+// the maps are converted to tuples and objects as their elements may differ in type
+func exportValue(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal
+		}
+		attributes := make(map[string]cty.Value, len(v))
+		for k, e := range v {
+			attributes[k] = exportValue(e)
+		}
+		return cty.ObjectVal(attributes)
+	case *schema.Set, []interface{}:
+		list := exportList(v)
+		if len(list) == 0 {
+			return cty.EmptyTupleVal
+		}
+		elements := make([]cty.Value, len(list))
+		for i, e := range list {
+			elements[i] = exportValue(e)
+		}
+		return cty.TupleVal(elements)
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+func exportVPCs(sess ClientSession, filter exportFilter) ([]exportListed, error) {
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	var listed []exportListed
+	start := ""
+	for {
+		options := &vpcv1.ListVpcsOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		vpcs, response, err := vpcClient.ListVpcs(options)
+		if err != nil {
+			return nil, fmt.Errorf("Error fetching the VPCs of %s: %s\n%s", filter.region, err, response)
+		}
+		for _, vpc := range vpcs.Vpcs {
+			if vpc.ResourceGroup != nil && filter.inResourceGroup(*vpc.ResourceGroup.ID) {
+				listed = append(listed, exportListed{id: *vpc.ID, name: *vpc.Name})
+			}
+		}
+		if start = GetNext(vpcs.Next); start == "" {
+			return listed, nil
+		}
+	}
+}
+
+func exportSubnets(sess ClientSession, filter exportFilter) ([]exportListed, error) {
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	var listed []exportListed
+	start := ""
+	for {
+		options := &vpcv1.ListSubnetsOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		subnets, response, err := vpcClient.ListSubnets(options)
+		if err != nil {
+			return nil, fmt.Errorf("Error fetching the subnets of %s: %s\n%s", filter.region, err, response)
+		}
+		for _, subnet := range subnets.Subnets {
+			if subnet.ResourceGroup != nil && filter.inResourceGroup(*subnet.ResourceGroup.ID) {
+				listed = append(listed, exportListed{id: *subnet.ID, name: *subnet.Name})
+			}
+		}
+		if start = GetNext(subnets.Next); start == "" {
+			return listed, nil
+		}
+	}
+}
+
+// exportServiceInstances lists the resource instances of the region, and the ones of
+// the global location for the first region, either the CIS instances or the others
+func exportServiceInstances(sess ClientSession, filter exportFilter, cis bool) ([]exportListed, error) {
+	rsConClient, err := sess.ResourceControllerAPI()
+	if err != nil {
+		return nil, err
+	}
+	instances, err := rsConClient.ResourceServiceInstance().ListInstances(controller.ServiceInstanceQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the resource instances: %s", err)
+	}
+	var listed []exportListed
+	for _, instance := range instances {
+		global := instance.RegionID == exportGlobalLocation
+		switch {
+		case instance.Type != serviceInstanceType,
+			(instance.Crn.ServiceName == cisServiceName) != cis,
+			!filter.inResourceGroup(instance.ResourceGroupID),
+			global && !filter.primary,
+			!global && instance.RegionID != filter.region:
+			continue
+		}
+		listed = append(listed, exportListed{id: instance.ID, name: instance.Name, global: global})
+	}
+	return listed, nil
+}
+
+func exportResourceInstances(sess ClientSession, filter exportFilter) ([]exportListed, error) {
+	return exportServiceInstances(sess, filter, false)
+}
+
+func exportCISInstances(sess ClientSession, filter exportFilter) ([]exportListed, error) {
+	return exportServiceInstances(sess, filter, true)
+}
+
+func exportCISDomains(sess ClientSession, filter exportFilter) ([]exportListed, error) {
+	instances, err := exportCISInstances(sess, filter)
+	if err != nil {
+		return nil, err
+	}
+	cisClient, err := sess.CisZonesV1ClientSession()
+	if err != nil {
+		return nil, err
+	}
+	var listed []exportListed
+	for _, instance := range instances {
+		cisClient.Crn = core.StringPtr(instance.id)
+		for page := int64(1); ; page++ {
+			opt := cisClient.NewListZonesOptions()
+			opt.SetPage(page)
+			opt.SetPerPage(exportPageSize)
+			zones, response, err := cisClient.ListZones(opt)
+			if err != nil {
+				return nil, fmt.Errorf("Error listing the domains of the CIS instance %s: %s\n%s", instance.id, err, response)
+			}
+			for _, zone := range zones.Result {
+				listed = append(listed, exportListed{id: cisDomainIDTemplate.format(*zone.ID, instance.id), name: *zone.Name})
+			}
+			if len(zones.Result) < exportPageSize {
+				break
+			}
+		}
+	}
+	return listed, nil
+}
+
+func exportCISDNSRecords(sess ClientSession, filter exportFilter) ([]exportListed, error) {
+	domains, err := exportCISDomains(sess, filter)
+	if err != nil {
+		return nil, err
+	}
+	dnsClient, err := sess.CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	var listed []exportListed
+	for _, domain := range domains {
+		segments, err := cisDomainIDTemplate.split(domain.id)
+		if err != nil {
+			return nil, err
+		}
+		zoneID, crn := segments[0], segments[1]
+		dnsClient.Crn = core.StringPtr(crn)
+		dnsClient.ZoneIdentifier = core.StringPtr(zoneID)
+		for page := int64(1); ; page++ {
+			opt := dnsClient.NewListAllDnsRecordsOptions()
+			opt.SetPage(page)
+			opt.SetPerPage(exportPageSize)
+			records, response, err := dnsClient.ListAllDnsRecords(opt)
+			if err != nil {
+				return nil, fmt.Errorf("Error listing the DNS records of the domain %s: %s\n%s", domain.name, err, response)
+			}
+			for _, record := range records.Result {
+				listed = append(listed, exportListed{id: cisDNSRecordIDTemplate.format(*record.ID, zoneID, crn), name: *record.Name})
+			}
+			if len(records.Result) < exportPageSize {
+				break
+			}
+		}
+	}
+	return listed, nil
+}
+
+// exportIAMAccessGroups lists the access groups of the account, except the Public
+// Access group managed by IAM. The access groups are not in a resource group, they
+// are only exported when no resource group is selected.
+func exportIAMAccessGroups(sess ClientSession, filter exportFilter) ([]exportListed, error) {
+	if len(filter.resourceGroups) > 0 {
+		return nil, nil
+	}
+	iamuumClient, err := sess.IAMUUMAPIV2()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := sess.BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	groups, err := iamuumClient.AccessGroup().List(userDetails.userAccount)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the access groups: %s", err)
+	}
+	var listed []exportListed
+	for _, group := range groups {
+		if group.ID != iamPublicAccessGroupID {
+			listed = append(listed, exportListed{id: group.ID, name: group.Name})
+		}
+	}
+	return listed, nil
+}
@@ -0,0 +1,250 @@
+package ibm
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// testExportCloud creates a fake cloud with a VPC, a CIS instance with a domain and a
+// DNS record, an access group and resource instances, and makes the export use it.
+// It returns the function restoring the session of the export.
+func testExportCloud(t *testing.T) func() {
+	cloud := newFakeCloud(t)
+	sess := cloud.session()
+	session := exportSession
+	exportSession = func(region string) (ClientSession, error) {
+		if region != "us-south" {
+			return nil, fmt.Errorf("no fake region %s", region)
+		}
+		return sess, nil
+	}
+
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := "web-vpc"
+	if _, _, err := vpcClient.CreateVPC(&vpcv1.CreateVPCOptions{Name: &name}); err != nil {
+		t.Fatal(err)
+	}
+
+	cloud.controller.addPlan("fake-cis-service", cisServiceName, "fake-cis-plan", "standard")
+	crn := cloud.controller.addInstance("web cis", "fake-cis-plan", fakeResourceGroupID, "global")
+	zoneID := cloud.cis.addZone(crn, "example.com")
+	cloud.cis.addObject(crn, zoneID, "dns_records", map[string]interface{}{
+		"name":      "www.example.com",
+		"type":      "A",
+		"content":   "192.0.2.1",
+		"ttl":       120,
+		"proxied":   false,
+		"proxiable": true,
+		"zone_id":   zoneID,
+		"zone_name": "example.com",
+	})
+	// The API returns the content computed from the data of an SRV record
+	cloud.cis.addObject(crn, zoneID, "dns_records", map[string]interface{}{
+		"name":      "_sip._tcp.example.com",
+		"type":      "SRV",
+		"content":   "5\t0\t5060\tsip.example.com",
+		"data":      map[string]interface{}{"service": "_sip", "proto": "_tcp", "name": "example.com", "priority": 5, "weight": 0, "port": 5060, "target": "sip.example.com"},
+		"ttl":       120,
+		"proxied":   false,
+		"proxiable": false,
+		"zone_id":   zoneID,
+		"zone_name": "example.com",
+	})
+
+	cloud.controller.addPlan("fake-kms-service", "kms", "fake-kms-tiered-plan", "tiered-pricing")
+	cloud.controller.addInstance("keys", "fake-kms-tiered-plan", fakeResourceGroupID, "us-south")
+	cloud.controller.addInstance("keys", "fake-kms-tiered-plan", "other-resource-group", "us-south")
+	cloud.controller.addInstance("frankfurt keys", "fake-kms-tiered-plan", fakeResourceGroupID, "eu-de")
+
+	cloud.accessGroups.addGroup("admins", "The administrators")
+
+	return func() {
+		exportSession = session
+		cloud.close()
+	}
+}
+
+func testExportDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func testExportFile(t *testing.T, dir, filename string) string {
+	src, err := ioutil.ReadFile(filepath.Join(dir, filename))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(src)
+}
+
+func TestExportCommand(t *testing.T) {
+	done := testExportCloud(t)
+	defer done()
+	dir, remove := testExportDir(t)
+	defer remove()
+
+	var out bytes.Buffer
+	if err := ExportCommand([]string{"-regions", "us-south", "-dir", dir}, &out); err != nil {
+		t.Fatalf("unexpected error: %s\n%s", err, out.String())
+	}
+	for _, line := range []string{
+		"Exported 1 ibm_is_vpc of us-south",
+		"Exported 0 ibm_is_subnet of us-south",
+		"Exported 1 ibm_cis\n",
+		"Exported 1 ibm_cis_domain\n",
+		"Exported 2 ibm_cis_dns_record\n",
+		"Exported 1 ibm_iam_access_group\n",
+		"Exported 2 ibm_resource_instance of us-south",
+		"Wrote 8 resources in " + dir,
+	} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("expected %q in the output:\n%s", line, out.String())
+		}
+	}
+
+	vpcs := testExportFile(t, dir, "ibm_is_vpc.tf")
+	expected := "resource \"ibm_is_vpc\" \"web-vpc\" {\n  name           = \"web-vpc\"\n  resource_group = \"fake-resource-group\"\n}\n"
+	if vpcs != expected {
+		t.Errorf("unexpected VPCs:\n%s", vpcs)
+	}
+
+	domains := testExportFile(t, dir, "ibm_cis_domain.tf")
+	expected = "resource \"ibm_cis_domain\" \"example_com\" {\n  cis_id = ibm_cis.web_cis.id\n  domain = \"example.com\"\n}\n"
+	if domains != expected {
+		t.Errorf("expected the domain to refer to the CIS instance, got:\n%s", domains)
+	}
+	records := testExportFile(t, dir, "ibm_cis_dns_record.tf")
+	for _, attribute := range []string{
+		"cis_id    = ibm_cis.web_cis.id",
+		"domain_id = ibm_cis_domain.example_com.domain_id",
+		"content   = \"192.0.2.1\"",
+		"ttl       = 120",
+	} {
+		if !strings.Contains(records, attribute) {
+			t.Errorf("expected %q in the DNS record, got:\n%s", attribute, records)
+		}
+	}
+	if !strings.Contains(records, "target = \"sip.example.com\"") || strings.Contains(records, "5060\\t") {
+		t.Errorf("expected the SRV record to be exported with its data only, got:\n%s", records)
+	}
+	if strings.Contains(records, "proxied") {
+		t.Errorf("expected the default values to be omitted, got:\n%s", records)
+	}
+	if groups := testExportFile(t, dir, "ibm_iam_access_group.tf"); !strings.Contains(groups, "description = \"The administrators\"") {
+		t.Errorf("unexpected access groups:\n%s", groups)
+	}
+	if instances := testExportFile(t, dir, "ibm_resource_instance.tf"); !strings.Contains(instances, "\"keys_2\"") || strings.Contains(instances, "frankfurt") {
+		t.Errorf("expected the instances of us-south with unique labels, got:\n%s", instances)
+	}
+
+	imports := testExportFile(t, dir, exportImportsFile)
+	if strings.Count(imports, "import {") != 8 {
+		t.Errorf("expected an import block per resource, got:\n%s", imports)
+	}
+	file, diags := hclsyntax.ParseConfig([]byte(imports), exportImportsFile, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		t.Fatalf("invalid imports: %s", diags)
+	}
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		to, _ := hcl.AbsTraversalForExpr(block.Body.Attributes["to"].Expr)
+		id, _ := block.Body.Attributes["id"].Expr.Value(nil)
+		if template, ok := idTemplates[to.RootName()]; ok {
+			if err := template.validate(id.AsString()); err != nil {
+				t.Errorf("expected the import ID to match the template: %s", err)
+			}
+		}
+	}
+	if !strings.Contains(imports, "to = ibm_cis_domain.example_com\n  id = \"fakezone") {
+		t.Errorf("expected the import ID of the domain, got:\n%s", imports)
+	}
+	if providers := testExportFile(t, dir, exportProvidersFile); !strings.Contains(providers, "source = \"IBM-Cloud/ibm\"") || !strings.Contains(providers, "region = \"us-south\"") {
+		t.Errorf("unexpected providers:\n%s", providers)
+	}
+
+	if err := ExportCommand([]string{"-regions", "us-south", "-dir", dir}, &out); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("expected the existing files not to be overwritten, got %v", err)
+	}
+}
+
+func TestExportCommandFilters(t *testing.T) {
+	done := testExportCloud(t)
+	defer done()
+	dir, remove := testExportDir(t)
+	defer remove()
+
+	var out bytes.Buffer
+	err := ExportCommand([]string{"-types", "ibm_resource_instance,ibm_iam_access_group", "-resource-groups", "other-resource-group", "-regions", "us-south", "-dir", dir}, &out)
+	if err != nil {
+		t.Fatalf("unexpected error: %s\n%s", err, out.String())
+	}
+	if !strings.Contains(out.String(), "Exported 1 ibm_resource_instance of us-south") || !strings.Contains(out.String(), "Exported 0 ibm_iam_access_group") {
+		t.Errorf("expected the resources of the resource group only, got:\n%s", out.String())
+	}
+	if _, err := os.Stat(filepath.Join(dir, "ibm_is_vpc.tf")); !os.IsNotExist(err) {
+		t.Errorf("expected only the selected types to be exported")
+	}
+
+	if err := ExportCommand([]string{"-types", "ibm_is_instance"}, &out); err == nil || !strings.Contains(err.Error(), "Unsupported type ibm_is_instance") {
+		t.Errorf("expected an error for an unsupported type, got %v", err)
+	}
+	if err := ExportCommand([]string{"-regions", "us-south,eu-de", "-dir", dir}, &out); err == nil || !strings.Contains(err.Error(), "eu-de") {
+		t.Errorf("expected the session error of the region, got %v", err)
+	}
+}
+
+func TestExportersResources(t *testing.T) {
+	resources := Provider().(*schema.Provider).ResourcesMap
+	for _, e := range exporters {
+		r, ok := resources[e.resource]
+		if !ok {
+			t.Errorf("the exporter %s is not the one of a resource", e.resource)
+			continue
+		}
+		if r.Importer == nil {
+			t.Errorf("the resource %s exported is not importable", e.resource)
+		}
+		for _, attribute := range e.references {
+			if _, ok := r.Schema[attribute]; !ok {
+				t.Errorf("the reference %s is not an attribute of %s", attribute, e.resource)
+			}
+		}
+	}
+}
+
+func TestUniqueExportLabel(t *testing.T) {
+	labels := map[string]bool{}
+	for name, expected := range map[string]string{
+		"Web VPC":         "web_vpc",
+		"www.example.com": "www_example_com",
+		"1st-vpc":         "r_1st-vpc",
+		"":                "resource",
+	} {
+		if label := uniqueExportLabel(labels, "ibm_is_vpc", name); label != expected {
+			t.Errorf("expected the label %s for %q, got %s", expected, name, label)
+		}
+	}
+	if label := uniqueExportLabel(labels, "ibm_is_vpc", "web vpc"); label != "web_vpc_2" {
+		t.Errorf("expected a unique label, got %s", label)
+	}
+	if label := uniqueExportLabel(labels, "ibm_is_subnet", "web vpc"); label != "web_vpc" {
+		t.Errorf("expected the labels to be unique per type, got %s", label)
+	}
+}
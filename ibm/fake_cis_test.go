@@ -0,0 +1,254 @@
+package ibm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// fakeCIS serves the zones of the CIS API, which are only read, and the zone settings
+// which are collections of objects under /v1/{crn}/zones/{zone_id}, e.g. the page
+// rules. The objects are stored as they are sent, with an id and the creation and
+// modification times, and every response is wrapped in the CIS envelope.
+type fakeCIS struct {
+	cloud *fakeCloud
+
+	mu sync.Mutex
+	// zones maps the CRN of an instance to its zones by id
+	zones map[string]map[string]map[string]interface{}
+	// objects maps the path of a collection to its objects by id
+	objects map[string]map[string]map[string]interface{}
+}
+
+// fakeCISCollections are the zone collections served by fakeCIS
+var fakeCISCollections = []string{"pagerules", "dns_records"}
+
+func newFakeCIS(cloud *fakeCloud) *fakeCIS {
+	f := &fakeCIS{
+		cloud:   cloud,
+		zones:   map[string]map[string]map[string]interface{}{},
+		objects: map[string]map[string]map[string]interface{}{},
+	}
+	cloud.handle(http.MethodGet, "/cis/v1/{crn}/zones", f.listZones)
+	cloud.handle(http.MethodGet, "/cis/v1/{crn}/zones/{zone_id}", f.getZone)
+	for _, collection := range fakeCISCollections {
+		path := "/cis/v1/{crn}/zones/{zone_id}/" + collection
+		cloud.handle(http.MethodPost, path, f.create)
+		cloud.handle(http.MethodGet, path, f.list)
+		cloud.handle(http.MethodGet, path+"/{id}", f.get)
+		cloud.handle(http.MethodPut, path+"/{id}", func(r *fakeRequest) (int, interface{}) {
+			return f.update(r, true)
+		})
+		cloud.handle(http.MethodPatch, path+"/{id}", func(r *fakeRequest) (int, interface{}) {
+			return f.update(r, false)
+		})
+		cloud.handle(http.MethodDelete, path+"/{id}", f.delete)
+	}
+	return f
+}
+
+// fakeCISResult wraps the result of a successful request in the CIS envelope
+func fakeCISResult(result interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"success":  true,
+		"errors":   []interface{}{},
+		"messages": []interface{}{},
+		"result":   result,
+	}
+}
+
+// fakeCISError returns the envelope of a failed request
+func fakeCISError(code int, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"success":  false,
+		"errors":   []map[string]interface{}{{"code": code, "message": message}},
+		"messages": []interface{}{},
+		"result":   nil,
+	}
+}
+
+// collection returns the objects of the collection of the request, the path is used
+// without the id of an object
+func (f *fakeCIS) collection(r *fakeRequest) map[string]map[string]interface{} {
+	path := r.Path
+	if id, ok := r.Params["id"]; ok {
+		path = path[:len(path)-len(id)-1]
+	}
+	objects, ok := f.objects[path]
+	if !ok {
+		objects = map[string]map[string]interface{}{}
+		f.objects[path] = objects
+	}
+	return objects
+}
+
+// addZone adds an active zone to the CIS instance and returns its id
+func (f *fakeCIS) addZone(crn, name string) string {
+	id := f.cloud.newID("fakezone")
+	now := fakeTimestamp()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.zones[crn] == nil {
+		f.zones[crn] = map[string]map[string]interface{}{}
+	}
+	f.zones[crn][id] = map[string]interface{}{
+		"id":                    id,
+		"name":                  name,
+		"status":                "active",
+		"paused":                false,
+		"name_servers":          []string{"ns1.fake.cis.cloud.ibm.com", "ns2.fake.cis.cloud.ibm.com"},
+		"original_name_servers": []string{},
+		"created_on":            now,
+		"modified_on":           now,
+	}
+	return id
+}
+
+// addObject adds an object to a zone collection and returns its id
+func (f *fakeCIS) addObject(crn, zoneID, collection string, object map[string]interface{}) string {
+	object = copyFakeObject(object)
+	now := fakeTimestamp()
+	object["id"] = f.cloud.newID("fakecis")
+	object["created_on"] = now
+	object["modified_on"] = now
+	path := "/cis/v1/" + crn + "/zones/" + zoneID + "/" + collection
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.objects[path] == nil {
+		f.objects[path] = map[string]map[string]interface{}{}
+	}
+	f.objects[path][object["id"].(string)] = object
+	return object["id"].(string)
+}
+
+// object returns a copy of an object of a zone collection, nil when it does not exist
+func (f *fakeCIS) object(crn, zoneID, collection, id string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if object, ok := f.objects["/cis/v1/"+crn+"/zones/"+zoneID+"/"+collection][id]; ok {
+		return copyFakeObject(object)
+	}
+	return nil
+}
+
+func (f *fakeCIS) listZones(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	zones := sortedFakeObjects(f.zones[r.Params["crn"]])
+	response := fakeCISResult(zones)
+	response["result_info"] = map[string]int{"page": 1, "per_page": len(zones), "count": len(zones), "total_count": len(zones)}
+	return http.StatusOK, response
+}
+
+func (f *fakeCIS) getZone(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	zone, ok := f.zones[r.Params["crn"]][r.Params["zone_id"]]
+	if !ok {
+		return http.StatusNotFound, fakeCISError(1001, "Invalid zone identifier")
+	}
+	return http.StatusOK, fakeCISResult(copyFakeObject(zone))
+}
+
+func (f *fakeCIS) create(r *fakeRequest) (int, interface{}) {
+	object, err := r.object()
+	if err != nil {
+		return http.StatusBadRequest, fakeCISError(http.StatusBadRequest, err.Error())
+	}
+	now := fakeTimestamp()
+	object["id"] = f.cloud.newID("fakecis")
+	object["created_on"] = now
+	object["modified_on"] = now
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if strings.HasSuffix(r.Path, "/dns_records") {
+		f.computeDNSRecord(r.Params["crn"], r.Params["zone_id"], object)
+	}
+	f.collection(r)[object["id"].(string)] = object
+	return http.StatusOK, fakeCISResult(copyFakeObject(object))
+}
+
+// computeDNSRecord sets the attributes the API computes for a DNS record. The
+// content of a record set with data is computed from its data, and the name of an
+// SRV record too.
+func (f *fakeCIS) computeDNSRecord(crn, zoneID string, record map[string]interface{}) {
+	record["zone_id"] = zoneID
+	if zone, ok := f.zones[crn][zoneID]; ok {
+		record["zone_name"] = zone["name"]
+	}
+	record["proxiable"] = true
+	if _, ok := record["proxied"]; !ok {
+		record["proxied"] = false
+	}
+	if data, ok := record["data"].(map[string]interface{}); ok {
+		if record["type"] == "SRV" {
+			record["name"] = fmt.Sprintf("%s.%s.%s.%s", data["service"], data["proto"], data["name"], record["zone_name"])
+		}
+		fields := []string{}
+		for _, key := range []string{"priority", "weight", "port", "target"} {
+			if v, ok := data[key]; ok {
+				fields = append(fields, fmt.Sprint(v))
+			}
+		}
+		record["content"] = strings.Join(fields, "\t")
+	}
+}
+
+func (f *fakeCIS) list(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	objects := sortedFakeObjects(f.collection(r))
+	response := fakeCISResult(objects)
+	response["result_info"] = map[string]int{"page": 1, "per_page": len(objects), "count": len(objects), "total_count": len(objects)}
+	return http.StatusOK, response
+}
+
+func (f *fakeCIS) get(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	object, ok := f.collection(r)[r.Params["id"]]
+	if !ok {
+		return http.StatusNotFound, fakeCISError(1004, "not found")
+	}
+	return http.StatusOK, fakeCISResult(copyFakeObject(object))
+}
+
+// update replaces the object for a PUT and merges the attributes for a PATCH
+func (f *fakeCIS) update(r *fakeRequest, replace bool) (int, interface{}) {
+	patch, err := r.object()
+	if err != nil {
+		return http.StatusBadRequest, fakeCISError(http.StatusBadRequest, err.Error())
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	objects := f.collection(r)
+	object, ok := objects[r.Params["id"]]
+	if !ok {
+		return http.StatusNotFound, fakeCISError(1004, "not found")
+	}
+	if replace {
+		object = map[string]interface{}{"id": object["id"], "created_on": object["created_on"]}
+		objects[r.Params["id"]] = object
+	}
+	for k, v := range patch {
+		object[k] = v
+	}
+	if strings.HasSuffix(r.Path, "/dns_records/"+r.Params["id"]) {
+		f.computeDNSRecord(r.Params["crn"], r.Params["zone_id"], object)
+	}
+	object["modified_on"] = fakeTimestamp()
+	return http.StatusOK, fakeCISResult(copyFakeObject(object))
+}
+
+func (f *fakeCIS) delete(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	objects := f.collection(r)
+	if _, ok := objects[r.Params["id"]]; !ok {
+		return http.StatusNotFound, fakeCISError(1004, "not found")
+	}
+	delete(objects, r.Params["id"])
+	return http.StatusOK, fakeCISResult(map[string]string{"id": r.Params["id"]})
+}
@@ -0,0 +1,327 @@
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// fakeCloud is an in-process IBM Cloud for the offline unit tests of the resources.
+//
+// A single httptest server serves the API of every fake under /<service>, e.g. the
+// VPC API under /vpc, and the client session returned by session points the endpoint
+// of every service there. The fakes keep the state of the resources in memory, the
+// responses can be scripted per request with on and once, e.g. to return an error,
+// and every request is recorded.
+type fakeCloud struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu       sync.Mutex
+	routes   []*fakeRoute
+	scripted []*fakeRoute
+	requests []*fakeRequest
+	ids      int
+	scale    float64
+
+	vpc          *fakeVPC
+	tagging      *fakeGlobalTagging
+	cis          *fakeCIS
+	controller   *fakeResourceController
+	accessGroups *fakeIAMAccessGroups
+	search       *fakeGlobalSearch
+}
+
+// fakeHandler returns the status and the body of the response, a nil body is not
+// written and any other body is encoded in JSON
+type fakeHandler func(r *fakeRequest) (int, interface{})
+
+type fakeRoute struct {
+	method   string
+	segments []string
+	handler  fakeHandler
+	// remaining is the number of requests the route still serves, -1 is unlimited
+	remaining int
+}
+
+// fakeRequest is a request received by the fake cloud, Path is unescaped and Params
+// holds the values of the {name} segments of the matched route
+type fakeRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Params map[string]string
+	Body   []byte
+
+	segments []string
+}
+
+func newFakeCloud(t *testing.T) *fakeCloud {
+	cloud := &fakeCloud{t: t, scale: asyncWaiterTimeScale}
+	cloud.server = httptest.NewServer(http.HandlerFunc(cloud.serveHTTP))
+	cloud.vpc = newFakeVPC(cloud)
+	cloud.tagging = newFakeGlobalTagging(cloud)
+	cloud.cis = newFakeCIS(cloud)
+	cloud.controller = newFakeResourceController(cloud)
+	cloud.accessGroups = newFakeIAMAccessGroups(cloud)
+	cloud.search = newFakeGlobalSearch(cloud)
+	// The fake cloud is polled without waiting for seconds
+	asyncWaiterTimeScale = 0.001
+	return cloud
+}
+
+// close stops the server and restores the poll intervals of the waiters
+func (c *fakeCloud) close() {
+	c.server.Close()
+	asyncWaiterTimeScale = c.scale
+}
+
+// handle registers a route of a fake, the segments of the path in braces match any
+// value, e.g. /vpc/vpcs/{id}
+func (c *fakeCloud) handle(method, path string, handler fakeHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.routes = append(c.routes, &fakeRoute{method: method, segments: splitFakePath(path), handler: handler, remaining: -1})
+}
+
+// on scripts the response of every matching request, it takes precedence over the fakes
+func (c *fakeCloud) on(method, path string, handler fakeHandler) {
+	c.script(method, path, handler, -1)
+}
+
+// once scripts the response of the next matching request only
+func (c *fakeCloud) once(method, path string, handler fakeHandler) {
+	c.script(method, path, handler, 1)
+}
+
+func (c *fakeCloud) script(method, path string, handler fakeHandler, times int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// The latest scripted response wins
+	c.scripted = append([]*fakeRoute{{method: method, segments: splitFakePath(path), handler: handler, remaining: times}}, c.scripted...)
+}
+
+// requestsTo returns the recorded requests matching the method and the path pattern
+func (c *fakeCloud) requestsTo(method, path string) []*fakeRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	segments := splitFakePath(path)
+	var requests []*fakeRequest
+	for _, r := range c.requests {
+		if _, ok := matchFakePath(segments, r.segments); ok && r.Method == method {
+			requests = append(requests, r)
+		}
+	}
+	return requests
+}
+
+// newID returns a new identifier with the given prefix, unique in the fake cloud
+func (c *fakeCloud) newID(prefix string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ids++
+	return fmt.Sprintf("%s%04d", prefix, c.ids)
+}
+
+func (c *fakeCloud) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// The segments are unescaped one by one, the CRNs in the paths contain escaped slashes
+	escaped := splitFakePath(r.URL.EscapedPath())
+	segments := make([]string, len(escaped))
+	for i, segment := range escaped {
+		if segments[i], err = url.PathUnescape(segment); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	request := &fakeRequest{
+		Method: r.Method,
+		Path:   "/" + strings.Join(segments, "/"),
+		Query:  r.URL.Query(),
+		Header: r.Header,
+		Body:   body,
+
+		segments: segments,
+	}
+
+	c.mu.Lock()
+	c.requests = append(c.requests, request)
+	route, params := c.match(r.Method, segments)
+	c.mu.Unlock()
+	if route == nil {
+		c.t.Errorf("Unexpected request to the fake cloud: %s %s", r.Method, request.Path)
+		writeFakeJSON(w, http.StatusNotFound, map[string]string{"message": "no fake for " + request.Path})
+		return
+	}
+	request.Params = params
+	status, response := route.handler(request)
+	writeFakeJSON(w, status, response)
+}
+
+func (c *fakeCloud) match(method string, segments []string) (*fakeRoute, map[string]string) {
+	for _, routes := range [][]*fakeRoute{c.scripted, c.routes} {
+		for _, route := range routes {
+			if route.method != method || route.remaining == 0 {
+				continue
+			}
+			if params, ok := matchFakePath(route.segments, segments); ok {
+				if route.remaining > 0 {
+					route.remaining--
+				}
+				return route, params
+			}
+		}
+	}
+	return nil, nil
+}
+
+func splitFakePath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func matchFakePath(pattern, segments []string) (map[string]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, p := range pattern {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			params[p[1:len(p)-1]] = segments[i]
+		} else if p != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, body interface{}) {
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// decode unmarshals the JSON body of the request
+func (r *fakeRequest) decode(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// object decodes the JSON object in the body of the request
+func (r *fakeRequest) object() (map[string]interface{}, error) {
+	object := map[string]interface{}{}
+	err := r.decode(&object)
+	return object, err
+}
+
+// session returns a client session authenticated with a fake token, whose clients
+// send their requests to the fake cloud. The IBMCLOUD_*_ENDPOINT variables still
+// take precedence over the fake endpoints.
+func (c *fakeCloud) session() ClientSession {
+	endpoints := endpointsFile{}
+	for service := range serviceEndpoints {
+		endpoints[service] = map[string]map[string]string{
+			publicVisibility: {"us-south": c.server.URL + "/" + service},
+		}
+	}
+	config := &Config{
+		Region:          "us-south",
+		Generation:      2,
+		Visibility:      publicVisibility,
+		IAMToken:        "Bearer fake-token",
+		IAMRefreshToken: "fake-refresh-token",
+		BluemixTimeout:  10 * time.Second,
+		endpoints:       endpoints,
+	}
+	s, err := newSession(config)
+	if err != nil {
+		c.t.Fatalf("Error creating the fake session: %s", err)
+	}
+	s.tokens.set("fake-token", time.Time{}, nil)
+	sess := &clientSession{config: config, session: s}
+	// The fake token is not refreshed and the user details are those of the fake account
+	sess.bluemixOnce.Do(func() {
+		sess.bmxUserDetails = &UserConfig{
+			userID:      "fake-user",
+			userEmail:   "fake-user@example.com",
+			userAccount: fakeAccountID,
+			cloudName:   "bluemix",
+			cloudType:   "public",
+			generation:  2,
+		}
+	})
+	return sess
+}
+
+// providers returns the providers of resource.UnitTest, configured with the fake session
+func (c *fakeCloud) providers() map[string]terraform.ResourceProvider {
+	sess := c.session()
+	provider := Provider().(*schema.Provider)
+	provider.ConfigureFunc = func(*schema.ResourceData) (interface{}, error) {
+		return sess, nil
+	}
+	return map[string]terraform.ResourceProvider{"ibm": provider}
+}
+
+const (
+	fakeAccountID       = "fakeaccount"
+	fakeResourceGroupID = "fake-resource-group"
+)
+
+// fakeCRN returns the CRN of a resource of the fake account
+func fakeCRN(service, region, resourceType, id string) string {
+	return fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s::%s:%s", service, region, fakeAccountID, resourceType, id)
+}
+
+func fakeTimestamp() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func TestFakeCloudRouting(t *testing.T) {
+	cloud := newFakeCloud(t)
+	defer cloud.close()
+
+	cloud.handle(http.MethodGet, "/test/items/{id}", func(r *fakeRequest) (int, interface{}) {
+		return http.StatusOK, map[string]string{"id": r.Params["id"]}
+	})
+	cloud.once(http.MethodGet, "/test/items/{id}", func(r *fakeRequest) (int, interface{}) {
+		return http.StatusServiceUnavailable, nil
+	})
+
+	get := func(path string) (int, map[string]string) {
+		resp, err := http.Get(cloud.server.URL + path)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		defer resp.Body.Close()
+		body := map[string]string{}
+		json.NewDecoder(resp.Body).Decode(&body)
+		return resp.StatusCode, body
+	}
+	if status, _ := get("/test/items/a"); status != http.StatusServiceUnavailable {
+		t.Errorf("expected the scripted response first, got %d", status)
+	}
+	if status, body := get("/test/items/crn:v1%2Fa"); status != http.StatusOK || body["id"] != "crn:v1/a" {
+		t.Errorf("expected the fake to receive the unescaped segment, got %d %v", status, body)
+	}
+	if n := len(cloud.requestsTo(http.MethodGet, "/test/items/{id}")); n != 2 {
+		t.Errorf("expected 2 recorded requests, got %d", n)
+	}
+}
@@ -0,0 +1,114 @@
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// fakeGlobalSearch serves the searches of the Global Search API. The queries are either
+// * or terms field:value joined by AND, a term on the tags matches any of the tags of
+// a resource. The search cursor is the offset of the next page, the last page has no
+// cursor.
+type fakeGlobalSearch struct {
+	cloud *fakeCloud
+
+	mu    sync.Mutex
+	items map[string]map[string]interface{}
+}
+
+func newFakeGlobalSearch(cloud *fakeCloud) *fakeGlobalSearch {
+	f := &fakeGlobalSearch{cloud: cloud, items: map[string]map[string]interface{}{}}
+	cloud.handle(http.MethodPost, "/global_search/v3/resources/search", f.search)
+	return f
+}
+
+// addItem adds a resource to the search index, the item must have a crn
+func (f *fakeGlobalSearch) addItem(item map[string]interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.items[item["crn"].(string)] = copyFakeObject(item)
+}
+
+func (f *fakeGlobalSearch) search(r *fakeRequest) (int, interface{}) {
+	var body struct {
+		Query        string   `json:"query"`
+		Fields       []string `json:"fields"`
+		SearchCursor string   `json:"search_cursor"`
+	}
+	if err := json.Unmarshal(r.Body, &body); err != nil || body.Query == "" {
+		return http.StatusBadRequest, map[string]string{"message": "invalid search body"}
+	}
+	limit, err := strconv.Atoi(r.Query.Get("limit"))
+	if err != nil || limit < 1 || limit > 1000 {
+		return http.StatusBadRequest, map[string]string{"message": "invalid limit " + r.Query.Get("limit")}
+	}
+	offset := 0
+	if body.SearchCursor != "" {
+		if offset, err = strconv.Atoi(body.SearchCursor); err != nil {
+			return http.StatusBadRequest, map[string]string{"message": "invalid search cursor"}
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	crns := []string{}
+	for crn, item := range f.items {
+		if fakeSearchMatch(item, body.Query) {
+			crns = append(crns, crn)
+		}
+	}
+	sort.Strings(crns)
+	items := []map[string]interface{}{}
+	for i := offset; i < len(crns) && i < offset+limit; i++ {
+		item := f.items[crns[i]]
+		projected := map[string]interface{}{"crn": crns[i]}
+		for _, field := range body.Fields {
+			name := strings.Split(field, ".")[0]
+			if value, ok := item[name]; ok {
+				projected[name] = value
+			}
+		}
+		items = append(items, projected)
+	}
+	result := map[string]interface{}{
+		"limit": limit,
+		"items": items,
+	}
+	if offset+len(items) < len(crns) {
+		result["search_cursor"] = strconv.Itoa(offset + len(items))
+	}
+	return http.StatusOK, result
+}
+
+func fakeSearchMatch(item map[string]interface{}, query string) bool {
+	if query == "*" {
+		return true
+	}
+	for _, term := range strings.Split(query, " AND ") {
+		parts := strings.SplitN(strings.TrimSpace(term), ":", 2)
+		if len(parts) != 2 {
+			return false
+		}
+		value := strings.Trim(parts[1], "\"")
+		switch actual := item[parts[0]].(type) {
+		case []interface{}:
+			found := false
+			for _, v := range actual {
+				found = found || fmt.Sprint(v) == value
+			}
+			if !found {
+				return false
+			}
+		default:
+			if fmt.Sprint(actual) != value {
+				return false
+			}
+		}
+	}
+	return true
+}
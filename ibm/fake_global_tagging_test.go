@@ -0,0 +1,120 @@
+package ibm
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// fakeGlobalTagging serves the user tags of the Global Tagging API, for both the
+// bluemix-go and the Platform SDK clients. Like the service, it is not case sensitive
+// and stores the tags in lower case.
+type fakeGlobalTagging struct {
+	cloud *fakeCloud
+
+	mu       sync.Mutex
+	attached map[string]map[string]bool
+}
+
+func newFakeGlobalTagging(cloud *fakeCloud) *fakeGlobalTagging {
+	f := &fakeGlobalTagging{cloud: cloud, attached: map[string]map[string]bool{}}
+	cloud.handle(http.MethodGet, "/global_tagging/v3/tags", f.listTags)
+	cloud.handle(http.MethodPost, "/global_tagging/v3/tags/attach", func(r *fakeRequest) (int, interface{}) {
+		return f.update(r, true)
+	})
+	cloud.handle(http.MethodPost, "/global_tagging/v3/tags/detach", func(r *fakeRequest) (int, interface{}) {
+		return f.update(r, false)
+	})
+	cloud.handle(http.MethodDelete, "/global_tagging/v3/tags/{tag}", f.deleteTag)
+	return f
+}
+
+// tags returns the sorted tags attached to a resource
+func (f *fakeGlobalTagging) tags(crn string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	tags := []string{}
+	for tag := range f.attached[crn] {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// listTags lists the tags attached to a resource, or every tag of the account
+func (f *fakeGlobalTagging) listTags(r *fakeRequest) (int, interface{}) {
+	var tags []string
+	if crn := r.Query.Get("attached_to"); crn != "" {
+		tags = f.tags(crn)
+	} else {
+		f.mu.Lock()
+		all := map[string]bool{}
+		for _, attached := range f.attached {
+			for tag := range attached {
+				all[tag] = true
+			}
+		}
+		f.mu.Unlock()
+		for tag := range all {
+			tags = append(tags, tag)
+		}
+		sort.Strings(tags)
+	}
+	items := make([]map[string]string, len(tags))
+	for i, tag := range tags {
+		items[i] = map[string]string{"name": tag}
+	}
+	return http.StatusOK, map[string]interface{}{"items": items, "total_count": len(items), "offset": 0, "limit": 100}
+}
+
+func (f *fakeGlobalTagging) update(r *fakeRequest, attach bool) (int, interface{}) {
+	var body struct {
+		Resources []struct {
+			ResourceID string `json:"resource_id"`
+		} `json:"resources"`
+		TagName  string   `json:"tag_name"`
+		TagNames []string `json:"tag_names"`
+	}
+	if err := r.decode(&body); err != nil {
+		return http.StatusBadRequest, map[string]string{"message": err.Error()}
+	}
+	names := body.TagNames
+	if body.TagName != "" {
+		names = append(names, body.TagName)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	results := make([]map[string]interface{}, len(body.Resources))
+	for i, resource := range body.Resources {
+		tags := f.attached[resource.ResourceID]
+		if tags == nil {
+			tags = map[string]bool{}
+			f.attached[resource.ResourceID] = tags
+		}
+		for _, name := range names {
+			name = strings.ToLower(name)
+			if attach {
+				tags[name] = true
+			} else {
+				delete(tags, name)
+			}
+		}
+		results[i] = map[string]interface{}{"resource_id": resource.ResourceID, "is_error": false}
+	}
+	return http.StatusOK, map[string]interface{}{"results": results}
+}
+
+// deleteTag deletes a tag, which fails while it is attached to a resource
+func (f *fakeGlobalTagging) deleteTag(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	tag := r.Params["tag"]
+	for _, tags := range f.attached {
+		if tags[tag] {
+			return http.StatusBadRequest, map[string]string{"message": "The tag " + tag + " is attached to resources"}
+		}
+	}
+	return http.StatusOK, map[string]interface{}{"results": []map[string]interface{}{{"tag_name": tag, "is_error": false}}}
+}
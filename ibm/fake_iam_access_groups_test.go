@@ -0,0 +1,62 @@
+package ibm
+
+import (
+	"net/http"
+	"sync"
+)
+
+// fakeIAMAccessGroups serves the access groups of the IAM Access Groups API. The
+// groups are listed in a single page.
+type fakeIAMAccessGroups struct {
+	cloud *fakeCloud
+
+	mu     sync.Mutex
+	groups map[string]map[string]interface{}
+}
+
+func newFakeIAMAccessGroups(cloud *fakeCloud) *fakeIAMAccessGroups {
+	f := &fakeIAMAccessGroups{cloud: cloud, groups: map[string]map[string]interface{}{}}
+	cloud.handle(http.MethodGet, "/iam/v2/groups", f.listGroups)
+	cloud.handle(http.MethodGet, "/iam/v2/groups/{id}", f.getGroup)
+	return f
+}
+
+// addGroup adds an access group to the fake account and returns its id
+func (f *fakeIAMAccessGroups) addGroup(name, description string) string {
+	id := f.cloud.newID("AccessGroupId-fake-")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.groups[id] = map[string]interface{}{
+		"id":          id,
+		"name":        name,
+		"description": description,
+		"account_id":  fakeAccountID,
+		"created_at":  fakeTimestamp(),
+	}
+	return id
+}
+
+func (f *fakeIAMAccessGroups) listGroups(r *fakeRequest) (int, interface{}) {
+	if r.Query.Get("account_id") != fakeAccountID {
+		return http.StatusForbidden, map[string]string{"message": "account " + r.Query.Get("account_id") + " not found"}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	groups := sortedFakeObjects(f.groups)
+	return http.StatusOK, map[string]interface{}{
+		"limit":       50,
+		"offset":      0,
+		"total_count": len(groups),
+		"groups":      groups,
+	}
+}
+
+func (f *fakeIAMAccessGroups) getGroup(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	group, ok := f.groups[r.Params["id"]]
+	if !ok {
+		return http.StatusNotFound, map[string]string{"message": "Group not found"}
+	}
+	return http.StatusOK, copyFakeObject(group)
+}
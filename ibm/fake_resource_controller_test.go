@@ -0,0 +1,192 @@
+package ibm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fakeResourceController serves the resource instances of the Resource Controller API
+// and the services and plans of the global catalog they refer to. The instances are
+// active as soon as they are created, and removed but still readable once deleted.
+type fakeResourceController struct {
+	cloud *fakeCloud
+
+	mu        sync.Mutex
+	instances map[string]map[string]interface{}
+	// catalog maps the id of a service or a plan to its catalog entry
+	catalog map[string]map[string]interface{}
+}
+
+func newFakeResourceController(cloud *fakeCloud) *fakeResourceController {
+	f := &fakeResourceController{
+		cloud:     cloud,
+		instances: map[string]map[string]interface{}{},
+		catalog:   map[string]map[string]interface{}{},
+	}
+	cloud.handle(http.MethodPost, "/resource_controller/v1/resource_instances", f.createInstance)
+	cloud.handle(http.MethodGet, "/resource_controller/v1/resource_instances", f.listInstances)
+	cloud.handle(http.MethodGet, "/resource_controller/v1/resource_instances/{id}", f.getInstance)
+	cloud.handle(http.MethodPatch, "/resource_controller/v1/resource_instances/{id}", f.updateInstance)
+	cloud.handle(http.MethodDelete, "/resource_controller/v1/resource_instances/{id}", f.deleteInstance)
+	cloud.handle(http.MethodGet, "/resource_catalog/api/v1/{id}", f.getCatalogEntry)
+	return f
+}
+
+// addPlan adds a service and one of its plans to the global catalog
+func (f *fakeResourceController) addPlan(serviceID, serviceName, planID, planName string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.catalog[serviceID] = map[string]interface{}{"id": serviceID, "name": serviceName, "kind": "service"}
+	f.catalog[planID] = map[string]interface{}{"id": planID, "name": planName, "kind": "plan", "parent_id": serviceID}
+}
+
+// addInstance adds an active instance of a plan of the catalog and returns its id
+func (f *fakeResourceController) addInstance(name, planID, resourceGroupID, location string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.newInstance(name, planID, resourceGroupID, location, nil)
+}
+
+// instance returns a copy of an instance, nil when it does not exist
+func (f *fakeResourceController) instance(id string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if instance, ok := f.instances[id]; ok {
+		return copyFakeObject(instance)
+	}
+	return nil
+}
+
+func (f *fakeResourceController) newInstance(name, planID, resourceGroupID, location string, parameters map[string]interface{}) string {
+	serviceID, serviceName := "", ""
+	if plan, ok := f.catalog[planID]; ok {
+		serviceID = plan["parent_id"].(string)
+		serviceName = f.catalog[serviceID]["name"].(string)
+	}
+	guid := f.cloud.newID("fake-guid-")
+	id := fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s:%s::", serviceName, location, fakeAccountID, guid)
+	now := time.Now().UTC()
+	f.instances[id] = map[string]interface{}{
+		"id":                  id,
+		"guid":                guid,
+		"crn":                 id,
+		"url":                 "/v1/resource_instances/" + guid,
+		"name":                name,
+		"region_id":           location,
+		"account_id":          fakeAccountID,
+		"resource_id":         serviceID,
+		"resource_plan_id":    planID,
+		"resource_group_id":   resourceGroupID,
+		"resource_group_name": "fake",
+		"parameters":          parameters,
+		"state":               "active",
+		"type":                "service_instance",
+		"created_at":          now,
+		"updated_at":          now,
+	}
+	return id
+}
+
+func (f *fakeResourceController) createInstance(r *fakeRequest) (int, interface{}) {
+	var body struct {
+		Name            string                 `json:"name"`
+		ServicePlanID   string                 `json:"resource_plan_id"`
+		ResourceGroupID string                 `json:"resource_group_id"`
+		Parameters      map[string]interface{} `json:"parameters"`
+		TargetCrn       string                 `json:"target_crn"`
+	}
+	if err := r.decode(&body); err != nil {
+		return http.StatusBadRequest, map[string]string{"message": err.Error()}
+	}
+	// The location is the last part of the CRN of the deployment
+	location := body.TargetCrn[strings.LastIndex(body.TargetCrn, ":")+1:]
+	if body.ResourceGroupID == "" {
+		body.ResourceGroupID = fakeResourceGroupID
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.catalog[body.ServicePlanID]; !ok {
+		return http.StatusBadRequest, map[string]string{"message": "unknown plan " + body.ServicePlanID}
+	}
+	id := f.newInstance(body.Name, body.ServicePlanID, body.ResourceGroupID, location, body.Parameters)
+	return http.StatusCreated, copyFakeObject(f.instances[id])
+}
+
+// listInstances lists the instances which are not removed, filtered by resource group,
+// service and plan
+func (f *fakeResourceController) listInstances(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	filters := map[string]string{}
+	for _, filter := range []string{"resource_group_id", "resource_id", "resource_plan_id"} {
+		if value := r.Query.Get(filter); value != "" {
+			filters[filter] = value
+		}
+	}
+	instances := []map[string]interface{}{}
+	for _, instance := range sortedFakeObjects(f.instances) {
+		match := instance["state"] != "removed"
+		for filter, value := range filters {
+			match = match && instance[filter] == value
+		}
+		if match {
+			instances = append(instances, instance)
+		}
+	}
+	return http.StatusOK, map[string]interface{}{"rows_count": len(instances), "next_url": nil, "resources": instances}
+}
+
+func (f *fakeResourceController) getInstance(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	instance, ok := f.instances[r.Params["id"]]
+	if !ok {
+		return http.StatusNotFound, map[string]string{"message": "Instance not found"}
+	}
+	return http.StatusOK, copyFakeObject(instance)
+}
+
+func (f *fakeResourceController) updateInstance(r *fakeRequest) (int, interface{}) {
+	patch, err := r.object()
+	if err != nil {
+		return http.StatusBadRequest, map[string]string{"message": err.Error()}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	instance, ok := f.instances[r.Params["id"]]
+	if !ok || instance["state"] == "removed" {
+		return http.StatusNotFound, map[string]string{"message": "Instance not found"}
+	}
+	for _, attribute := range []string{"name", "resource_plan_id", "parameters"} {
+		if value, ok := patch[attribute]; ok {
+			instance[attribute] = value
+		}
+	}
+	instance["updated_at"] = time.Now().UTC()
+	return http.StatusOK, copyFakeObject(instance)
+}
+
+func (f *fakeResourceController) deleteInstance(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	instance, ok := f.instances[r.Params["id"]]
+	if !ok || instance["state"] == "removed" {
+		return http.StatusNotFound, map[string]string{"message": "Instance not found"}
+	}
+	instance["state"] = "removed"
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeResourceController) getCatalogEntry(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	entry, ok := f.catalog[r.Params["id"]]
+	if !ok {
+		return http.StatusNotFound, map[string]string{"message": "Entry not found"}
+	}
+	return http.StatusOK, copyFakeObject(entry)
+}
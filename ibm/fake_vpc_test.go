@@ -0,0 +1,202 @@
+package ibm
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// fakeVPC serves the VPCs, their default security group and the subnets of the VPC API
+// (generation 2). A new VPC is pending for PendingPolls reads before it is available.
+type fakeVPC struct {
+	cloud *fakeCloud
+
+	mu             sync.Mutex
+	vpcs           map[string]map[string]interface{}
+	pendingPolls   map[string]int
+	securityGroups map[string]map[string]interface{}
+	PendingPolls   int
+}
+
+func newFakeVPC(cloud *fakeCloud) *fakeVPC {
+	f := &fakeVPC{
+		cloud:          cloud,
+		vpcs:           map[string]map[string]interface{}{},
+		pendingPolls:   map[string]int{},
+		securityGroups: map[string]map[string]interface{}{},
+		PendingPolls:   1,
+	}
+	cloud.handle(http.MethodPost, "/vpc/vpcs", f.createVPC)
+	cloud.handle(http.MethodGet, "/vpc/vpcs", f.listVPCs)
+	cloud.handle(http.MethodGet, "/vpc/vpcs/{id}", f.getVPC)
+	cloud.handle(http.MethodPatch, "/vpc/vpcs/{id}", f.updateVPC)
+	cloud.handle(http.MethodDelete, "/vpc/vpcs/{id}", f.deleteVPC)
+	cloud.handle(http.MethodGet, "/vpc/security_groups", f.listSecurityGroups)
+	cloud.handle(http.MethodGet, "/vpc/subnets", func(r *fakeRequest) (int, interface{}) {
+		return http.StatusOK, f.collection("subnets", nil)
+	})
+	return f
+}
+
+// fakeVPCError returns the body of an error of the VPC API
+func fakeVPCError(code, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"errors": []map[string]interface{}{{"code": code, "message": message}},
+		"trace":  "fake-trace",
+	}
+}
+
+func (f *fakeVPC) reference(kind, id, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":   id,
+		"name": name,
+		"crn":  fakeCRN("is", "us-south", kind, id),
+		"href": f.cloud.server.URL + "/vpc/" + kind + "s/" + id,
+	}
+}
+
+func (f *fakeVPC) collection(name string, items []map[string]interface{}) map[string]interface{} {
+	if items == nil {
+		items = []map[string]interface{}{}
+	}
+	return map[string]interface{}{
+		name:          items,
+		"limit":       50,
+		"total_count": len(items),
+		"first":       map[string]string{"href": f.cloud.server.URL + "/vpc/" + name + "?limit=50"},
+	}
+}
+
+func (f *fakeVPC) createVPC(r *fakeRequest) (int, interface{}) {
+	var body struct {
+		Name          string `json:"name"`
+		ClassicAccess bool   `json:"classic_access"`
+		ResourceGroup struct {
+			ID string `json:"id"`
+		} `json:"resource_group"`
+	}
+	if err := r.decode(&body); err != nil {
+		return http.StatusBadRequest, fakeVPCError("bad_request", err.Error())
+	}
+	resourceGroup := body.ResourceGroup.ID
+	if resourceGroup == "" {
+		resourceGroup = fakeResourceGroupID
+	}
+
+	id := f.cloud.newID("r006-vpc-")
+	sgID := f.cloud.newID("r006-sg-")
+	vpc := f.reference("vpc", id, body.Name)
+	vpc["status"] = isVPCPending
+	vpc["classic_access"] = body.ClassicAccess
+	vpc["created_at"] = fakeTimestamp()
+	vpc["default_network_acl"] = f.reference("network_acl", f.cloud.newID("r006-acl-"), body.Name+"-acl")
+	vpc["default_security_group"] = f.reference("security_group", sgID, body.Name+"-sg")
+	vpc["resource_group"] = map[string]interface{}{"id": resourceGroup, "name": "fake", "href": f.cloud.server.URL + "/resource_groups/" + resourceGroup}
+	vpc["cse_source_ips"] = []interface{}{}
+
+	sg := f.reference("security_group", sgID, body.Name+"-sg")
+	sg["vpc"] = f.reference("vpc", id, body.Name)
+	sg["rules"] = []interface{}{}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.vpcs[id] = vpc
+	f.pendingPolls[id] = f.PendingPolls
+	f.securityGroups[sgID] = sg
+	return http.StatusCreated, copyFakeObject(vpc)
+}
+
+func (f *fakeVPC) listVPCs(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return http.StatusOK, f.collection("vpcs", sortedFakeObjects(f.vpcs))
+}
+
+func (f *fakeVPC) getVPC(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := r.Params["id"]
+	vpc, ok := f.vpcs[id]
+	if !ok {
+		return http.StatusNotFound, fakeVPCError("not_found", "VPC not found")
+	}
+	if f.pendingPolls[id] > 0 {
+		f.pendingPolls[id]--
+	} else {
+		vpc["status"] = isVPCAvailable
+	}
+	return http.StatusOK, copyFakeObject(vpc)
+}
+
+func (f *fakeVPC) updateVPC(r *fakeRequest) (int, interface{}) {
+	patch, err := r.object()
+	if err != nil {
+		return http.StatusBadRequest, fakeVPCError("bad_request", err.Error())
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	vpc, ok := f.vpcs[r.Params["id"]]
+	if !ok {
+		return http.StatusNotFound, fakeVPCError("not_found", "VPC not found")
+	}
+	if name, ok := patch["name"]; ok {
+		vpc["name"] = name
+	}
+	return http.StatusOK, copyFakeObject(vpc)
+}
+
+func (f *fakeVPC) deleteVPC(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := r.Params["id"]
+	if _, ok := f.vpcs[id]; !ok {
+		return http.StatusNotFound, fakeVPCError("not_found", "VPC not found")
+	}
+	delete(f.vpcs, id)
+	delete(f.pendingPolls, id)
+	for sgID, sg := range f.securityGroups {
+		if sg["vpc"].(map[string]interface{})["id"] == id {
+			delete(f.securityGroups, sgID)
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeVPC) listSecurityGroups(r *fakeRequest) (int, interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return http.StatusOK, f.collection("security_groups", sortedFakeObjects(f.securityGroups))
+}
+
+// get returns a copy of the VPC, nil when it does not exist
+func (f *fakeVPC) get(id string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if vpc, ok := f.vpcs[id]; ok {
+		return copyFakeObject(vpc)
+	}
+	return nil
+}
+
+// sortedFakeObjects returns copies of the objects sorted by key, the order in which the
+// fakes list them
+func sortedFakeObjects(objects map[string]map[string]interface{}) []map[string]interface{} {
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	sorted := make([]map[string]interface{}, len(keys))
+	for i, key := range keys {
+		sorted[i] = copyFakeObject(objects[key])
+	}
+	return sorted
+}
+
+func copyFakeObject(object map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(object))
+	for k, v := range object {
+		c[k] = v
+	}
+	return c
+}
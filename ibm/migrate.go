@@ -0,0 +1,571 @@
+package ibm
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// migrationRename is the new name of a deprecated resource or data source type, with
+// the arguments of the type renamed with it. The resources of the types whose state
+// cannot be moved to the new type are removed from the state and imported by their ID.
+type migrationRename struct {
+	name      string
+	arguments map[string]string
+	imported  bool
+}
+
+// migrationTypeRenames are the deprecated resource and data source types, the
+// ibmcloud_* types of the former ibmcloud provider and the Key Protect keys managed
+// by ibm_kms_key. The state of an ibm_kp_key lacks the endpoint_type and the
+// instance_id of ibm_kms_key, which force a new key, the keys are imported by CRN.
+var migrationTypeRenames = map[string]migrationRename{
+	"ibmcloud_cf_account":                        {name: "ibm_account"},
+	"ibmcloud_cf_app":                            {name: "ibm_app"},
+	"ibmcloud_cf_org":                            {name: "ibm_org"},
+	"ibmcloud_cf_private_domain":                 {name: "ibm_app_domain_private"},
+	"ibmcloud_cf_route":                          {name: "ibm_app_route"},
+	"ibmcloud_cf_service_instance":               {name: "ibm_service_instance"},
+	"ibmcloud_cf_service_key":                    {name: "ibm_service_key"},
+	"ibmcloud_cf_service_plan":                   {name: "ibm_service_plan"},
+	"ibmcloud_cf_shared_domain":                  {name: "ibm_app_domain_shared"},
+	"ibmcloud_cf_space":                          {name: "ibm_space"},
+	"ibmcloud_cs_cluster":                        {name: "ibm_container_cluster"},
+	"ibmcloud_cs_cluster_bind_service":           {name: "ibm_container_bind_service"},
+	"ibmcloud_cs_cluster_config":                 {name: "ibm_container_cluster_config"},
+	"ibmcloud_cs_worker":                         {name: "ibm_container_cluster_worker"},
+	"ibmcloud_infra_bare_metal":                  {name: "ibm_compute_bare_metal"},
+	"ibmcloud_infra_basic_monitor":               {name: "ibm_compute_monitor"},
+	"ibmcloud_infra_block_storage":               {name: "ibm_storage_block"},
+	"ibmcloud_infra_dns_domain":                  {name: "ibm_dns_domain"},
+	"ibmcloud_infra_dns_domain_record":           {name: "ibm_dns_record"},
+	"ibmcloud_infra_file_storage":                {name: "ibm_storage_file"},
+	"ibmcloud_infra_fw_hardware_dedicated":       {name: "ibm_firewall"},
+	"ibmcloud_infra_fw_hardware_dedicated_rules": {name: "ibm_firewall_policy"},
+	"ibmcloud_infra_global_ip":                   {name: "ibm_network_public_ip"},
+	"ibmcloud_infra_image_template":              {name: "ibm_compute_image_template"},
+	"ibmcloud_infra_lb_local":                    {name: "ibm_lb"},
+	"ibmcloud_infra_lb_local_service":            {name: "ibm_lb_service"},
+	"ibmcloud_infra_lb_local_service_group":      {name: "ibm_lb_service_group"},
+	"ibmcloud_infra_lb_vpx":                      {name: "ibm_lb_vpx"},
+	"ibmcloud_infra_lb_vpx_ha":                   {name: "ibm_lb_vpx_ha"},
+	"ibmcloud_infra_lb_vpx_service":              {name: "ibm_lb_vpx_service"},
+	"ibmcloud_infra_lb_vpx_vip":                  {name: "ibm_lb_vpx_vip"},
+	"ibmcloud_infra_objectstorage_account":       {name: "ibm_object_storage_account"},
+	"ibmcloud_infra_provisioning_hook":           {name: "ibm_compute_provisioning_hook"},
+	"ibmcloud_infra_scale_group":                 {name: "ibm_compute_autoscale_group"},
+	"ibmcloud_infra_scale_policy":                {name: "ibm_compute_autoscale_policy"},
+	"ibmcloud_infra_security_certificate":        {name: "ibm_compute_ssl_certificate"},
+	"ibmcloud_infra_ssh_key":                     {name: "ibm_compute_ssh_key"},
+	"ibmcloud_infra_user":                        {name: "ibm_compute_user"},
+	"ibmcloud_infra_virtual_guest":               {name: "ibm_compute_vm_instance"},
+	"ibmcloud_infra_vlan":                        {name: "ibm_network_vlan"},
+	"ibm_kp_key": {
+		name:      "ibm_kms_key",
+		arguments: map[string]string{"key_protect_id": "instance_id"},
+		imported:  true,
+	},
+}
+
+// migrationProviderRenames are the former names of the provider
+var migrationProviderRenames = map[string]string{
+	"ibmcloud": "ibm",
+}
+
+// migrationProviderArguments are the deprecated arguments of the provider replaced by
+// another argument, the other deprecated arguments are only reported
+var migrationProviderArguments = map[string]string{
+	"bluemix_api_key":        "ibmcloud_api_key",
+	"bluemix_timeout":        "ibmcloud_timeout",
+	"softlayer_api_key":      "iaas_classic_api_key",
+	"softlayer_username":     "iaas_classic_username",
+	"softlayer_endpoint_url": "iaas_classic_endpoint_url",
+	"softlayer_timeout":      "iaas_classic_timeout",
+}
+
+// migrationFinding is a deprecated construct found in a configuration, it is fixed
+// when the migration rewrites it
+type migrationFinding struct {
+	Range   hcl.Range
+	Message string
+	Fixed   bool
+}
+
+func (f migrationFinding) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s", f.Range.Filename, f.Range.Start.Line, f.Range.Start.Column, f.Message)
+}
+
+// migrationMove is the move of a resource in the state to its new type, or its
+// removal and import as the new type
+type migrationMove struct {
+	From   string
+	To     string
+	Import bool
+}
+
+// migrationInstance is a resource instance of a state, e.g. ibm_kp_key.key[0]
+type migrationInstance struct {
+	Address string
+	ID      string
+}
+
+// migrationEdit replaces the bytes from Start to End of a file
+type migrationEdit struct {
+	Start int
+	End   int
+	Text  string
+}
+
+// migration is the migration of the files of a module
+type migration struct {
+	provider *schema.Provider
+	findings []migrationFinding
+	moves    []migrationMove
+}
+
+// MigrateCommand implements the migrate subcommand of the provider binary. It rewrites
+// the deprecated resource types, arguments and provider arguments of the .tf files of
+// the given files and directories, and writes the terraform commands moving the
+// renamed resources in the state, or removing and importing them by the IDs read from
+// the state of -state. With -check the files are not rewritten, the findings are only
+// reported.
+func MigrateCommand(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	flags.SetOutput(out)
+	check := flags.Bool("check", false, "Only report the findings, and fail when the files need to be migrated")
+	statePath := flags.String("state", "", "The JSON state of the configuration, e.g. written by terraform state pull, to read the IDs of the resources to import")
+	flags.Usage = func() {
+		fmt.Fprintf(out, "Usage: terraform-provider-ibm migrate [-check] [-state FILE] [PATH...]\n\n")
+		fmt.Fprintf(out, "Migrates the deprecated resource types, arguments and provider arguments of the .tf files of the paths, the current directory when no PATH is given.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	files, err := migrationFiles(paths)
+	if err != nil {
+		return err
+	}
+	instances, err := migrationStateInstances(*statePath)
+	if err != nil {
+		return err
+	}
+
+	provider := Provider().(*schema.Provider)
+	fixes := 0
+	for _, dir := range sortedKeys(files) {
+		m := &migration{provider: provider}
+		for _, filename := range files[dir] {
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return fmt.Errorf("Error reading %s: %s", filename, err)
+			}
+			migrated, err := m.migrateFile(filename, src)
+			if err != nil {
+				return err
+			}
+			if !*check && !bytes.Equal(migrated, src) {
+				if err := ioutil.WriteFile(filename, migrated, 0644); err != nil {
+					return fmt.Errorf("Error writing %s: %s", filename, err)
+				}
+			}
+		}
+		for _, f := range m.findings {
+			if f.Fixed {
+				fixes++
+			}
+			if *check || !f.Fixed {
+				fmt.Fprintln(out, f)
+			}
+		}
+		if !*check && len(m.moves) > 0 {
+			fmt.Fprintf(out, "\n# State moves of the resources of %s\n", dir)
+			writeMigrationMoves(out, m.moves, instances)
+		}
+	}
+	if *check && fixes > 0 {
+		return fmt.Errorf("%d deprecated constructs to migrate", fixes)
+	}
+	return nil
+}
+
+// writeMigrationMoves writes the terraform commands moving the resources in the state.
+// The resources imported are removed and imported again with the IDs of their
+// instances, the commands are commented out when the resource is not in the state.
+func writeMigrationMoves(out io.Writer, moves []migrationMove, instances map[string][]migrationInstance) {
+	for _, move := range moves {
+		if !move.Import {
+			fmt.Fprintf(out, "terraform state mv %s %s\n", move.From, move.To)
+			continue
+		}
+		if len(instances[move.From]) == 0 {
+			fmt.Fprintf(out, "# %s is not in the state of -state, remove it and import %s with its ID:\n", move.From, move.To)
+			fmt.Fprintf(out, "# terraform state rm %s\n", move.From)
+			fmt.Fprintf(out, "# terraform import %s ID\n", move.To)
+			continue
+		}
+		fmt.Fprintf(out, "terraform state rm %s\n", move.From)
+		for _, instance := range instances[move.From] {
+			address := move.To + strings.TrimPrefix(instance.Address, move.From)
+			if strings.Contains(address, "[") {
+				address = "'" + address + "'"
+			}
+			fmt.Fprintf(out, "terraform import %s %s\n", address, instance.ID)
+		}
+	}
+}
+
+// migrationStateInstances returns the resource instances of the root module of a JSON
+// state by resource address, none when path is empty
+func migrationStateInstances(path string) (map[string][]migrationInstance, error) {
+	instances := map[string][]migrationInstance{}
+	if path == "" {
+		return instances, nil
+	}
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the state %s: %s", path, err)
+	}
+	var state struct {
+		Resources []struct {
+			Module    string `json:"module"`
+			Mode      string `json:"mode"`
+			Type      string `json:"type"`
+			Name      string `json:"name"`
+			Instances []struct {
+				IndexKey   interface{} `json:"index_key"`
+				Attributes struct {
+					ID string `json:"id"`
+				} `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal(src, &state); err != nil {
+		return nil, fmt.Errorf("Error decoding the state %s: %s", path, err)
+	}
+	for _, r := range state.Resources {
+		if r.Module != "" || r.Mode != "managed" {
+			continue
+		}
+		address := r.Type + "." + r.Name
+		for _, instance := range r.Instances {
+			instanceAddress := address
+			switch key := instance.IndexKey.(type) {
+			case float64:
+				instanceAddress += fmt.Sprintf("[%d]", int(key))
+			case string:
+				instanceAddress += "[" + strconv.Quote(key) + "]"
+			}
+			instances[address] = append(instances[address], migrationInstance{Address: instanceAddress, ID: instance.Attributes.ID})
+		}
+	}
+	return instances, nil
+}
+
+// migrationFiles returns the .tf files of the paths by directory, the hidden
+// directories like .terraform are skipped
+func migrationFiles(paths []string) (map[string][]string, error) {
+	files := map[string][]string{}
+	for _, path := range paths {
+		err := filepath.Walk(path, func(name string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if name != path && strings.HasPrefix(info.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if filepath.Ext(name) == ".tf" {
+				files[filepath.Dir(name)] = append(files[filepath.Dir(name)], name)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error listing the files of %s: %s", path, err)
+		}
+	}
+	return files, nil
+}
+
+// migrateFile returns the migrated content of the file, the findings and the moves
+// are added to the migration
+func (m *migration) migrateFile(filename string, src []byte) ([]byte, error) {
+	file, diags := hclsyntax.ParseConfig(src, filename, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("Error parsing %s: %s", filename, diags)
+	}
+	body := file.Body.(*hclsyntax.Body)
+
+	var edits []migrationEdit
+	for _, block := range body.Blocks {
+		switch {
+		case block.Type == "provider" && len(block.Labels) == 1:
+			edits = append(edits, m.migrateProvider(src, block)...)
+		case (block.Type == "resource" || block.Type == "data") && len(block.Labels) == 2:
+			blockEdits, move := m.migrateResource(src, block)
+			edits = append(edits, blockEdits...)
+			if move != nil {
+				m.moves = append(m.moves, *move)
+			}
+		}
+	}
+	edits = append(edits, m.migrateReferences(body)...)
+	return applyMigrationEdits(src, edits), nil
+}
+
+func (m *migration) migrateProvider(src []byte, block *hclsyntax.Block) []migrationEdit {
+	var edits []migrationEdit
+	name := block.Labels[0]
+	if renamed, ok := migrationProviderRenames[name]; ok {
+		m.addFinding(block.LabelRanges[0], true, "provider %s is renamed to %s", name, renamed)
+		edits = append(edits, labelEdit(src, block.LabelRanges[0], renamed))
+		name = renamed
+	}
+	if name != "ibm" {
+		return edits
+	}
+	for _, attribute := range sortedAttributes(block.Body) {
+		s, ok := m.provider.Schema[attribute.Name]
+		if !ok || s.Deprecated == "" {
+			continue
+		}
+		replacement, ok := migrationProviderArguments[attribute.Name]
+		if !ok {
+			m.addFinding(attribute.NameRange, false, "provider argument %s is deprecated: %s", attribute.Name, s.Deprecated)
+			continue
+		}
+		if _, ok := block.Body.Attributes[replacement]; ok {
+			m.addFinding(attribute.NameRange, false, "provider argument %s is deprecated, remove it as %s is set", attribute.Name, replacement)
+			continue
+		}
+		m.addFinding(attribute.NameRange, true, "provider argument %s is renamed to %s", attribute.Name, replacement)
+		edits = append(edits, rangeEdit(attribute.NameRange, replacement))
+	}
+	return edits
+}
+
+// migrateResource renames the type and the arguments of a resource or data source, it
+// returns the state move of a renamed resource
+func (m *migration) migrateResource(src []byte, block *hclsyntax.Block) ([]migrationEdit, *migrationMove) {
+	var edits []migrationEdit
+	var move *migrationMove
+	kind, resourceType, name := "resource", block.Labels[0], block.Labels[1]
+	resources := m.provider.ResourcesMap
+	if block.Type == "data" {
+		kind, resources = "data source", m.provider.DataSourcesMap
+	}
+
+	rename, renamed := migrationTypeRenames[resourceType]
+	if renamed {
+		if _, ok := resources[rename.name]; !ok {
+			renamed = false
+		}
+	}
+	if renamed {
+		m.addFinding(block.LabelRanges[0], true, "%s type %s is renamed to %s", kind, resourceType, rename.name)
+		edits = append(edits, labelEdit(src, block.LabelRanges[0], rename.name))
+		if block.Type == "resource" {
+			move = &migrationMove{From: resourceType + "." + name, To: rename.name + "." + name, Import: rename.imported}
+		}
+		for _, attribute := range sortedAttributes(block.Body) {
+			replacement, ok := rename.arguments[attribute.Name]
+			if !ok {
+				continue
+			}
+			if _, ok := block.Body.Attributes[replacement]; ok {
+				m.addFinding(attribute.NameRange, false, "argument %s of %s is renamed to %s, which is already set", attribute.Name, resourceType, replacement)
+				continue
+			}
+			m.addFinding(attribute.NameRange, true, "argument %s of %s is renamed to %s", attribute.Name, resourceType, replacement)
+			edits = append(edits, rangeEdit(attribute.NameRange, replacement))
+		}
+		edits = append(edits, m.migrateIgnoreChanges(block, rename)...)
+		resourceType = rename.name
+	}
+
+	if r, ok := resources[resourceType]; ok {
+		for _, attribute := range sortedAttributes(block.Body) {
+			if _, ok := rename.arguments[attribute.Name]; renamed && ok {
+				continue
+			}
+			if s, ok := r.Schema[attribute.Name]; ok && s.Deprecated != "" {
+				m.addFinding(attribute.NameRange, false, "argument %s of %s is deprecated: %s", attribute.Name, resourceType, s.Deprecated)
+			}
+		}
+	}
+	return edits, move
+}
+
+// migrateIgnoreChanges renames the arguments of a renamed resource listed in the
+// ignore_changes of its lifecycle block
+func (m *migration) migrateIgnoreChanges(block *hclsyntax.Block, rename migrationRename) []migrationEdit {
+	var edits []migrationEdit
+	for _, lifecycle := range block.Body.Blocks {
+		attribute, ok := lifecycle.Body.Attributes["ignore_changes"]
+		if lifecycle.Type != "lifecycle" || !ok {
+			continue
+		}
+		hclsyntax.VisitAll(attribute.Expr, func(node hclsyntax.Node) hcl.Diagnostics {
+			expr, ok := node.(*hclsyntax.ScopeTraversalExpr)
+			if !ok {
+				return nil
+			}
+			root := expr.Traversal[0].(hcl.TraverseRoot)
+			if replacement, ok := rename.arguments[root.Name]; ok {
+				edits = append(edits, rangeEdit(root.SrcRange, replacement))
+			}
+			return nil
+		})
+	}
+	return edits
+}
+
+// migrateReferences renames the references to the renamed resources, data sources
+// and providers, and to the renamed arguments of the resources and data sources, e.g.
+// ibm_kp_key.key.key_protect_id in any expression of the file. The moved blocks of the
+// configuration keep the former addresses.
+func (m *migration) migrateReferences(body *hclsyntax.Body) []migrationEdit {
+	var edits []migrationEdit
+	var nodes []hclsyntax.Node
+	for _, attribute := range body.Attributes {
+		nodes = append(nodes, attribute)
+	}
+	for _, block := range body.Blocks {
+		if block.Type != "moved" {
+			nodes = append(nodes, block)
+		}
+	}
+	for _, node := range nodes {
+		edits = append(edits, m.migrateNodeReferences(node)...)
+	}
+	return edits
+}
+
+func (m *migration) migrateNodeReferences(node hclsyntax.Node) []migrationEdit {
+	var edits []migrationEdit
+	hclsyntax.VisitAll(node, func(node hclsyntax.Node) hcl.Diagnostics {
+		expr, ok := node.(*hclsyntax.ScopeTraversalExpr)
+		if !ok {
+			return nil
+		}
+		traversal := expr.Traversal
+		root := traversal[0].(hcl.TraverseRoot)
+		if renamed, ok := migrationProviderRenames[root.Name]; ok {
+			edits = append(edits, rangeEdit(root.SrcRange, renamed))
+			return nil
+		}
+		typeStep := 0
+		resources := m.provider.ResourcesMap
+		if root.Name == "data" {
+			typeStep, resources = 1, m.provider.DataSourcesMap
+		}
+		if len(traversal) <= typeStep {
+			return nil
+		}
+		resourceType, typeRange := traversalName(traversal[typeStep])
+		rename, ok := migrationTypeRenames[resourceType]
+		if !ok {
+			return nil
+		}
+		if _, ok := resources[rename.name]; !ok {
+			return nil
+		}
+		edits = append(edits, suffixEdit(typeRange, resourceType, rename.name))
+		// The argument follows the name of the resource and its optional index
+		for i := typeStep + 2; i < len(traversal); i++ {
+			if _, ok := traversal[i].(hcl.TraverseIndex); ok {
+				continue
+			}
+			if argument, argumentRange := traversalName(traversal[i]); argument != "" {
+				if replacement, ok := rename.arguments[argument]; ok {
+					edits = append(edits, suffixEdit(argumentRange, argument, replacement))
+				}
+			}
+			break
+		}
+		return nil
+	})
+	return edits
+}
+
+func (m *migration) addFinding(rng hcl.Range, fixed bool, format string, args ...interface{}) {
+	m.findings = append(m.findings, migrationFinding{Range: rng, Message: fmt.Sprintf(format, args...), Fixed: fixed})
+}
+
+func traversalName(step hcl.Traverser) (string, hcl.Range) {
+	switch s := step.(type) {
+	case hcl.TraverseRoot:
+		return s.Name, s.SrcRange
+	case hcl.TraverseAttr:
+		return s.Name, s.SrcRange
+	}
+	return "", hcl.Range{}
+}
+
+func rangeEdit(rng hcl.Range, text string) migrationEdit {
+	return migrationEdit{Start: rng.Start.Byte, End: rng.End.Byte, Text: text}
+}
+
+// suffixEdit replaces the name at the end of the range, the range of an attribute
+// traversal starts with the dot
+func suffixEdit(rng hcl.Range, name, text string) migrationEdit {
+	return migrationEdit{Start: rng.End.Byte - len(name), End: rng.End.Byte, Text: text}
+}
+
+// labelEdit replaces a block label, keeping its quotes
+func labelEdit(src []byte, rng hcl.Range, text string) migrationEdit {
+	if src[rng.Start.Byte] == '"' {
+		return migrationEdit{Start: rng.Start.Byte + 1, End: rng.End.Byte - 1, Text: text}
+	}
+	return rangeEdit(rng, text)
+}
+
+func applyMigrationEdits(src []byte, edits []migrationEdit) []byte {
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].Start > edits[j].Start
+	})
+	migrated := append([]byte(nil), src...)
+	for _, edit := range edits {
+		migrated = append(migrated[:edit.Start], append([]byte(edit.Text), migrated[edit.End:]...)...)
+	}
+	return migrated
+}
+
+// sortedAttributes returns the attributes of the body in the order of the file
+func sortedAttributes(body *hclsyntax.Body) []*hclsyntax.Attribute {
+	attributes := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attribute := range body.Attributes {
+		attributes = append(attributes, attribute)
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].NameRange.Start.Byte < attributes[j].NameRange.Start.Byte
+	})
+	return attributes
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,258 @@
+package ibm
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const testMigrationConfig = `provider "ibmcloud" {
+  bluemix_api_key = var.api_key
+  softlayer_username = "user"
+  iaas_classic_api_key = var.classic_api_key
+  softlayer_api_key = var.classic_api_key
+  riaas_endpoint = "us-south.iaas.cloud.ibm.com"
+}
+
+resource "ibm_kp_key" "key" {
+  count          = 2
+  key_protect_id = ibm_resource_instance.kp.guid # the instance
+  key_name       = "key"
+
+  lifecycle {
+    ignore_changes = [key_protect_id]
+  }
+}
+
+resource "ibmcloud_infra_vlan" "vlan" {
+  provider = ibmcloud.dallas
+  name     = "vlan"
+}
+
+data "ibm_kp_key" "keys" {
+  key_protect_id = ibm_kp_key.key[0].key_protect_id
+}
+
+output "crn" {
+  value      = "${ibm_kp_key.key[1].crn} in ${data.ibm_kp_key.keys.key_protect_id}"
+  depends_on = [ibmcloud_infra_vlan.vlan]
+}
+`
+
+const testMigratedConfig = `provider "ibm" {
+  ibmcloud_api_key = var.api_key
+  iaas_classic_username = "user"
+  iaas_classic_api_key = var.classic_api_key
+  softlayer_api_key = var.classic_api_key
+  riaas_endpoint = "us-south.iaas.cloud.ibm.com"
+}
+
+resource "ibm_kms_key" "key" {
+  count          = 2
+  instance_id = ibm_resource_instance.kp.guid # the instance
+  key_name       = "key"
+
+  lifecycle {
+    ignore_changes = [instance_id]
+  }
+}
+
+resource "ibm_network_vlan" "vlan" {
+  provider = ibm.dallas
+  name     = "vlan"
+}
+
+data "ibm_kms_key" "keys" {
+  instance_id = ibm_kms_key.key[0].instance_id
+}
+
+output "crn" {
+  value      = "${ibm_kms_key.key[1].crn} in ${data.ibm_kms_key.keys.instance_id}"
+  depends_on = [ibm_network_vlan.vlan]
+}
+`
+
+func TestMigrateFile(t *testing.T) {
+	m := &migration{provider: Provider().(*schema.Provider)}
+	migrated, err := m.migrateFile("main.tf", []byte(testMigrationConfig))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(migrated) != testMigratedConfig {
+		t.Errorf("unexpected migration:\n%s", migrated)
+	}
+
+	var findings []string
+	for _, f := range m.findings {
+		if !f.Fixed {
+			findings = append(findings, f.String())
+		}
+	}
+	expected := []string{
+		"main.tf:5:3: provider argument softlayer_api_key is deprecated, remove it as iaas_classic_api_key is set",
+		"main.tf:6:3: provider argument riaas_endpoint is deprecated: This field is deprecated use generation",
+	}
+	if strings.Join(findings, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected findings not fixed:\n%s", strings.Join(findings, "\n"))
+	}
+
+	expectedMoves := []migrationMove{
+		{From: "ibm_kp_key.key", To: "ibm_kms_key.key", Import: true},
+		{From: "ibmcloud_infra_vlan.vlan", To: "ibm_network_vlan.vlan"},
+	}
+	if len(m.moves) != len(expectedMoves) {
+		t.Fatalf("unexpected moves %v", m.moves)
+	}
+	for i, move := range expectedMoves {
+		if m.moves[i] != move {
+			t.Errorf("expected the move %v, got %v", move, m.moves[i])
+		}
+	}
+
+	again := &migration{provider: m.provider}
+	if remigrated, err := again.migrateFile("main.tf", migrated); err != nil || !bytes.Equal(remigrated, migrated) || len(again.moves) != 0 {
+		t.Errorf("expected the migration to be idempotent, got %v:\n%s", err, remigrated)
+	}
+
+	if _, err := m.migrateFile("main.tf", []byte(`resource "ibm_kp_key" {`)); err == nil {
+		t.Errorf("expected an error for an invalid file")
+	}
+}
+
+func TestWriteMigrationMoves(t *testing.T) {
+	moves := []migrationMove{
+		{From: "ibmcloud_infra_vlan.vlan", To: "ibm_network_vlan.vlan"},
+		{From: "ibm_kp_key.key", To: "ibm_kms_key.key", Import: true},
+		{From: "ibm_kp_key.other", To: "ibm_kms_key.other", Import: true},
+	}
+	instances := map[string][]migrationInstance{
+		"ibm_kp_key.key": {
+			{Address: "ibm_kp_key.key[0]", ID: "crn:v1:bluemix:public:kms:us-south:a/fakeaccount:instance:key:key-0"},
+			{Address: `ibm_kp_key.key["b"]`, ID: "crn:v1:bluemix:public:kms:us-south:a/fakeaccount:instance:key:key-b"},
+		},
+	}
+	var out bytes.Buffer
+	writeMigrationMoves(&out, moves, instances)
+	expected := `terraform state mv ibmcloud_infra_vlan.vlan ibm_network_vlan.vlan
+terraform state rm ibm_kp_key.key
+terraform import 'ibm_kms_key.key[0]' crn:v1:bluemix:public:kms:us-south:a/fakeaccount:instance:key:key-0
+terraform import 'ibm_kms_key.key["b"]' crn:v1:bluemix:public:kms:us-south:a/fakeaccount:instance:key:key-b
+# ibm_kp_key.other is not in the state of -state, remove it and import ibm_kms_key.other with its ID:
+# terraform state rm ibm_kp_key.other
+# terraform import ibm_kms_key.other ID
+`
+	if out.String() != expected {
+		t.Errorf("unexpected state moves:\n%s", out.String())
+	}
+}
+
+func TestMigrationRenames(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	for old, rename := range migrationTypeRenames {
+		r, resource := provider.ResourcesMap[rename.name]
+		d, dataSource := provider.DataSourcesMap[rename.name]
+		if !resource && !dataSource {
+			t.Errorf("%s is renamed to %s, which is neither a resource nor a data source", old, rename.name)
+		}
+		for argument, replacement := range rename.arguments {
+			if (resource && r.Schema[replacement] == nil) || (dataSource && d.Schema[replacement] == nil) {
+				t.Errorf("argument %s of %s is renamed to %s, which is not an argument of %s", argument, old, replacement, rename.name)
+			}
+		}
+	}
+	for argument, replacement := range migrationProviderArguments {
+		if s, ok := provider.Schema[argument]; !ok || s.Deprecated == "" {
+			t.Errorf("the provider argument %s is not deprecated", argument)
+		}
+		if _, ok := provider.Schema[replacement]; !ok {
+			t.Errorf("%s is renamed to %s, which is not a provider argument", argument, replacement)
+		}
+	}
+}
+
+const testMigrationState = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "ibm_kp_key",
+      "name": "key",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "crn:v1:bluemix:public:kms:us-south:a/fakeaccount:instance:key:key-0"}},
+        {"index_key": 1, "attributes": {"id": "crn:v1:bluemix:public:kms:us-south:a/fakeaccount:instance:key:key-1"}}
+      ]
+    },
+    {
+      "module": "module.keys",
+      "mode": "managed",
+      "type": "ibm_kp_key",
+      "name": "key",
+      "instances": [{"attributes": {"id": "crn:v1:bluemix:public:kms:us-south:a/fakeaccount:instance:key:key-2"}}]
+    }
+  ]
+}`
+
+func TestMigrateCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	main := filepath.Join(dir, "main.tf")
+	if err := ioutil.WriteFile(main, []byte(testMigrationConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, ".terraform"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, ".terraform", "module.tf"), []byte(`resource "ibm_kp_key" "key" {}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	state := filepath.Join(dir, "terraform.tfstate.json")
+	if err := ioutil.WriteFile(state, []byte(testMigrationState), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	err = MigrateCommand([]string{"-check", dir}, &out)
+	if err == nil || !strings.Contains(err.Error(), "deprecated constructs to migrate") {
+		t.Errorf("expected the check to fail, got %v", err)
+	}
+	if !strings.Contains(out.String(), "main.tf:9:10: resource type ibm_kp_key is renamed to ibm_kms_key") {
+		t.Errorf("expected the findings to be reported, got:\n%s", out.String())
+	}
+	if src, _ := ioutil.ReadFile(main); string(src) != testMigrationConfig {
+		t.Errorf("expected the check not to rewrite the files")
+	}
+
+	out.Reset()
+	if err := MigrateCommand([]string{"-state", state, dir}, &out); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if src, _ := ioutil.ReadFile(main); string(src) != testMigratedConfig {
+		t.Errorf("expected the file to be migrated, got:\n%s", src)
+	}
+	if src, _ := ioutil.ReadFile(filepath.Join(dir, ".terraform", "module.tf")); !strings.Contains(string(src), "ibm_kp_key") {
+		t.Errorf("expected the hidden directories to be skipped")
+	}
+	for _, line := range []string{
+		"terraform state rm ibm_kp_key.key\nterraform import 'ibm_kms_key.key[0]' crn:v1:bluemix:public:kms:us-south:a/fakeaccount:instance:key:key-0\nterraform import 'ibm_kms_key.key[1]' crn:v1:bluemix:public:kms:us-south:a/fakeaccount:instance:key:key-1\n",
+		"terraform state mv ibmcloud_infra_vlan.vlan ibm_network_vlan.vlan",
+		"provider argument riaas_endpoint is deprecated",
+	} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("expected %q in the output:\n%s", line, out.String())
+		}
+	}
+
+	out.Reset()
+	if err := MigrateCommand([]string{"-check", dir}, &out); err != nil {
+		t.Errorf("expected the migrated files to pass the check, got %v", err)
+	}
+}
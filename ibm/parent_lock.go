@@ -0,0 +1,132 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	parentLockConflictMinWait = 2 * time.Second
+	parentLockConflictMaxWait = 30 * time.Second
+)
+
+// parentLocks are the parent locks by child resource name
+var parentLocks = map[string]*parentLock{}
+
+// parentLock serializes the mutations of the child resources of a parent, e.g. the
+// connections of a VPN gateway, which the APIs reject with 409 "resource is in use"
+// or "locked" while another child of the same parent is being changed. Every child
+// resource declares its lock with the name of the parent, the children declaring the
+// same parent share the lock of each parent instance, e.g. the listeners and the
+// pools of a load balancer.
+type parentLock struct {
+	resource string
+	parent   string
+}
+
+// newParentLock returns the lock of the parent of the resource and registers it in
+// parentLocks. It panics when the resource already declares a lock.
+func newParentLock(resource, parent string) *parentLock {
+	if _, ok := parentLocks[resource]; ok {
+		panic(fmt.Sprintf("duplicate parent lock for %s", resource))
+	}
+	l := &parentLock{resource: resource, parent: parent}
+	parentLocks[resource] = l
+	return l
+}
+
+// key returns the key of ibmMutexKV locked for the parent, identified by one or more
+// IDs, e.g. the VPC and the zone of the subnets
+func (l *parentLock) key(parentIDs ...string) string {
+	return l.parent + "_key_" + strings.Join(parentIDs, "_")
+}
+
+// lock locks the parent and returns the function unlocking it. The wait for a lock
+// held by another resource is logged at INFO level to show the cost of serializing
+// the changes.
+func (l *parentLock) lock(parentIDs ...string) func() {
+	key := l.key(parentIDs...)
+	holder, waiting := parentLockContention.wait(key)
+	start := time.Now()
+	if holder != "" {
+		log.Printf("[INFO] %s waiting for the lock %s held by %s, %d waiting", l.resource, key, holder, waiting)
+	}
+	ibmMutexKV.Lock(key)
+	parentLockContention.acquire(key, l.resource)
+	acquired := time.Now()
+	if holder != "" {
+		log.Printf("[INFO] %s locked %s after waiting %s", l.resource, key, acquired.Sub(start).Round(time.Millisecond))
+	}
+	return func() {
+		parentLockContention.release(key)
+		ibmMutexKV.Unlock(key)
+		log.Printf("[DEBUG] %s unlocked %s after holding it %s", l.resource, key, time.Since(acquired).Round(time.Millisecond))
+	}
+}
+
+// retryConflicts calls f until it does not fail with a conflict, waiting between the
+// attempts from parentLockConflictMinWait up to parentLockConflictMaxWait, and returns
+// the conflict when the timeout would expire before the next attempt. The conflicts
+// are the changes of the parent made out of the lock, e.g. by another Terraform
+// configuration, or the parent still updating after the change of another child.
+func (l *parentLock) retryConflicts(timeout time.Duration, f func() error) error {
+	deadline := time.Now().Add(timeout)
+	wait := scaleAsyncDuration(parentLockConflictMinWait)
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || !isConflict(err) || time.Until(deadline) < wait {
+			return err
+		}
+		log.Printf("[INFO] %s conflicts with a change of its %s, retrying in %s (attempt %d): %s", l.resource, l.parent, wait, attempt, err)
+		time.Sleep(wait)
+		if wait *= 2; wait > scaleAsyncDuration(parentLockConflictMaxWait) {
+			wait = scaleAsyncDuration(parentLockConflictMaxWait)
+		}
+	}
+}
+
+// mutate calls f with the parent locked, retrying the conflicts
+func (l *parentLock) mutate(timeout time.Duration, f func() error, parentIDs ...string) error {
+	unlock := l.lock(parentIDs...)
+	defer unlock()
+	return l.retryConflicts(timeout, f)
+}
+
+var parentLockContention = &lockContention{
+	holders: map[string]string{},
+	waiting: map[string]int{},
+}
+
+// lockContention tracks the holder and the number of waiters of the parent locks
+type lockContention struct {
+	mu      sync.Mutex
+	holders map[string]string
+	waiting map[string]int
+}
+
+// wait registers a waiter of the key, it returns the current holder and the number
+// of waiters
+func (c *lockContention) wait(key string) (string, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.waiting[key]++
+	return c.holders[key], c.waiting[key]
+}
+
+func (c *lockContention) acquire(key, holder string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.waiting[key]--; c.waiting[key] <= 0 {
+		delete(c.waiting, key)
+	}
+	c.holders[key] = holder
+}
+
+func (c *lockContention) release(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.holders, key)
+}
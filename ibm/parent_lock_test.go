@@ -0,0 +1,140 @@
+package ibm
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func testParentLock(resource string) *parentLock {
+	return &parentLock{resource: resource, parent: "test_parent"}
+}
+
+func conflictError() error {
+	return responseError(&core.DetailedResponse{StatusCode: 409}, errors.New("The resource is locked"))
+}
+
+func waitingFor(key string) bool {
+	parentLockContention.mu.Lock()
+	defer parentLockContention.mu.Unlock()
+	return parentLockContention.waiting[key] > 0
+}
+
+func TestParentLocksResources(t *testing.T) {
+	resources := Provider().(*schema.Provider).ResourcesMap
+	for name := range parentLocks {
+		if _, ok := resources[name]; !ok {
+			t.Errorf("the parent lock %s is not the one of a resource", name)
+		}
+	}
+	if key := isSubnetLock.key("r006-vpc", "us-south-1"); key != "vpc_zone_key_r006-vpc_us-south-1" {
+		t.Errorf("unexpected key %q", key)
+	}
+	if isLBListenerLock.key("r006-lb") != isLBPoolMemberLock.key("r006-lb") {
+		t.Errorf("expected the children of a load balancer to share its lock")
+	}
+}
+
+func TestParentLockSerializes(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	first, second := testParentLock("ibm_test_first"), testParentLock("ibm_test_second")
+	unlock := first.lock("parent-1")
+	var wg sync.WaitGroup
+	var order []string
+	var mu sync.Mutex
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		second.lock("parent-1")()
+		mu.Lock()
+		order = append(order, "second")
+		mu.Unlock()
+	}()
+	for i := 0; i < 100 && !waitingFor(first.key("parent-1")); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	mu.Lock()
+	order = append(order, "first")
+	mu.Unlock()
+	unlock()
+	wg.Wait()
+
+	if strings.Join(order, ",") != "first,second" {
+		t.Errorf("expected the second lock to wait for the first one, got %v", order)
+	}
+	logs := buf.String()
+	if !strings.Contains(logs, "[INFO] ibm_test_second waiting for the lock test_parent_key_parent-1 held by ibm_test_first") {
+		t.Errorf("expected the contention to be logged, got:\n%s", logs)
+	}
+	if !strings.Contains(logs, "[INFO] ibm_test_second locked test_parent_key_parent-1 after waiting") {
+		t.Errorf("expected the wait to be logged, got:\n%s", logs)
+	}
+
+	// Another parent is not locked
+	buf.Reset()
+	first.lock("parent-1")()
+	unlock = first.lock("parent-1")
+	second.lock("parent-2")()
+	unlock()
+	if strings.Contains(buf.String(), "waiting for the lock") {
+		t.Errorf("expected no contention, got:\n%s", buf.String())
+	}
+}
+
+func TestParentLockRetryConflicts(t *testing.T) {
+	scale := asyncWaiterTimeScale
+	asyncWaiterTimeScale = 0.001
+	defer func() {
+		asyncWaiterTimeScale = scale
+	}()
+	l := testParentLock("ibm_test")
+
+	attempts := 0
+	err := l.mutate(time.Minute, func() error {
+		if attempts++; attempts < 3 {
+			return conflictError()
+		}
+		return nil
+	}, "parent-1")
+	if err != nil || attempts != 3 {
+		t.Errorf("expected the conflicts to be retried, got %v after %d attempts", err, attempts)
+	}
+
+	attempts = 0
+	err = l.retryConflicts(time.Minute, func() error {
+		attempts++
+		return errors.New("Bad Request")
+	})
+	if err == nil || attempts != 1 {
+		t.Errorf("expected the other errors not to be retried, got %v after %d attempts", err, attempts)
+	}
+
+	attempts = 0
+	err = l.retryConflicts(time.Millisecond, func() error {
+		attempts++
+		return conflictError()
+	})
+	if !isConflict(err) || attempts != 1 {
+		t.Errorf("expected the conflict once the timeout expires, got %v after %d attempts", err, attempts)
+	}
+}
+
+func TestNewParentLockDuplicate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a duplicate parent lock to panic")
+		}
+	}()
+	newParentLock("ibm_is_subnet", "vpc_zone")
+}
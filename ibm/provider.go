@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
@@ -14,7 +15,7 @@ var ibmMutexKV = NewMutexKV()
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -118,6 +119,50 @@ func Provider() terraform.ResourceProvider {
 				Description: "The retry count to set for API calls.",
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRIES", 10),
 			},
+			"retry_max_wait": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum wait in seconds between two retries of an API call.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_RETRY_MAX_WAIT", "IBMCLOUD_RETRY_MAX_WAIT"}, 30),
+			},
+			"rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Maximum number of requests per second sent to a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(endpointServiceNames()),
+							Description:  "Name of the service",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "Requests per second allowed for the service, 0 disables the limit",
+						},
+					},
+				},
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags attached to every resource supporting tags",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         resourceIBMVPCHash,
+							Description: "List of tags",
+						},
+					},
+				},
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -150,6 +195,45 @@ func Provider() terraform.ResourceProvider {
 				Description: "IAM Authentication refresh token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN"}, nil),
 			},
+			"iam_profile_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "ID of the IAM trusted profile to authenticate with",
+				ConflictsWith: []string{"iam_profile_name"},
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
+			},
+			"iam_profile_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Name of the IAM trusted profile to authenticate with",
+				ConflictsWith: []string{"iam_profile_id"},
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_NAME", "IBMCLOUD_IAM_PROFILE_NAME"}, nil),
+			},
+			"iam_cr_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the compute resource token file exchanged for the trusted profile token. The token is fetched from the instance metadata service when not provided",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_CR_TOKEN_FILE", "IBMCLOUD_IAM_CR_TOKEN_FILE"}, nil),
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private", "public-and-private"}),
+				Description:  "Visibility of the provider if it is private or public.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public"),
+			},
+			"endpoints_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a JSON or YAML file that overrides the public and private endpoints of the services per region.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
+			},
+			"audit_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a JSONL file logging every mutating API call of the provider, with the secrets redacted.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_AUDIT_LOG_PATH", "IBMCLOUD_AUDIT_LOG_PATH"}, nil),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -269,6 +353,7 @@ func Provider() terraform.ResourceProvider {
 			"ibm_resource_group":                     dataSourceIBMResourceGroup(),
 			"ibm_resource_instance":                  dataSourceIBMResourceInstance(),
 			"ibm_resource_key":                       dataSourceIBMResourceKey(),
+			"ibm_resources":                          dataSourceIBMResources(),
 			"ibm_security_group":                     dataSourceIBMSecurityGroup(),
 			"ibm_service_instance":                   dataSourceIBMServiceInstance(),
 			"ibm_service_key":                        dataSourceIBMServiceKey(),
@@ -464,6 +549,7 @@ func Provider() terraform.ResourceProvider {
 			"ibm_kp_key":                                         resourceIBMkey(),
 			"ibm_resource_group":                                 resourceIBMResourceGroup(),
 			"ibm_resource_instance":                              resourceIBMResourceInstance(),
+			"ibm_resource_tag":                                   resourceIBMResourceTag(),
 			"ibm_resource_key":                                   resourceIBMResourceKey(),
 			"ibm_security_group":                                 resourceIBMSecurityGroup(),
 			"ibm_security_group_rule":                            resourceIBMSecurityGroupRule(),
@@ -512,6 +598,9 @@ func Provider() terraform.ResourceProvider {
 
 		ConfigureFunc: providerConfigure,
 	}
+	auditResources(provider)
+	redactResources(provider)
+	return provider
 }
 
 var globalValidatorDict ValidatorDict
@@ -529,6 +618,7 @@ func Validator() ValidatorDict {
 				"ibm_cis_tls_settings":       resourceIBMCISTLSSettingsValidator(),
 				"ibm_cis_routing":            resourceIBMCISRoutingValidator(),
 				"ibm_cis_page_rule":          resourceCISPageRuleValidator(),
+				"ibm_cis_dns_record":         resourceIBMCISDnsRecordValidator(),
 				"ibm_cis_waf_package":        resourceIBMCISWAFPackageValidator(),
 				"ibm_cis_waf_group":          resourceIBMCISWAFGroupValidator(),
 				"ibm_cis_certificate_upload": resourceCISCertificateUploadValidator(),
@@ -580,6 +670,7 @@ func Validator() ValidatorDict {
 				"ibm_is_vpn_gateway":                   resourceIBMISVPNGatewayValidator(),
 				"ibm_dns_glb_monitor":                  resourceIBMPrivateDNSGLBMonitorValidator(),
 				"ibm_dns_glb_pool":                     resourceIBMPrivateDNSGLBPoolValidator(),
+				"ibm_resource_tag":                     resourceIBMResourceTagValidator(),
 			},
 			DataSourceValidatorDictionary: map[string]*ResourceValidator{
 				"ibm_is_subnet":          dataSourceIBMISSubnetValidator(),
@@ -647,9 +738,26 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
+	retryMaxWait := d.Get("retry_max_wait").(int)
+	rateLimits := map[string]float64{}
+	for _, l := range d.Get("rate_limits").([]interface{}) {
+		limit := l.(map[string]interface{})
+		rateLimits[limit["service"].(string)] = limit["requests_per_second"].(float64)
+	}
+	var defaultTags []string
+	if v, ok := d.GetOk("default_tags"); ok && v.([]interface{})[0] != nil {
+		tags := v.([]interface{})[0].(map[string]interface{})["tags"].(*schema.Set)
+		defaultTags = expandStringList(tags.List())
+	}
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 	generation := d.Get("generation").(int)
+	visibility := d.Get("visibility").(string)
+	endpointsFile := d.Get("endpoints_file_path").(string)
+	auditLogPath := d.Get("audit_log_path").(string)
+	iamProfileID := d.Get("iam_profile_id").(string)
+	iamProfileName := d.Get("iam_profile_name").(string)
+	iamCRTokenFile := d.Get("iam_cr_token_file").(string)
 
 	wskEnvVal, err := schema.EnvDefaultFunc("FUNCTION_NAMESPACE", "")()
 	if err != nil {
@@ -671,12 +779,21 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		RetryCount:           retryCount,
 		SoftLayerEndpointURL: softlayerEndpointUrl,
 		RetryDelay:           RetryAPIDelay,
+		RetryMaxWait:         time.Duration(retryMaxWait) * time.Second,
+		RateLimits:           rateLimits,
+		DefaultTags:          defaultTags,
 		FunctionNameSpace:    wskNameSpace,
 		RiaasEndPoint:        riaasEndPoint,
 		Generation:           generation,
 		IAMToken:             iamToken,
 		IAMRefreshToken:      iamRefreshToken,
+		IAMProfileID:         iamProfileID,
+		IAMProfileName:       iamProfileName,
+		IAMCRTokenFile:       iamCRTokenFile,
 		Zone:                 zone,
+		Visibility:           visibility,
+		EndpointsFile:        endpointsFile,
+		AuditLogPath:         auditLogPath,
 		//PowerServiceInstance: powerServiceInstance,
 	}
 
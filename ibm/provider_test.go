@@ -3,7 +3,9 @@ package ibm
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -478,6 +480,7 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
+	testAccCassette(t)
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
@@ -489,6 +492,25 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccCassette points the HTTP recorder to the cassette of the test when
+// IBM_TEST_RECORD is set. The replayed tests need no credentials, the cassettes are
+// scrubbed of them.
+func testAccCassette(t *testing.T) {
+	mode := os.Getenv(recorderModeEnv)
+	if mode == "" {
+		return
+	}
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	os.Setenv(recorderCassetteEnv, filepath.Join("testdata", "cassettes", name+".jsonl"))
+	if mode == replayMode {
+		for _, env := range []string{"IC_API_KEY", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME"} {
+			if os.Getenv(env) == "" {
+				os.Setenv(env, "replayed-"+strings.ToLower(env))
+			}
+		}
+	}
+}
+
 func testAccPreCheckCis(t *testing.T) {
 	testAccPreCheck(t)
 	if cisInstance == "" {
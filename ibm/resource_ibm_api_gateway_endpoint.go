@@ -183,7 +183,7 @@ func resourceIBMApiGatewayEndPointGet(d *schema.ResourceData, meta interface{})
 	}
 	result, response, err := endpointservice.GetEndpoint(&payload)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -381,7 +381,7 @@ func resourceIBMApiGatewayEndPointDelete(d *schema.ResourceData, meta interface{
 	response, err := endpointservice.DeleteEndpoint(&payload)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error deleting Endpoint: %s\n%s", err, response)
@@ -416,7 +416,7 @@ func resourceIBMApiGatewayEndPointExists(d *schema.ResourceData, meta interface{
 	}
 	_, response, err := endpointservice.GetEndpoint(&payload)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, err
@@ -143,7 +143,7 @@ func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta i
 	}
 	result, response, err := endpointservice.GetSubscription(&payload)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -244,7 +244,7 @@ func resourceIBMApiGatewayEndpointSubscriptionDelete(d *schema.ResourceData, met
 	}
 	response, err := endpointservice.DeleteSubscription(&payload)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error deleting Subscription: %s\n%s", err, response)
@@ -278,7 +278,7 @@ func resourceIBMApiGatewayEndpointSubscriptionExists(d *schema.ResourceData, met
 	}
 	_, response, err := endpointservice.GetSubscription(&payload)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, err
@@ -6,14 +6,13 @@ import (
 	"time"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
 
 func resourceIBMApp() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMAppCreate,
 		Read:     resourceIBMAppRead,
 		Update:   resourceIBMAppUpdate,
@@ -21,6 +20,11 @@ func resourceIBMApp() *schema.Resource {
 		Exists:   resourceIBMAppExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -94,7 +98,7 @@ func resourceIBMApp() *schema.Resource {
 				Description: "Define timeout to wait for the app instances to start/update/restage etc.",
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Default:     20,
+				Deprecated:  waitTimeMinutesDeprecation,
 			},
 			"tags": {
 				Type:     schema.TypeSet,
@@ -120,7 +124,7 @@ func resourceIBMApp() *schema.Resource {
 				Optional:    true,
 			},
 		},
-	}
+	}, upgradeWaitTimeMinutesState(20))
 }
 
 func resourceIBMAppCreate(d *schema.ResourceData, meta interface{}) error {
@@ -221,7 +225,7 @@ func resourceIBMAppCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error uploading app bits: %s", err)
 	}
 
-	err = restartApp(appGUID, d, meta)
+	err = restartApp(appGUID, d, meta, waitTimeout(d, schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -287,7 +291,7 @@ func resourceIBMAppUpdate(d *schema.ResourceData, meta interface{}) error {
 	restartRequired := false
 	restageRequired := false
 
-	waitTimeout := time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute
+	timeout := waitTimeout(d, schema.TimeoutUpdate)
 
 	if d.HasChange("name") {
 		appUpdatePayload.Name = helpers.String(d.Get("name").(string))
@@ -382,20 +386,20 @@ func resourceIBMAppUpdate(d *schema.ResourceData, meta interface{}) error {
 	//If restage and restart both are required then we only need restage as that starts over everything
 	if restageRequired {
 		log.Println("[INFO] Restage since buildpack has changed")
-		err := restageApp(appGUID, d, meta)
+		err := restageApp(appGUID, d, meta, timeout)
 		if err != nil {
 			return err
 		}
 	} else if restartRequired {
-		err := restartApp(appGUID, d, meta)
+		err := restartApp(appGUID, d, meta, timeout)
 		if err != nil {
 			return err
 		}
 	} else {
 		//In case only memory/disk etc are updated then cloud controller would destroy the current instances
 		//and spin new ones, so we are waiting till they come up again
-		state, err := appAPI.WaitForInstanceStatus(v2.AppRunningState, appGUID, waitTimeout)
-		if waitTimeout != 0 && (err != nil || state != v2.AppRunningState) {
+		state, err := appAPI.WaitForInstanceStatus(v2.AppRunningState, appGUID, timeout)
+		if timeout != 0 && (err != nil || state != v2.AppRunningState) {
 			return fmt.Errorf("All applications instances aren't %s, Current status is %s, %q", v2.AppRunningState, state, err)
 		}
 	}
@@ -430,10 +434,8 @@ func resourceIBMAppExists(d *schema.ResourceData, meta interface{}) (bool, error
 
 	app, err := appAPI.Get(id)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -526,7 +528,7 @@ func updateServiceInstanceGUID(appGUID string, d *schema.ResourceData, meta inte
 	}
 	return
 }
-func restartApp(appGUID string, d *schema.ResourceData, meta interface{}) error {
+func restartApp(appGUID string, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
 	cfClient, _ := meta.(ClientSession).MccpAPI()
 	appAPI := cfClient.Apps()
 
@@ -538,29 +540,27 @@ func restartApp(appGUID string, d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("Error updating application status to %s %s", v2.AppStoppedState, err)
 	}
-	waitTimeout := time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute
 	log.Println("[INFO] Starting Application")
-	status, err := appAPI.Start(appGUID, waitTimeout)
+	status, err := appAPI.Start(appGUID, timeout)
 	if err != nil {
 		return fmt.Errorf("Error while starting application : %s", err)
 	}
-	if waitTimeout != 0 {
+	if timeout != 0 {
 		return checkAppStatus(status)
 	}
 	return nil
 }
 
-func restageApp(appGUID string, d *schema.ResourceData, meta interface{}) error {
+func restageApp(appGUID string, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
 	cfClient, _ := meta.(ClientSession).MccpAPI()
 	appAPI := cfClient.Apps()
 
 	log.Println("[INFO] Restage Application")
-	waitTimeout := time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute
-	status, err := appAPI.Restage(appGUID, waitTimeout)
+	status, err := appAPI.Restage(appGUID, timeout)
 	if err != nil {
 		return fmt.Errorf("Error while restaging application : %s", err)
 	}
-	if waitTimeout != 0 {
+	if timeout != 0 {
 		return checkAppStatus(status)
 	}
 	return nil
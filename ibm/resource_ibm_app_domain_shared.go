@@ -5,7 +5,6 @@ import (
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -116,10 +115,8 @@ func resourceIBMAppDomainSharedExists(d *schema.ResourceData, meta interface{})
 
 	shdomain, err := cfClient.SharedDomains().Get(shdomainGUID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
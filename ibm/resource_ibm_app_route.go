@@ -6,7 +6,6 @@ import (
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -176,10 +175,8 @@ func resourceIBMAppRouteExists(d *schema.ResourceData, meta interface{}) (bool,
 
 	route, err := cfClient.Routes().Get(routeGUID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -595,10 +595,8 @@ func resourceIBMCDNExists(d *schema.ResourceData, meta interface{}) (bool, error
 	///Print the response for exist request.
 	log.Print("Exists response is : ", exists)
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 || len(exists) == 0 {
-				return false, nil
-			}
+		if isNotFound(err) || len(exists) == 0 {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving CDN mapping info: %s", err)
 	}
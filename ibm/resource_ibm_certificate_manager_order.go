@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/models"
 )
 
@@ -276,7 +275,7 @@ func waitForCertificateOrder(d *schema.ResourceData, meta interface{}) (interfac
 		Refresh: func() (interface{}, string, error) {
 			getcert, err := cmService.Certificate().GetMetaData(certID)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The certificate %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", err
@@ -306,7 +305,7 @@ func waitForCertificateRenew(d *schema.ResourceData, meta interface{}) (interfac
 		Refresh: func() (interface{}, string, error) {
 			getcert, err := cmService.Certificate().GetMetaData(certID)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The certificate %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", err
@@ -10,7 +10,6 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev1/controller"
 	"github.com/IBM-Cloud/bluemix-go/models"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -228,8 +227,7 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 	instanceID := d.Id()
 	instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
 	if err != nil {
-		if strings.Contains(err.Error(), "Object not found") ||
-			strings.Contains(err.Error(), "status code: 404") {
+		if isNotFound(err) {
 			log.Printf("[WARN] Removing record from state because it's not found via the API")
 			d.SetId("")
 			return nil
@@ -371,10 +369,8 @@ func resourceIBMCISInstanceExists(d *schema.ResourceData, meta interface{}) (boo
 	instanceID := d.Id()
 	instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -400,7 +396,7 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 		Refresh: func() (interface{}, string, error) {
 			instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", err
@@ -431,7 +427,7 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 		Refresh: func() (interface{}, string, error) {
 			instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", err
@@ -461,7 +457,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 		Refresh: func() (interface{}, string, error) {
 			instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return instance, cisInstanceSuccessStatus, nil
 				}
 				return nil, "", err
@@ -20,8 +20,10 @@ const (
 	cisCacheSettingsOnOffValidatorID  = "on_off_validator_id"
 )
 
+var cisCacheSettingsIDTemplate = newCisIDTemplate("ibm_cis_cache_settings", "{domain_id}", "9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISCacheSettings() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -117,8 +119,8 @@ func resourceIBMCISCacheSettings() *schema.Resource {
 		Read:     resourceCISCacheSettingsRead,
 		Update:   resourceCISCacheSettingsUpdate,
 		Delete:   resourceCISCacheSettingsDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		Importer: cisCacheSettingsIDTemplate.importer(),
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISCacheSettingsValidator() *ResourceValidator {
@@ -20,14 +20,19 @@ const (
 	cisCertificateOrderDeletePending = "deleting"
 )
 
+var cisCertificateOrderIDTemplate = newCisIDTemplate("ibm_cis_certificate_order", "{certificate_id}:{domain_id}", "48996f0da6ed76251b475971b097205c:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISCertificateOrder() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISCertificateOrderCreate,
 		Update:   resourceIBMCISCertificateOrderRead,
 		Read:     resourceIBMCISCertificateOrderRead,
 		Delete:   resourceIBMCISCertificateOrderDelete,
 		Exists:   resourceIBMCISCertificateOrderExist,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisCertificateOrderIDTemplate.importer(),
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -63,7 +68,7 @@ func resourceIBMCISCertificateOrder() *schema.Resource {
 				Computed:    true,
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISCertificateOrderValidator() *ResourceValidator {
@@ -31,14 +31,19 @@ const (
 	cisCertificateUploadDeleted         = "deleted"
 )
 
+var cisCertificateUploadIDTemplate = newCisIDTemplate("ibm_cis_certificate_upload", "{certificate_id}:{domain_id}", "48996f0da6ed76251b475971b097205c:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISCertificateUpload() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceCISCertificateUploadCreate,
 		Read:     resourceCISCertificateUploadRead,
 		Update:   resourceCISCertificateUploadUpdate,
 		Delete:   resourceCISCertificateUploadDelete,
 		Exists:   resourceCISCertificateUploadExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisCertificateUploadIDTemplate.importer(),
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -121,7 +126,7 @@ func resourceIBMCISCertificateUpload() *schema.Resource {
 				Computed:    true,
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 
 func resourceCISCertificateUploadValidator() *ResourceValidator {
@@ -21,8 +21,10 @@ const (
 	cisCustomPageModifiedOn      = "modified_on"
 )
 
+var cisCustomPageIDTemplate = newCisIDTemplate("ibm_cis_custom_page", "{page_id}:{domain_id}", "basic_challenge:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISCustomPage() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -86,8 +88,8 @@ func resourceIBMCISCustomPage() *schema.Resource {
 		Read:     resourceCISCustomPageRead,
 		Update:   resourceCISCustomPageUpdate,
 		Delete:   resourceCISCustomPageDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		Importer: cisCustomPageIDTemplate.importer(),
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISCustomPageValidator() *ResourceValidator {
@@ -153,7 +155,7 @@ func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
 
 	result, response, err := cisClient.GetZoneCustomPage(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("Custom Page has some error: %v", response)
 			d.SetId("")
 			return nil
@@ -7,10 +7,12 @@ import (
 	"strings"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 const (
+	ibmCISDNSRecord        = "ibm_cis_dns_record"
 	cisID                  = "cis_id"
 	cisDomainID            = "domain_id"
 	cisZoneName            = "zone_name"
@@ -42,14 +44,22 @@ const (
 	cisDNSRecordTypeTXT   = "TXT"
 )
 
+// cisDNSRecordDataTypes are the types of the records set with data instead of content
+var cisDNSRecordDataTypes = []string{cisDNSRecordTypeCAA, cisDNSRecordTypeLOC, cisDNSRecordTypeSRV}
+
+var cisDNSRecordIDTemplate = newCisIDTemplate(ibmCISDNSRecord, "{record_id}:{domain_id}", "48996f0da6ed76251b475971b097205c:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISDnsRecord() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISDnsRecordCreate,
 		Read:     resourceIBMCISDnsRecordRead,
 		Update:   resourceIBMCISDnsRecordUpdate,
 		Delete:   resourceIBMCISDnsRecordDelete,
 		Exists:   resourceIBMCISDnsRecordExist,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisDNSRecordIDTemplate.importer(),
+		// The content and the data of the existing records are not checked, the
+		// records created before the rules may have both in their state
+		CustomizeDiff: customdiff.If(cisDNSRecordTypeChanged, InvokeResourceValidator(ibmCISDNSRecord)),
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -93,6 +103,7 @@ func resourceIBMCISDnsRecord() *schema.Resource {
 				Type:          schema.TypeMap,
 				Optional:      true,
 				ConflictsWith: []string{cisDNSRecordContent},
+				ValidateFunc:  InvokeValidator(ibmCISDNSRecord, cisDNSRecordData),
 			},
 			cisDNSRecordPriority: {
 				Type:             schema.TypeInt,
@@ -130,7 +141,43 @@ func resourceIBMCISDnsRecord() *schema.Resource {
 				Computed: true,
 			},
 		},
+	}, upgradeCisDomainIDState)
+}
+
+func resourceIBMCISDnsRecordValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 cisDNSRecordData,
+			ValidateFunctionIdentifier: StringLenBetween,
+			Type:                       TypeString,
+			Optional:                   true,
+			MinValueLength:             1,
+			MaxValueLength:             255,
+			Elements:                   true})
+
+	contentTypes := strings.Join([]string{cisDNSRecordTypeA, cisDNSRecordTypeAAAA, cisDNSRecordTypeCNAME,
+		cisDNSRecordTypeMX, cisDNSRecordTypeNS, cisDNSRecordTypeSPF, cisDNSRecordTypeTXT}, ", ")
+	dataTypes := strings.Join(cisDNSRecordDataTypes, ", ")
+	rules := []ValidateRule{
+		{
+			Type:        RuleRequiredWith,
+			Identifiers: []string{cisDNSRecordContent},
+			When:        cisDNSRecordType,
+			WhenValues:  contentTypes},
+		{
+			Type:        RuleRequiredWith,
+			Identifiers: []string{cisDNSRecordData},
+			When:        cisDNSRecordType,
+			WhenValues:  dataTypes},
 	}
+	cisDNSRecordValidator := ResourceValidator{ResourceName: ibmCISDNSRecord, Schema: validateSchema, Rules: rules}
+	return &cisDNSRecordValidator
+}
+
+// cisDNSRecordTypeChanged reports whether the record is new or its type changes
+func cisDNSRecordTypeChanged(d *schema.ResourceDiff, meta interface{}) bool {
+	return d.Id() == "" || d.HasChange(cisDNSRecordType)
 }
 
 func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
@@ -435,7 +482,7 @@ func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 	opt := sess.NewGetDnsRecordOptions(recordID)
 	result, response, err := sess.GetDnsRecord(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -451,8 +498,11 @@ func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 	d.Set(cisDNSRecordModifiedOn, *result.Result.ModifiedOn)
 	d.Set(cisDNSRecordName, *result.Result.Name)
 	d.Set(cisDNSRecordType, *result.Result.Type)
-	if result.Result.Content != nil {
+	// The content of the records set with data is computed from the data by the API
+	if result.Result.Content != nil && !stringInSlice(*result.Result.Type, cisDNSRecordDataTypes) {
 		d.Set(cisDNSRecordContent, *result.Result.Content)
+	} else {
+		d.Set(cisDNSRecordContent, "")
 	}
 	d.Set(cisDNSRecordProxiable, *result.Result.Proxiable)
 	d.Set(cisDNSRecordProxied, *result.Result.Proxied)
@@ -800,7 +850,7 @@ func resourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) err
 	delOpt := sess.NewDeleteDnsRecordOptions(recordID)
 	result, response, err := sess.DeleteDnsRecord(delOpt)
 
-	if err != nil && !strings.Contains(err.Error(), "Request failed with status code: 404") {
+	if err != nil && !isNotFound(responseError(response, err)) {
 		log.Printf("Error deleting dns record %s: %s", *result.Result.ID, response)
 		return err
 	}
@@ -828,7 +878,7 @@ func resourceIBMCISDnsRecordExist(d *schema.ResourceData, meta interface{}) (boo
 	opt := sess.NewGetDnsRecordOptions(recordID)
 	_, response, err := sess.GetDnsRecord(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("DNS record is not found")
 			return false, nil
 		}
@@ -2,6 +2,7 @@ package ibm
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/IBM/go-sdk-core/v4/core"
@@ -389,3 +390,79 @@ func testAccCheckIBMCisDNSRecordConfigProxied(resourceID string, cisDomainStatic
 	  }
 `, resourceID)
 }
+
+func TestUnitIBMCisDNSRecord_srv(t *testing.T) {
+	cloud := newFakeCloud(t)
+	defer cloud.close()
+	zoneID := cloud.cis.addZone(cisIDExampleCRN, "example.com")
+	name := "ibm_cis_dns_record.srv"
+	config := fmt.Sprintf(`
+	resource "ibm_cis_dns_record" "srv" {
+		cis_id    = "%[1]s"
+		domain_id = "%[2]s:%[1]s"
+		name      = "_xmpp-client._tcp"
+		type      = "SRV"
+		data = {
+		  "name"     = "talk"
+		  "priority" = 5
+		  "weight"   = 0
+		  "port"     = 5222
+		  "target"   = "talk.l.google.com"
+		  "service"  = "_xmpp-client"
+		  "proto"    = "_tcp"
+		}
+	}
+	`, cisIDExampleCRN, zoneID)
+
+	// The API computes the content of the SRV record, the record is refreshed and
+	// planned again without a change
+	resource.UnitTest(t, resource.TestCase{
+		Providers: cloud.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "content", ""),
+					resource.TestCheckResourceAttr(name, "data.target", "talk.l.google.com"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestIBMCisDNSRecordDiffExisting(t *testing.T) {
+	r := resourceIBMCISDnsRecord()
+	config := map[string]interface{}{
+		"cis_id":    cisIDExampleCRN,
+		"domain_id": "fakezone:" + cisIDExampleCRN,
+		"name":      "_sip._tcp",
+		"type":      "SRV",
+		"data":      map[string]interface{}{"target": "sip.example.com"},
+	}
+	// A record refreshed before the content of the SRV records was ignored has both
+	state := &terraform.InstanceState{
+		ID: "fakecis:fakezone:" + cisIDExampleCRN,
+		Attributes: map[string]string{
+			"cis_id":      cisIDExampleCRN,
+			"domain_id":   "fakezone:" + cisIDExampleCRN,
+			"name":        "_sip._tcp.example.com",
+			"type":        "SRV",
+			"content":     "5\t0\t5060\tsip.example.com",
+			"data.%":      "1",
+			"data.target": "sip.example.com",
+		},
+	}
+	if _, err := r.Diff(state, terraform.NewResourceConfigRaw(config), nil); err != nil {
+		t.Errorf("unexpected error planning the existing record: %s", err)
+	}
+
+	config["content"] = "5 0 5060 sip.example.com"
+	delete(config, "data")
+	if _, err := r.Diff(nil, terraform.NewResourceConfigRaw(config), nil); err == nil || !strings.Contains(err.Error(), "`data` must be set when `type` is SRV") {
+		t.Errorf("expected the data of a new SRV record to be required, got %v", err)
+	}
+}
@@ -12,13 +12,16 @@ import (
 )
 
 const (
+	ibmCISDNSRecordsImport                = "ibm_cis_dns_records_import"
 	cisDNSRecordsImportFile               = "file"
 	cisDNSRecordsImportTotalRecordsParsed = "total_records_parsed"
 	cisDNSRecordsImportRecordsAdded       = "records_added"
 )
 
+var cisDNSRecordsImportIDTemplate = newCisIDTemplate(ibmCISDNSRecordsImport, "{domain_id}", "9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISDNSRecordsImport() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -49,12 +52,14 @@ func resourceIBMCISDNSRecordsImport() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISDNSRecordsImportUpdate,
-		Read:     resourceCISDNSRecordsImportRead,
-		Update:   resourceCISDNSRecordsImportRead,
-		Delete:   resourceCISDNSRecordsImportDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		Create: resourceCISDNSRecordsImportUpdate,
+		Read:   resourceCISDNSRecordsImportRead,
+		Update: resourceCISDNSRecordsImportRead,
+		Delete: resourceCISDNSRecordsImportDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCISDNSRecordsImportImport,
+		},
+	}, chainStateUpgrades(upgradeCisDomainIDState, upgradeCisDNSRecordsImportIDState))
 }
 func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{}) error {
 	cisClient, err := meta.(ClientSession).CisDNSRecordBulkClientSession()
@@ -79,27 +84,42 @@ func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{})
 		log.Printf("Error importing dns records: %v", response)
 		return err
 	}
-	id := fmt.Sprintf("%v:%v:%s:%s:%s", *result.Result.TotalRecordsParsed,
-		*result.Result.RecsAdded, file, zoneID, crn)
-	d.SetId(id)
+	d.SetId(convertCisToTfTwoVar(zoneID, crn))
+	d.Set(cisDNSRecordsImportTotalRecordsParsed, *result.Result.TotalRecordsParsed)
+	d.Set(cisDNSRecordsImportRecordsAdded, *result.Result.RecsAdded)
 
 	return nil
 
 }
 
 func resourceCISDNSRecordsImportRead(d *schema.ResourceData, meta interface{}) error {
-	idSplitStr := strings.SplitN(d.Id(), ":", 5)
-	parsed, _ := strconv.Atoi(idSplitStr[0])
-	added, _ := strconv.Atoi(idSplitStr[1])
-	file := idSplitStr[2]
-	zoneID := idSplitStr[3]
-	crn := idSplitStr[4]
+	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
+	return nil
+}
+
+// resourceCISDNSRecordsImportImport accepts the zone:crn ID and the former
+// parsed:added:file:zone:crn ID, which also restores the file and the counters
+func resourceCISDNSRecordsImportImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !isCisDNSRecordsImportLegacyID(d.Id()) {
+		if err := cisDNSRecordsImportIDTemplate.validate(d.Id()); err != nil {
+			return nil, err
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+	parsed, added, file, zoneID, crn, err := parseCisDNSRecordsImportLegacyID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(convertCisToTfTwoVar(zoneID, crn))
 	d.Set(cisDNSRecordsImportFile, file)
 	d.Set(cisDNSRecordsImportTotalRecordsParsed, parsed)
 	d.Set(cisDNSRecordsImportRecordsAdded, added)
-	return nil
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceCISDNSRecordsImportDelete(d *schema.ResourceData, meta interface{}) error {
@@ -107,3 +127,64 @@ func resourceCISDNSRecordsImportDelete(d *schema.ResourceData, meta interface{})
 	d.SetId("")
 	return nil
 }
+
+// isCisDNSRecordsImportLegacyID reports whether the ID is in the former
+// parsed:added:file:zone:crn format instead of zone:crn
+func isCisDNSRecordsImportLegacyID(id string) bool {
+	return strings.Contains(strings.SplitN(id, ":crn:", 2)[0], ":")
+}
+
+// parseCisDNSRecordsImportLegacyID parses the parsed:added:file:zone:crn ID, the file
+// is the remaining part between the counters and the zone and may contain colons
+func parseCisDNSRecordsImportLegacyID(id string) (parsed int, added int, file string, zoneID string, crn string, err error) {
+	invalid := fmt.Errorf("Invalid ID %q for %s, expected parsed:added:file:zone:crn", id, ibmCISDNSRecordsImport)
+	i := strings.Index(id, ":crn:")
+	if i < 0 {
+		err = invalid
+		return
+	}
+	crn = id[i+1:]
+	prefix := id[:i]
+	j := strings.LastIndex(prefix, ":")
+	if j < 0 {
+		err = invalid
+		return
+	}
+	zoneID = prefix[j+1:]
+	g := strings.SplitN(prefix[:j], ":", 3)
+	if len(g) < 3 {
+		err = invalid
+		return
+	}
+	file = g[2]
+	if parsed, err = strconv.Atoi(g[0]); err != nil {
+		err = invalid
+		return
+	}
+	if added, err = strconv.Atoi(g[1]); err != nil {
+		err = invalid
+	}
+	return
+}
+
+// upgradeCisDNSRecordsImportIDState replaces the parsed:added:file:zone:crn ID by
+// zone:crn, the file and the counters are kept in their attributes
+var upgradeCisDNSRecordsImportIDState = upgradeStateID(func(id string, rawState map[string]interface{}) (string, error) {
+	if !isCisDNSRecordsImportLegacyID(id) {
+		return id, nil
+	}
+	parsed, added, file, zoneID, crn, err := parseCisDNSRecordsImportLegacyID(id)
+	if err != nil {
+		return "", err
+	}
+	if v, ok := rawState[cisDNSRecordsImportFile].(string); !ok || v == "" {
+		rawState[cisDNSRecordsImportFile] = file
+	}
+	if rawState[cisDNSRecordsImportTotalRecordsParsed] == nil {
+		rawState[cisDNSRecordsImportTotalRecordsParsed] = parsed
+	}
+	if rawState[cisDNSRecordsImportRecordsAdded] == nil {
+		rawState[cisDNSRecordsImportRecordsAdded] = added
+	}
+	return convertCisToTfTwoVar(zoneID, crn), nil
+})
@@ -15,6 +15,8 @@ const (
 	cisDomainOriginalNameServers = "original_name_servers"
 )
 
+var cisDomainIDTemplate = newCisIDTemplate("ibm_cis_domain", "{domain_id}", "9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISDomain() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -56,7 +58,7 @@ func resourceIBMCISDomain() *schema.Resource {
 		Exists:   resourceCISdomainExists,
 		Update:   resourceCISdomainUpdate,
 		Delete:   resourceCISdomainDelete,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisDomainIDTemplate.importer(),
 	}
 }
 
@@ -124,7 +126,7 @@ func resourceCISdomainExists(d *schema.ResourceData, meta interface{}) (bool, er
 	opt := cisClient.NewGetZoneOptions(zoneID)
 	_, resp, err := cisClient.GetZone(opt)
 	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
+		if isNotFound(responseError(resp, err)) {
 			log.Printf("[WARN] zone is not found")
 			return false, nil
 		}
@@ -63,8 +63,10 @@ const (
 	cisDomainSettingsCipherValidatorID               = "cipher"
 )
 
+var cisDomainSettingsIDTemplate = newCisIDTemplate("ibm_cis_domain_settings", "{domain_id}", "9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISSettings() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -444,8 +446,8 @@ func resourceIBMCISSettings() *schema.Resource {
 		Read:     resourceCISSettingsRead,
 		Update:   resourceCISSettingsUpdate,
 		Delete:   resourceCISSettingsDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		Importer: cisDomainSettingsIDTemplate.importer(),
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISDomainSettingValidator() *ResourceValidator {
@@ -16,14 +16,16 @@ const (
 	cisEdgeFunctionsActionScript     = "script"
 )
 
+var cisEdgeFunctionsActionIDTemplate = newCisIDTemplate("ibm_cis_edge_functions_action", "{script_name}:{domain_id}", "sample_script:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISEdgeFunctionsAction() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISEdgeFunctionsActionCreate,
 		Read:     resourceIBMCISEdgeFunctionsActionRead,
 		Update:   resourceIBMCISEdgeFunctionsActionUpdate,
 		Delete:   resourceIBMCISEdgeFunctionsActionDelete,
 		Exists:   resourceIBMCISEdgeFunctionsActionExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisEdgeFunctionsActionIDTemplate.importer(),
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -48,7 +50,7 @@ func resourceIBMCISEdgeFunctionsAction() *schema.Resource {
 				Description: "Edge function action script",
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISEdgeFunctionsActionCreate(d *schema.ResourceData, meta interface{}) error {
@@ -135,7 +137,7 @@ func resourceIBMCISEdgeFunctionsActionExists(d *schema.ResourceData, meta interf
 	opt := cisClient.NewGetEdgeFunctionsActionOptions(scriptName)
 	_, response, err := cisClient.GetEdgeFunctionsAction(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("Edge functions action script is not found")
 			return false, nil
 		}
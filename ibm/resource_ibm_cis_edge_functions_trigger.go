@@ -15,14 +15,16 @@ const (
 	cisEdgeFunctionsTriggerRequestLimitFailOpen = "request_limit_fail_open"
 )
 
+var cisEdgeFunctionsTriggerIDTemplate = newCisIDTemplate("ibm_cis_edge_functions_trigger", "{trigger_id}:{domain_id}", "48996f0da6ed76251b475971b097205c:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISEdgeFunctionsTrigger() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISEdgeFunctionsTriggerCreate,
 		Read:     resourceIBMCISEdgeFunctionsTriggerRead,
 		Update:   resourceIBMCISEdgeFunctionsTriggerUpdate,
 		Delete:   resourceIBMCISEdgeFunctionsTriggerDelete,
 		Exists:   resourceIBMCISEdgeFunctionsTriggerExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisEdgeFunctionsTriggerIDTemplate.importer(),
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -56,7 +58,7 @@ func resourceIBMCISEdgeFunctionsTrigger() *schema.Resource {
 				Description: "Edge function trigger request limit fail open",
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISEdgeFunctionsTriggerCreate(d *schema.ResourceData, meta interface{}) error {
@@ -149,7 +151,7 @@ func resourceIBMCISEdgeFunctionsTriggerExists(d *schema.ResourceData, meta inter
 	opt := cisClient.NewGetEdgeFunctionsTriggerOptions(routeID)
 	_, response, err := cisClient.GetEdgeFunctionsTrigger(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("Edge functions trigger route is not found")
 			return false, nil
 		}
@@ -3,6 +3,7 @@ package ibm
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	cislockdownv1 "github.com/IBM/networking-go-sdk/zonelockdownv1"
@@ -52,14 +53,16 @@ const (
 	cisFirewallUARuleConfigurationValue            = "value"
 )
 
+var cisFirewallIDTemplate = newCisIDTemplate("ibm_cis_firewall", "{firewall_type}:{firewall_id}:{domain_id}", "lockdowns:48996f0da6ed76251b475971b097205c:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISFirewallRecord() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISFirewallRecordCreate,
 		Read:     resourceIBMCISFirewallRecordRead,
 		Update:   resourceIBMCISFirewallRecordUpdate,
 		Delete:   resourceIBMCISFirewallRecordDelete,
 		Exists:   resourceIBMCISFirewallRecordExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisFirewallIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -257,7 +260,7 @@ func resourceIBMCISFirewallRecord() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, chainStateUpgrades(upgradeCisDomainIDState, upgradeCisFirewallIDState))
 }
 
 func resourceIBMCISFirewallValidator() *ResourceValidator {
@@ -305,7 +308,7 @@ func resourceIBMCISFirewallValidator() *ResourceValidator {
 			Type:                       TypeString,
 			Required:                   true,
 			AllowedValues:              "block, challenge, js_challenge"})
-	cisFirewallValidator := ResourceValidator{ResourceName: ibmCISHealthCheck, Schema: validateSchema}
+	cisFirewallValidator := ResourceValidator{ResourceName: ibmCISFirewall, Schema: validateSchema}
 	return &cisFirewallValidator
 }
 
@@ -730,7 +733,7 @@ func resourceIBMCISFirewallRecordExists(d *schema.ResourceData, meta interface{}
 
 		_, response, err := cisClient.GetLockdown(opt)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				log.Printf("Zone Firewall Lockdown is not found")
 				return false, nil
 			}
@@ -752,7 +755,7 @@ func resourceIBMCISFirewallRecordExists(d *schema.ResourceData, meta interface{}
 
 		_, response, err := cisClient.GetZoneAccessRule(opt)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				log.Printf("Zone Firewall Access Rule is not found")
 				return false, nil
 			}
@@ -772,7 +775,7 @@ func resourceIBMCISFirewallRecordExists(d *schema.ResourceData, meta interface{}
 		opt := cisClient.NewGetUserAgentRuleOptions(lockdownID)
 		_, response, err := cisClient.GetUserAgentRule(opt)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				log.Printf("Zone Firewall User Agent Rule does not found")
 				return false, nil
 			}
@@ -812,3 +815,20 @@ func flattenLockdownsTypeConfiguration(lockdownConfigs []cislockdownv1.LockdownO
 	}
 	return configListOutput
 }
+
+// upgradeCisFirewallIDState prefixes the firewall type to the lockdown:zone:crn IDs
+// written before access rules and user agent rules were supported
+var upgradeCisFirewallIDState = upgradeStateID(func(id string, rawState map[string]interface{}) (string, error) {
+	switch strings.SplitN(id, ":", 2)[0] {
+	case cisFirewallTypeLockdowns, cisFirewallTypeAccessRules, cisFirewallTypeUARules:
+		return id, nil
+	}
+	if _, _, _, err := convertTfToCisThreeVar(id); err != nil {
+		return "", fmt.Errorf("Invalid ID %q for %s: %s", id, ibmCISFirewall, err)
+	}
+	firewallType, ok := rawState[cisFirewallType].(string)
+	if !ok || firewallType == "" {
+		firewallType = cisFirewallTypeLockdowns
+	}
+	return firewallType + ":" + id, nil
+})
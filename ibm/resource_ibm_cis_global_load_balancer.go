@@ -28,8 +28,10 @@ const (
 	cisGLBModifiedOn         = "modified_on"
 )
 
+var cisGLBIDTemplate = newCisIDTemplate("ibm_cis_global_load_balancer", "{glb_id}:{domain_id}", "57d96f0da6ed76251b475971b097205c:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISGlb() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -157,8 +159,8 @@ func resourceIBMCISGlb() *schema.Resource {
 		Read:     resourceCISGlbRead,
 		Update:   resourceCISGlbUpdate,
 		Delete:   resourceCISGlbDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		Importer: cisGLBIDTemplate.importer(),
+	}, upgradeCisDomainIDState)
 }
 
 func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
@@ -361,7 +363,7 @@ func resourceCISGlbExists(d *schema.ResourceData, meta interface{}) (bool, error
 
 	_, response, err := cisClient.GetLoadBalancerSettings(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("global load balancer does not exist.")
 			return false, nil
 		}
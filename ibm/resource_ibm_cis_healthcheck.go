@@ -29,6 +29,8 @@ const (
 	cisGLBHealthCheckHeadersValues   = "values"
 )
 
+var cisHealthCheckIDTemplate = newCisIDTemplate("ibm_cis_healthcheck", "{monitor_id}", "1fc7c3247067ee00856729661c7d58c9")
+
 func resourceIBMCISHealthCheck() *schema.Resource {
 	return &schema.Resource{
 
@@ -37,7 +39,7 @@ func resourceIBMCISHealthCheck() *schema.Resource {
 		Update:   resourceCISHealthCheckUpdate,
 		Delete:   resourceCISHealthCheckDelete,
 		Exists:   resourceCISHealthCheckExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisHealthCheckIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -433,7 +435,7 @@ func resourceCISHealthCheckExists(d *schema.ResourceData, meta interface{}) (boo
 
 	result, response, err := sess.GetLoadBalancerMonitor(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("global load balancer health check does not exist.")
 			return false, nil
 		}
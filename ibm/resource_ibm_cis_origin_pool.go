@@ -31,6 +31,8 @@ const (
 	cisGLBPoolOriginsFailureReason = "failure_reason"
 )
 
+var cisOriginPoolIDTemplate = newCisIDTemplate("ibm_cis_origin_pool", "{pool_id}", "000f57b5c42bcff3c02d155c2d58aa97")
+
 func resourceIBMCISPool() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -148,7 +150,7 @@ func resourceIBMCISPool() *schema.Resource {
 		Update:   resourceCISPoolUpdate,
 		Delete:   resourceCISPoolDelete,
 		Exists:   resourceCISPoolExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisOriginPoolIDTemplate.importer(),
 	}
 }
 
@@ -354,7 +356,7 @@ func resourceCISPoolExists(d *schema.ResourceData, meta interface{}) (bool, erro
 	opt := cisClient.NewGetLoadBalancerPoolOptions(poolID)
 	result, response, err := cisClient.GetLoadBalancerPool(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("global load balancer pool does not exist.")
 			return false, nil
 		}
@@ -3,6 +3,7 @@ package ibm
 import (
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	cispagerulev1 "github.com/IBM/networking-go-sdk/pageruleapiv1"
@@ -31,14 +32,17 @@ const (
 	cisPageRuleActionsIDAlwaysUseHTTPS   = "always_use_https"
 )
 
+var cisPageRuleIDTemplate = newCisIDTemplate("ibm_cis_page_rule", "{rule_id}:{domain_id}", "48996f0da6ed76251b475971b097205c:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISPageRule() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceCISPageRuleCreate,
-		Read:     resourceCISPageRuleRead,
-		Update:   resourceCISPageRuleUpdate,
-		Delete:   resourceCISPageRuleDelete,
-		Exists:   resourceCISPageRuleExists,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		Create:        resourceCISPageRuleCreate,
+		Read:          resourceCISPageRuleRead,
+		Update:        resourceCISPageRuleUpdate,
+		Delete:        resourceCISPageRuleDelete,
+		Exists:        resourceCISPageRuleExists,
+		Importer:      cisPageRuleIDTemplate.importer(),
+		CustomizeDiff: InvokeResourceValidator(ibmCISPageRule),
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -135,7 +139,7 @@ func resourceIBMCISPageRule() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 
 func resourceCISPageRuleValidator() *ResourceValidator {
@@ -165,7 +169,60 @@ func resourceCISPageRuleValidator() *ResourceValidator {
 			Type:                       TypeString,
 			Required:                   true,
 			AllowedValues:              status})
-	cisPageRuleValidator := ResourceValidator{ResourceName: ibmCISPageRule, Schema: validateSchema}
+
+	onOffActions := "always_online, browser_check, server_side_exclude, serve_stale_content, " +
+		"email_obfuscation, automatic_https_rewrites, opportunistic_encryption, ip_geolocation, " +
+		"explicit_cache_control, cache_deception_armor, waf, image_load_optimization, " +
+		"origin_error_page_pass_thru, response_buffering, script_load_optimization, " +
+		"true_client_ip_header, sort_query_string_for_cache"
+	noValueActions := "forwarding_url, disable_security, always_use_https, disable_apps, disable_performance"
+	actionValues := func(actions, values string) ValidateRule {
+		return ValidateRule{
+			Type:          RuleAllowedValuesWhen,
+			Block:         cisPageRuleActions,
+			Identifiers:   []string{cisPageRuleActionsValue},
+			When:          cisPageRuleActionsID,
+			WhenValues:    actions,
+			AllowedValues: values}
+	}
+	rules := []ValidateRule{
+		actionValues(onOffActions, "on, off"),
+		actionValues("ssl", "off, flexible, full, strict, origin_pull"),
+		actionValues("cache_level", "bypass, aggressive, basic, simplified, cache_everything"),
+		actionValues("image_size_optimization", "off, lossless, lossy"),
+		actionValues(cisPageRuleActionsIDBrowserCacheTTL, "0, 1800, 3600, 7200, 10800, 14400, 18000, 28800, "+
+			"43200, 57600, 72000, 86400, 172800, 259200, 345600, 432000, 691200, 1382400, 2073600, "+
+			"2678400, 5356800, 16070400, 31536000"),
+		actionValues(cisPageRuleActionsIDEdgeCacheTTL, "0, 30, 60, 300, 600, 1200, 1800, 3600, 7200, 10800, "+
+			"14400, 18000, 28800, 43200, 57600, 72000, 86400, 172800, 259200, 345600, 432000, 518400, "+
+			"604800, 1209600, 2419200"),
+		{
+			Type:        RuleRequiredWith,
+			Block:       cisPageRuleActions,
+			Identifiers: []string{cisPageRuleActionsValueURL, cisPageRuleActionsValueStatusCode},
+			When:        cisPageRuleActionsID,
+			WhenValues:  cisPageRuleActionsIDForwardingURL},
+		{
+			Type:          RuleAllowedValuesWhen,
+			Block:         cisPageRuleActions,
+			Identifiers:   []string{cisPageRuleActionsValueStatusCode},
+			When:          cisPageRuleActionsID,
+			WhenValues:    cisPageRuleActionsIDForwardingURL,
+			AllowedValues: "301, 302"},
+	}
+	valueActions := make([]string, 0)
+	for _, action := range splitValues(actions) {
+		if !stringInSlice(action, splitValues(noValueActions)) {
+			valueActions = append(valueActions, action)
+		}
+	}
+	rules = append(rules, ValidateRule{
+		Type:        RuleRequiredWith,
+		Block:       cisPageRuleActions,
+		Identifiers: []string{cisPageRuleActionsValue},
+		When:        cisPageRuleActionsID,
+		WhenValues:  strings.Join(valueActions, ", ")})
+	cisPageRuleValidator := ResourceValidator{ResourceName: ibmCISPageRule, Schema: validateSchema, Rules: rules}
 	return &cisPageRuleValidator
 }
 
@@ -297,7 +354,7 @@ func resourceCISPageRuleExists(d *schema.ResourceData, meta interface{}) (bool,
 	opt := cisClient.NewGetPageRuleOptions(ruleID)
 	_, response, err := cisClient.GetPageRule(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("Page rule does not exist.")
 			return false, nil
 		}
@@ -2,6 +2,7 @@ package ibm
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/IBM/go-sdk-core/v4/core"
@@ -180,3 +181,115 @@ func testAccCheckIBMCisPageRuleConfigUpdate() string {
 	}
 	`, url)
 }
+
+func TestUnitIBMCisPageRule_basic(t *testing.T) {
+	cloud := newFakeCloud(t)
+	defer cloud.close()
+	name := "ibm_cis_page_rule.page_rule"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    cloud.providers(),
+		CheckDestroy: testUnitCheckIBMCisPageRuleDestroy(cloud),
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitIBMCisPageRuleConfig(`
+		actions {
+			id          = "forwarding_url"
+			url         = "https://example.com/*"
+			status_code = 302
+		}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "actions.#", "1"),
+					resource.TestCheckResourceAttr(name, "targets.#", "1"),
+					resource.TestCheckResourceAttr(name, "status", "active"),
+					testUnitCheckIBMCisPageRuleActions(cloud, name, "forwarding_url"),
+				),
+			},
+			{
+				Config: testUnitIBMCisPageRuleConfig(`
+		actions {
+			id = "disable_security"
+		}
+		actions {
+			id    = "browser_check"
+			value = "on"
+		}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "actions.#", "2"),
+					testUnitCheckIBMCisPageRuleActions(cloud, name, "disable_security", "browser_check"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+	if n := len(cloud.requestsTo(http.MethodPut, "/cis/v1/{crn}/zones/{zone_id}/pagerules/{id}")); n != 1 {
+		t.Errorf("expected the page rule to be updated once, got %d updates", n)
+	}
+}
+
+func testUnitIBMCisPageRuleConfig(actions string) string {
+	return fmt.Sprintf(`
+	resource "ibm_cis_page_rule" "page_rule" {
+		cis_id    = "%[1]s"
+		domain_id = "fakezone:%[1]s"
+		targets {
+			target = "url"
+			constraint {
+				operator = "matches"
+				value    = "example.com/"
+			}
+		}
+		status   = "active"
+		priority = 1
+		%[2]s
+	}
+	`, cisIDExampleCRN, actions)
+}
+
+func testUnitCheckIBMCisPageRuleDestroy(cloud *fakeCloud) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "ibm_cis_page_rule" {
+				continue
+			}
+			ruleID, zoneID, crn, _ := convertTfToCisThreeVar(rs.Primary.ID)
+			if cloud.cis.object(crn, zoneID, "pagerules", ruleID) != nil {
+				return fmt.Errorf("Page rule still exists: %s", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// testUnitCheckIBMCisPageRuleActions checks the ids of the actions sent to CIS
+func testUnitCheckIBMCisPageRuleActions(cloud *fakeCloud, n string, ids ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		ruleID, zoneID, crn, _ := convertTfToCisThreeVar(rs.Primary.ID)
+		rule := cloud.cis.object(crn, zoneID, "pagerules", ruleID)
+		if rule == nil {
+			return fmt.Errorf("Page rule not found: %s", rs.Primary.ID)
+		}
+		actions := rule["actions"].([]interface{})
+		sent := map[string]bool{}
+		for _, action := range actions {
+			sent[action.(map[string]interface{})["id"].(string)] = true
+		}
+		for _, id := range ids {
+			if !sent[id] {
+				return fmt.Errorf("Expected the action %s to be sent, got %v", id, actions)
+			}
+		}
+		if len(actions) != len(ids) {
+			return fmt.Errorf("Expected %d actions to be sent, got %v", len(ids), actions)
+		}
+		return nil
+	}
+}
@@ -43,14 +43,16 @@ const (
 	cisRangeAppModifiedOn              = "modified_on"
 )
 
+var cisRangeAppIDTemplate = newCisIDTemplate("ibm_cis_range_app", "{app_id}:{domain_id}", "48996f0da6ed76251b475971b097205c:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISRangeApp() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISRangeAppCreate,
 		Read:     resourceIBMCISRangeAppRead,
 		Update:   resourceIBMCISRangeAppUpdate,
 		Delete:   resourceIBMCISRangeAppDelete,
 		Exists:   resourceIBMCISRangeAppExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisRangeAppIDTemplate.importer(),
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -113,7 +115,7 @@ func resourceIBMCISRangeApp() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Description:  "Allows for the true client IP to be passed to the service.",
-				ValidateFunc: InvokeValidator(ibmCISRangeApp, cisRangeAppProtocol),
+				ValidateFunc: InvokeValidator(ibmCISRangeApp, cisRangeAppProxyProtocol),
 			},
 			cisRangeAppEdgeIPsType: {
 				Type:         schema.TypeString,
@@ -154,7 +156,7 @@ func resourceIBMCISRangeApp() *schema.Resource {
 				Description: "modified on date",
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 func resourceIBMCISRangeAppValidator() *ResourceValidator {
 
@@ -400,7 +402,7 @@ func resourceIBMCISRangeAppExists(d *schema.ResourceData, meta interface{}) (boo
 	opt := cisClient.NewGetRangeAppOptions(rangeAppID)
 	_, resp, err := cisClient.GetRangeApp(opt)
 	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
+		if isNotFound(responseError(resp, err)) {
 			log.Println("range application is not found")
 			return false, nil
 		}
@@ -18,14 +18,16 @@ const (
 	cisRLURL         = "url"
 )
 
+var cisRateLimitIDTemplate = newCisIDTemplate("ibm_cis_rate_limit", "{rule_id}:{domain_id}", "48996f0da6ed76251b475971b097205c:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISRateLimit() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISRateLimitCreate,
 		Read:     resourceIBMCISRateLimitRead,
 		Update:   resourceIBMCISRateLimitUpdate,
 		Delete:   resourceIBMCISRateLimitDelete,
 		Exists:   resourceIBMCISRateLimitExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisRateLimitIDTemplate.importer(),
 		Schema: map[string]*schema.Schema{
 			"cis_id": {
 				Type:        schema.TypeString,
@@ -245,7 +247,7 @@ func resourceIBMCISRateLimit() *schema.Resource {
 				Description: "Rate Limit rule Id",
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 func resourceIBMCISRateLimitValidator() *ResourceValidator {
 
@@ -527,7 +529,7 @@ func resourceIBMCISRateLimitExists(d *schema.ResourceData, meta interface{}) (bo
 	opt := cisClient.NewGetRateLimitOptions(recordID)
 	_, resp, err := cisClient.GetRateLimit(opt)
 	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
+		if isNotFound(responseError(resp, err)) {
 			log.Println("ratelimit is not found")
 			return false, nil
 		}
@@ -12,13 +12,15 @@ const (
 	cisRoutingSmartRouting = "smart_routing"
 )
 
+var cisRoutingIDTemplate = newCisIDTemplate("ibm_cis_routing", "{domain_id}", "9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISRouting() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISRoutingUpdate,
 		Read:     resourceIBMCISRoutingRead,
 		Update:   resourceIBMCISRoutingUpdate,
 		Delete:   resourceIBMCISRoutingDelete,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisRoutingIDTemplate.importer(),
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -39,7 +41,7 @@ func resourceIBMCISRouting() *schema.Resource {
 				ValidateFunc: InvokeValidator(ibmCISRouting, cisRoutingSmartRouting),
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISRoutingValidator() *ResourceValidator {
@@ -15,8 +15,10 @@ const (
 	cisTLSSettingsMinTLSVersion = "min_tls_version"
 )
 
+var cisTLSSettingsIDTemplate = newCisIDTemplate("ibm_cis_tls_settings", "{domain_id}", "9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISTLSSettings() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -55,8 +57,8 @@ func resourceIBMCISTLSSettings() *schema.Resource {
 		Read:     resourceCISTLSSettingsRead,
 		Update:   resourceCISTLSSettingsUpdate,
 		Delete:   resourceCISTLSSettingsDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		Importer: cisTLSSettingsIDTemplate.importer(),
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISTLSSettingsValidator() *ResourceValidator {
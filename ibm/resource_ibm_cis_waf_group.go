@@ -18,13 +18,15 @@ const (
 	cisWAFGroupDesc               = "description"
 )
 
+var cisWAFGroupIDTemplate = newCisIDTemplate("ibm_cis_waf_group", "{group_id}:{package_id}:{domain_id}", "3d8fb0c18b5a6ba7682c80e94c7937b2:57d96f0da6ed76251b475971b097205c:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISWAFGroup() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISWAFGroupUpdate,
 		Read:     resourceIBMCISWAFGroupRead,
 		Update:   resourceIBMCISWAFGroupUpdate,
 		Delete:   resourceIBMCISWAFGroupDelete,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisWAFGroupIDTemplate.importer(),
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -77,7 +79,7 @@ func resourceIBMCISWAFGroup() *schema.Resource {
 				Description: "WAF Rule group modified rules count",
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISWAFGroupValidator() *ResourceValidator {
@@ -134,7 +136,7 @@ func resourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error
 	opt := cisClient.NewGetWafRuleGroupOptions(packageID, groupID)
 	result, response, err := cisClient.GetWafRuleGroup(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("WAF group is not found!")
 			d.SetId("")
 			return nil
@@ -17,13 +17,15 @@ const (
 	cisWAFPackageActionMode    = "action_mode"
 )
 
+var cisWAFPackageIDTemplate = newCisIDTemplate("ibm_cis_waf_package", "{package_id}:{domain_id}", "489d96f0da6ed76251b475971b097205:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISWAFPackage() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISWAFPackageUpdate,
 		Read:     resourceIBMCISWAFPackageRead,
 		Update:   resourceIBMCISWAFPackageUpdate,
 		Delete:   resourceIBMCISWAFPackageDelete,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisWAFPackageIDTemplate.importer(),
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -73,7 +75,7 @@ func resourceIBMCISWAFPackage() *schema.Resource {
 				Description: "WAF package description",
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISWAFPackageValidator() *ResourceValidator {
@@ -143,7 +145,7 @@ func resourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) erro
 	opt := cisClient.NewGetWafPackageOptions(packageID)
 	result, response, err := cisClient.GetWafPackage(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("WAF package is not found!")
 			d.SetId("")
 			return nil
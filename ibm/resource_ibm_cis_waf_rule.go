@@ -22,13 +22,15 @@ const (
 	cisWAFRuleAllowedModes = "allowed_modes"
 )
 
+var cisWAFRuleIDTemplate = newCisIDTemplate("ibm_cis_waf_rule", "{rule_id}:{package_id}:{domain_id}", "100000356:c504870194831cd12c3fc0284f294abb:9caf68812ae9b3f0377fdf986751a78f")
+
 func resourceIBMCISWAFRule() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMCISWAFRuleUpdate,
 		Read:     resourceIBMCISWAFRuleRead,
 		Update:   resourceIBMCISWAFRuleUpdate,
 		Delete:   resourceIBMCISWAFRuleDelete,
-		Importer: &schema.ResourceImporter{},
+		Importer: cisWAFRuleIDTemplate.importer(),
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -97,7 +99,7 @@ func resourceIBMCISWAFRule() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, upgradeCisDomainIDState)
 }
 
 func resourceIBMCISWAFRuleValidator() *ResourceValidator {
@@ -175,7 +177,7 @@ func resourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
 	opt := cisClient.NewGetWafRuleOptions(packageID, ruleID)
 	result, response, err := cisClient.GetWafRule(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("WAF Rule is not found!")
 			d.SetId("")
 			return nil
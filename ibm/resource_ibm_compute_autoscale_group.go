@@ -42,6 +42,11 @@ func resourceIBMComputeAutoScaleGroup() *schema.Resource {
 		Exists:   resourceIBMComputeAutoScaleGroupExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"name": {
@@ -133,8 +138,11 @@ func resourceIBMComputeAutoScaleGroup() *schema.Resource {
 func getModifiedVirtualGuestResource() *schema.Resource {
 
 	r := resourceIBMComputeVmInstance()
-	// wait_time_minutes is only used in virtual_guest resource.
+	// wait_time_minutes and the timeouts are only used in virtual_guest resource.
 	delete(r.Schema, "wait_time_minutes")
+	r.Timeouts = nil
+	r.SchemaVersion = 0
+	r.StateUpgraders = nil
 
 	for _, elem := range r.Schema {
 		elem.ForceNew = false
@@ -293,7 +301,7 @@ func resourceIBMComputeAutoScaleGroupCreate(d *schema.ResourceData, meta interfa
 	time.Sleep(60)
 
 	// wait for scale group to become active
-	_, err = waitForActiveStatus(d, meta)
+	_, err = waitForActiveStatus(d, meta, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("Error waiting for scale group (%s) to become active: %s", d.Id(), err)
@@ -344,7 +352,7 @@ func resourceIBMComputeAutoScaleGroupRead(d *schema.ResourceData, meta interface
 	slGroupObj, err := service.Id(groupId).Mask(strings.Join(IBMComputeAutoScaleGroupObjectMask, ",")).GetObject()
 	if err != nil {
 		// If the scale group is somehow already destroyed, mark as successfully gone
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -535,7 +543,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 	}
 
 	// wait for scale group to become active
-	_, err = waitForActiveStatus(d, meta)
+	_, err = waitForActiveStatus(d, meta, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		return fmt.Errorf("Error waiting for scale group (%s) to become active: %s", d.Id(), err)
@@ -573,7 +581,7 @@ func resourceIBMComputeAutoScaleGroupDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func waitForActiveStatus(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForActiveStatus(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	sess := meta.(ClientSession).SoftLayerSession()
 	scaleGroupService := services.GetScaleGroupService(sess)
 
@@ -592,7 +600,7 @@ func waitForActiveStatus(d *schema.ResourceData, meta interface{}) (interface{},
 				"virtualGuestMembers[virtualGuest[primaryBackendIpAddress,primaryIpAddress,privateNetworkOnlyFlag,fullyQualifiedDomainName]]").
 				GetObject()
 			if err != nil {
-				if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The scale group %d does not exist anymore: %s", id, err)
 				}
 
@@ -627,7 +635,7 @@ func waitForActiveStatus(d *schema.ResourceData, meta interface{}) (interface{},
 
 			return result, status, nil
 		},
-		Timeout:    120 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
@@ -647,10 +655,8 @@ func resourceIBMComputeAutoScaleGroupExists(d *schema.ResourceData, meta interfa
 
 	result, err := scaleGroupService.Id(groupId).Mask("id").GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -43,6 +44,10 @@ func resourceIBMComputeAutoScalePolicy() *schema.Resource {
 		Exists:   resourceIBMComputeAutoScalePolicyExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"name": {
@@ -307,7 +312,29 @@ func resourceIBMComputeAutoScalePolicyUpdate(d *schema.ResourceData, meta interf
 		scalePolicyTriggerService.Id(*triggerList.Id).DeleteObject()
 	}
 
-	time.Sleep(60)
+	// Wait for the triggers to be deleted before adding the new ones
+	if len(scalePolicy.Triggers) > 0 {
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"deleting"},
+			Target:  []string{"deleted"},
+			Refresh: func() (interface{}, string, error) {
+				triggers, err := scalePolicyService.Id(scalePolicyId).GetTriggers()
+				if err != nil {
+					return nil, "", err
+				}
+				if len(triggers) > 0 {
+					return triggers, "deleting", nil
+				}
+				return triggers, "deleted", nil
+			},
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			MinTimeout: 3 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for the triggers of the scale policy %d to be deleted: %s", scalePolicyId, err)
+		}
+	}
+
 	log.Printf("[INFO] Updating scale policy: %d", scalePolicyId)
 	_, err = scalePolicyServiceNoRetry.Id(scalePolicyId).EditObject(&template)
 
@@ -348,10 +375,8 @@ func resourceIBMComputeAutoScalePolicyExists(d *schema.ResourceData, meta interf
 	}
 	result, err := service.Id(policyId).Mask("id").GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
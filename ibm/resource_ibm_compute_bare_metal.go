@@ -27,6 +27,11 @@ func resourceIBMComputeBareMetal() *schema.Resource {
 		Exists:   resourceIBMComputeBareMetalExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Hour),
+			Delete: schema.DefaultTimeout(24 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"hostname": {
@@ -821,10 +826,10 @@ func resourceIBMComputeBareMetalUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIBMComputeBareMetalDelete(d *schema.ResourceData, meta interface{}) error {
-	return deleteHardware(d, meta)
+	return deleteHardware(d, meta, d.Timeout(schema.TimeoutDelete))
 }
 
-func deleteHardware(d dataRetriever, meta interface{}) error {
+func deleteHardware(d dataRetriever, meta interface{}, timeout time.Duration) error {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetHardwareService(sess)
 	id, err := strconv.Atoi(d.Id())
@@ -832,7 +837,7 @@ func deleteHardware(d dataRetriever, meta interface{}) error {
 		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
 	}
 
-	_, err = waitForNoBareMetalActiveTransactions(id, meta)
+	_, err = waitForNoBareMetalActiveTransactions(id, meta, timeout)
 	if err != nil {
 		return fmt.Errorf("Error deleting bare metal server while waiting for zero active transactions: %s", err)
 	}
@@ -864,7 +869,7 @@ func resourceIBMComputeBareMetalExists(d *schema.ResourceData, meta interface{})
 
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); !ok || apiErr.StatusCode != 404 {
+		if !isNotFound(err) {
 			return false, fmt.Errorf("Error trying to retrieve the Bare Metal server: %s", err)
 		}
 	}
@@ -915,7 +920,7 @@ func waitForBareMetalProvision(hw *datatypes.Hardware, d *schema.ResourceData, m
 			return bms[0], "provisioned", nil
 
 		},
-		Timeout:        24 * time.Hour,
+		Timeout:        d.Timeout(schema.TimeoutCreate),
 		Delay:          10 * time.Second,
 		MinTimeout:     1 * time.Minute,
 		NotFoundChecks: 24 * 60,
@@ -924,7 +929,7 @@ func waitForBareMetalProvision(hw *datatypes.Hardware, d *schema.ResourceData, m
 	return stateConf.WaitForState()
 }
 
-func waitForNoBareMetalActiveTransactions(id int, meta interface{}) (interface{}, error) {
+func waitForNoBareMetalActiveTransactions(id int, meta interface{}, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for server (%d) to have zero active transactions", id)
 	service := services.GetHardwareServerService(meta.(ClientSession).SoftLayerSession())
 
@@ -943,7 +948,7 @@ func waitForNoBareMetalActiveTransactions(id int, meta interface{}) (interface{}
 			return bm, "active", nil
 
 		},
-		Timeout:        24 * time.Hour,
+		Timeout:        timeout,
 		Delay:          10 * time.Second,
 		MinTimeout:     1 * time.Minute,
 		NotFoundChecks: 24 * 60,
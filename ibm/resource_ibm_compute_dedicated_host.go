@@ -20,7 +20,7 @@ import (
 var dedicatedHostPackageType = "DEDICATED_HOST"
 
 func resourceIBMComputeDedicatedHost() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMComputeDedicatedHostCreate,
 		Read:     resourceIBMComputeDedicatedHostRead,
 		Delete:   resourceIBMComputeDedicatedHostDelete,
@@ -28,6 +28,10 @@ func resourceIBMComputeDedicatedHost() *schema.Resource {
 		Update:   resourceIBMComputeDedicatedHostUpdate,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"hostname": {
 				Type:        schema.TypeString,
@@ -82,9 +86,9 @@ func resourceIBMComputeDedicatedHost() *schema.Resource {
 				Description: "The capacity that the dedicated host's memory allocation is restricted to.",
 			},
 			"wait_time_minutes": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  90,
+				Type:       schema.TypeInt,
+				Optional:   true,
+				Deprecated: waitTimeMinutesDeprecation,
 			},
 			"tags": {
 				Type:     schema.TypeSet,
@@ -93,7 +97,7 @@ func resourceIBMComputeDedicatedHost() *schema.Resource {
 				Set:      schema.HashString,
 			},
 		},
-	}
+	}, upgradeWaitTimeMinutesState(90))
 }
 
 func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error {
@@ -281,10 +285,8 @@ func resourceIBMComputeDedicatedHostExists(d *schema.ResourceData, meta interfac
 
 	result, err := service.Id(dedicatedID).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -317,7 +319,7 @@ func findDedicatedHostByOrderID(d *datatypes.Hardware, r *schema.ResourceData, m
 			return dedicatedHosts[0], "provisioned", nil
 
 		},
-		Timeout:    time.Duration(r.Get("wait_time_minutes").(int)) * time.Minute,
+		Timeout:    waitTimeout(r, schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 1 * time.Minute,
 	}
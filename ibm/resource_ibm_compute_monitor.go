@@ -174,7 +174,7 @@ func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		// If the monitor is somehow already destroyed, mark as
 		// succesfully gone
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -289,10 +289,8 @@ func resourceIBMComputeMonitorExists(d *schema.ResourceData, meta interface{}) (
 
 	result, err := service.Id(basicMonitorId).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving basic monitor info: %s", err)
 	}
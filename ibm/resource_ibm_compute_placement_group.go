@@ -184,10 +184,8 @@ func resourceIBMComputePlacementGroupExists(d *schema.ResourceData, meta interfa
 
 	result, err := service.Id(pgrpID).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -138,10 +138,8 @@ func resourceIBMComputeProvisioningHookExists(d *schema.ResourceData, meta inter
 
 	result, err := service.Id(hookId).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
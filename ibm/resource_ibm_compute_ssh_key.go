@@ -141,7 +141,7 @@ func resourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		// If the key is somehow already destroyed, mark as
 		// succesfully gone
-		if err, ok := err.(sl.Error); ok && err.StatusCode == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -211,10 +211,8 @@ func resourceIBMComputeSSHKeyExists(d *schema.ResourceData, meta interface{}) (b
 
 	result, err := service.Id(keyID).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -194,10 +194,8 @@ func resourceIBMComputeSSLCertificateExists(d *schema.ResourceData, meta interfa
 
 	cert, err := service.Id(id).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
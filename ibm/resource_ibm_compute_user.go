@@ -273,7 +273,7 @@ func resourceIBMComputeUserRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		// If the key is somehow already destroyed, mark as
 		// successfully gone
-		if strings.Contains(err.Error(), "404 Not Found") {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
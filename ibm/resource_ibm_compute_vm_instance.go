@@ -57,7 +57,7 @@ const (
 )
 
 func resourceIBMComputeVmInstance() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMComputeVmInstanceCreate,
 		Read:     resourceIBMComputeVmInstanceRead,
 		Update:   resourceIBMComputeVmInstanceUpdate,
@@ -65,6 +65,12 @@ func resourceIBMComputeVmInstance() *schema.Resource {
 		Exists:   resourceIBMComputeVmInstanceExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"hostname": {
 				Type:          schema.TypeString,
@@ -492,9 +498,9 @@ func resourceIBMComputeVmInstance() *schema.Resource {
 				Set:      schema.HashString,
 			},
 			"wait_time_minutes": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  90,
+				Type:       schema.TypeInt,
+				Optional:   true,
+				Deprecated: waitTimeMinutesDeprecation,
 			},
 			// Monthly only
 			// Limited BandWidth
@@ -541,7 +547,7 @@ func resourceIBMComputeVmInstance() *schema.Resource {
 				Description: "The status of the resource",
 			},
 		},
-	}
+	}, upgradeWaitTimeMinutesState(90))
 }
 
 type vmMember map[string]interface{}
@@ -1029,7 +1035,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 
 		// wait for machine availability
 
-		_, err = WaitForVirtualGuestAvailable(id, d, meta)
+		_, err = WaitForVirtualGuestAvailable(id, d, meta, waitTimeout(d, schema.TimeoutCreate))
 
 		if err != nil {
 			return fmt.Errorf(
@@ -1433,7 +1439,7 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 			return err
 		}
 		// Wait for upgrade transactions to finish
-		_, err = WaitForNoActiveTransactions(id, d, meta)
+		_, err = WaitForNoActiveTransactions(id, d, meta, waitTimeout(d, schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -1490,7 +1496,7 @@ func resourceIBMComputeVmInstanceDelete(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
 		}
 
-		_, err = WaitForNoActiveTransactions(id, d, meta)
+		_, err = WaitForNoActiveTransactions(id, d, meta, waitTimeout(d, schema.TimeoutDelete))
 
 		if err != nil {
 			return fmt.Errorf("Error deleting virtual guest, couldn't wait for zero active transactions: %s", err)
@@ -1598,7 +1604,7 @@ func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}
 			service := services.GetVirtualGuestService(meta.(ClientSession).SoftLayerSession())
 			transactions, err := service.Id(id).GetActiveTransactions()
 			if err != nil {
-				if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("Couldn't fetch active transactions: %s", err)
 				}
 				return false, "retry", nil
@@ -1619,7 +1625,7 @@ func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}
 }
 
 // WaitForNoActiveTransactions Wait for no active transactions
-func WaitForNoActiveTransactions(id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForNoActiveTransactions(id int, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to have zero active transactions", d.Id())
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"retry", activeTransaction},
@@ -1628,7 +1634,7 @@ func WaitForNoActiveTransactions(id int, d *schema.ResourceData, meta interface{
 			service := services.GetVirtualGuestService(meta.(ClientSession).SoftLayerSession())
 			transactions, err := service.Id(id).GetActiveTransactions()
 			if err != nil {
-				if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("Couldn't get active transactions: %s", err)
 				}
 				return false, "retry", nil
@@ -1638,7 +1644,7 @@ func WaitForNoActiveTransactions(id int, d *schema.ResourceData, meta interface{
 			}
 			return transactions, activeTransaction, nil
 		},
-		Timeout:    time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
@@ -1647,14 +1653,14 @@ func WaitForNoActiveTransactions(id int, d *schema.ResourceData, meta interface{
 }
 
 // WaitForVirtualGuestAvailable Waits for virtual guest creation
-func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to be available.", d.Id())
 	sess := meta.(ClientSession).SoftLayerSession()
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", virtualGuestProvisioning},
 		Target:     []string{virtualGuestAvailable},
 		Refresh:    virtualGuestStateRefreshFunc(sess, id, d),
-		Timeout:    time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
@@ -1669,7 +1675,7 @@ func virtualGuestStateRefreshFunc(sess *session.Session, instanceID int, d *sche
 		service := services.GetVirtualGuestService(sess)
 		result, err := service.Id(instanceID).Mask("activeTransaction,primaryBackendIpAddress,primaryIpAddress").GetObject()
 		if err != nil {
-			if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			if isNotFound(err) {
 				return nil, "", fmt.Errorf("Error retrieving virtual guest: %s", err)
 			}
 			return false, "retry", nil
@@ -1723,10 +1729,8 @@ func resourceIBMComputeVmInstanceExists(d *schema.ResourceData, meta interface{}
 
 	result, err := service.Id(guestID).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
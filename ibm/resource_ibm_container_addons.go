@@ -6,11 +6,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 )
 
 func resourceIBMContainerAddOns() *schema.Resource {
@@ -349,17 +347,18 @@ func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, t
 		return false, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pending", "updating", ""},
-		Target:  []string{"normal", "warning", "critical", "available"},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("the add-ons of the cluster %s to be available", cluster),
+		Pending:     []string{"pending", "updating", ""},
+		Target:      []string{"normal", "warning", "critical", "available"},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			targetEnv, err := getClusterTargetHeader(d, meta)
 			if err != nil {
 				return nil, "", err
 			}
 			addOns, err := addOnClient.AddOns().GetAddons(cluster, targetEnv)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The resource addons %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", err
@@ -370,13 +369,13 @@ func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, t
 				}
 			}
 			return addOns, "available", nil
-		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(timeout),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 func resourceIBMContainerAddOnsExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 
@@ -394,10 +393,8 @@ func resourceIBMContainerAddOnsExists(d *schema.ResourceData, meta interface{})
 
 	_, err = addOnAPI.GetAddons(cluster, targetEnv)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
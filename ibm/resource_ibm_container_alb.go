@@ -2,14 +2,11 @@ package ibm
 
 import (
 	"fmt"
-	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 )
 
 func resourceIBMContainerALB() *schema.Resource {
@@ -213,17 +210,18 @@ func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeou
 		return false, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pending"},
-		Target:  []string{"active"},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("ALB %s to be updated", albID),
+		Pending:     []string{"pending"},
+		Target:      []string{"active"},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			targetEnv, err := getAlbTargetHeader(d, meta)
 			if err != nil {
 				return nil, "", err
 			}
 			alb, err := albClient.Albs().GetALB(albID, targetEnv)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The resource alb %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", err
@@ -238,13 +236,13 @@ func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeou
 				}
 			}
 			return alb, "active", nil
-		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(timeout),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func resourceIBMContainerALBDelete(d *schema.ResourceData, meta interface{}) error {
@@ -272,18 +270,17 @@ func waitForClusterAvailable(d *schema.ResourceData, meta interface{}, albID str
 
 	ClusterID := albConfig.ClusterID
 
-	log.Printf("Waiting for worker of the cluster (%s) wokers to be available.", ClusterID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    workerStateRefreshFunc(csClient.Workers(), ClusterID, target),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the workers of the cluster %s to be normal", ClusterID),
+		Pending:         []string{"retry", workerProvisioning},
+		Target:          []string{workerNormal},
+		Status:          workerStateRefreshFunc(csClient.Workers(), ClusterID, target),
+		Timeout:         d.Timeout(schema.TimeoutCreate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 func getAlbTargetHeader(d *schema.ResourceData, meta interface{}) (v1.ClusterTargetHeader, error) {
 	var region string
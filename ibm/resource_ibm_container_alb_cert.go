@@ -5,13 +5,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 )
 
+var containerALBCertIDTemplate = newIDTemplate("ibm_container_alb_cert", "{cluster_id}/{secret_name}/{namespace?}", "166179849c9a469581f28939874d0c82/mysecret")
+
 func resourceIBMContainerALBCert() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMContainerALBCertCreate,
@@ -19,7 +19,7 @@ func resourceIBMContainerALBCert() *schema.Resource {
 		Update:   resourceIBMContainerALBCertUpdate,
 		Delete:   resourceIBMContainerALBCertDelete,
 		Exists:   resourceIBMContainerALBCertExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: containerALBCertIDTemplate.importer(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -225,14 +225,15 @@ func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout stri
 		namespace = parts[2]
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"deleting"},
-		Target:  []string{"deleted"},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("ALB certificate %s of the cluster %s to be deleted", secretName, clusterID),
+		Pending:     []string{"deleting"},
+		Target:      []string{"deleted"},
+		Status: refreshStatus(func() (interface{}, string, error) {
 
 			secret, err := ingressClient.Ingresses().GetIngressSecret(clusterID, secretName, namespace)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return secret, "deleted", nil
 				}
 				return nil, "", err
@@ -241,13 +242,13 @@ func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout stri
 				return secret, "deleting", nil
 			}
 			return secret, "deleted", nil
-		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(timeout),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -312,10 +313,8 @@ func resourceIBMContainerALBCertExists(d *schema.ResourceData, meta interface{})
 	ingressSecretConfig, err := ingressAPI.GetIngressSecret(clusterID, secretName, namespace)
 
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -339,14 +338,15 @@ func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout s
 		namespace = parts[2]
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"creating"},
-		Target:  []string{"done"},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("ALB certificate %s of the cluster %s to be created", secretName, clusterID),
+		Pending:     []string{"creating"},
+		Target:      []string{"done"},
+		Status: refreshStatus(func() (interface{}, string, error) {
 
 			alb, err := ingressClient.Ingresses().GetIngressSecret(clusterID, secretName, namespace)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return alb, "creating", nil
 				}
 				return nil, "", err
@@ -362,11 +362,11 @@ func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout s
 				return alb, "creating", nil
 			}
 			return alb, "done", nil
-		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(timeout),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
@@ -8,13 +8,15 @@ import (
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 )
 
+var containerBindServiceIDTemplate = newIDTemplate("ibm_container_bind_service", "{cluster}/{service_instance}/{namespace}", "mycluster/myservice/default")
+
 func resourceIBMContainerBindService() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMContainerBindServiceCreate,
 		Read:     resourceIBMContainerBindServiceRead,
 		Update:   resourceIBMContainerBindServiceUpdate,
 		Delete:   resourceIBMContainerBindServiceDelete,
-		Importer: &schema.ResourceImporter{},
+		Importer: containerBindServiceIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name_id": {
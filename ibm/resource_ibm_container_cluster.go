@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 )
 
 const (
@@ -59,7 +58,7 @@ func resourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -1370,26 +1369,25 @@ func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		return nil, err
 	}
 	clusterID := d.Id()
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{clusterDeletePending},
-		Target:  []string{clusterDeleted},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("cluster %s to be deleted", clusterID),
+		Pending:     []string{clusterDeletePending},
+		Target:      []string{clusterDeleted},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			cluster, err := csClient.Clusters().Find(clusterID, targetEnv)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && (apiErr.StatusCode() == 404) {
-					return cluster, clusterDeleted, nil
-				}
 				return nil, "", err
 			}
 			return cluster, clusterDeletePending, nil
-		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        60 * time.Second,
-		MinTimeout:   10 * time.Second,
-		PollInterval: 60 * time.Second,
+		}),
+		NotFoundIsDeleted: true,
+		Timeout:           d.Timeout(schema.TimeoutDelete),
+		Delay:             60 * time.Second,
+		MinPollInterval:   60 * time.Second,
+		MaxPollInterval:   60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 // WaitForClusterAvailable Waits for cluster creation
@@ -1398,19 +1396,19 @@ func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("Waiting for cluster (%s) to be available.", d.Id())
 	id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", clusterProvisioning},
-		Target:     []string{clusterNormal},
-		Refresh:    clusterStateRefreshFunc(csClient.Clusters(), id, target),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("cluster %s to be normal", id),
+		Pending:         []string{"retry", clusterProvisioning},
+		Target:          []string{clusterNormal},
+		Status:          refreshStatus(clusterStateRefreshFunc(csClient.Clusters(), id, target)),
+		Timeout:         d.Timeout(schema.TimeoutCreate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1436,38 +1434,50 @@ func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("Waiting for worker of the cluster (%s) to be available.", d.Id())
 	id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    workerStateRefreshFunc(csClient.Workers(), id, target),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the workers of the cluster %s to be normal", id),
+		Pending:         []string{"retry", workerProvisioning},
+		Target:          []string{workerNormal},
+		Status:          workerStateRefreshFunc(csClient.Workers(), id, target),
+		Timeout:         d.Timeout(schema.TimeoutCreate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
-func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.ClusterTargetHeader) asyncStatusFunc {
+	return func() (interface{}, asyncStatus, error) {
 		workerFields, err := client.List(instanceID, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			return nil, asyncStatus{}, fmt.Errorf("Error retrieving workers for cluster: %s", err)
 		}
-		log.Println("Checking workers...")
-		//Done worker has two fields State and Status , so check for those 2
-		for _, e := range workerFields {
-			if strings.Contains(e.KubeVersion, "pending") || strings.Compare(e.State, workerNormal) != 0 || strings.Compare(e.Status, workerReadyState) != 0 {
-				if strings.Compare(e.State, "deleted") != 0 {
-					return workerFields, workerProvisioning, nil
-				}
-			}
+		return workerFields, workersStatus(workerFields, ""), nil
+	}
+}
+
+// workersStatus returns the status of the workers in the zone, or in all the zones when
+// the zone is empty, with the number of normal workers as progress. Done worker has two
+// fields State and Status, so check for those 2, the deleted workers are ignored.
+func workersStatus(workerFields []v1.Worker, zone string) asyncStatus {
+	total, normal := 0, 0
+	for _, e := range workerFields {
+		if (zone != "" && e.Location != zone) || strings.Compare(e.State, "deleted") == 0 {
+			continue
+		}
+		total++
+		if !strings.Contains(e.KubeVersion, "pending") && strings.Compare(e.State, workerNormal) == 0 && strings.Compare(e.Status, workerReadyState) == 0 {
+			normal++
 		}
-		return workerFields, workerNormal, nil
 	}
+	status := asyncStatus{State: workerNormal, Progress: fmt.Sprintf("%d/%d workers normal", normal, total)}
+	if normal < total {
+		status.State = workerProvisioning
+	}
+	return status
 }
 
 func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
@@ -1475,13 +1485,13 @@ func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("Waiting for cluster (%s) to be available.", d.Id())
 	ClusterID := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"retry", clusterProvisioning},
-		Target:  []string{clusterNormal},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("the workers of the cluster %s to be deployed", ClusterID),
+		Pending:     []string{"retry", clusterProvisioning},
+		Target:      []string{clusterNormal},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			workerFields, err := csClient.Workers().List(ClusterID, target)
 			log.Println("Total workers: ", len(workerFields))
 			if err != nil {
@@ -1496,13 +1506,13 @@ func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.
 				}
 			}
 			return workerFields, workerProvisioning, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutCreate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
@@ -1510,19 +1520,19 @@ func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("Waiting for Ingress Subdomain and secret being assigned.")
 	id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    subnetStateRefreshFunc(csClient.Clusters(), id, d, target),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the ingress subdomain and secret of the cluster %s to be assigned", id),
+		Pending:         []string{"retry", workerProvisioning},
+		Target:          []string{workerNormal},
+		Status:          refreshStatus(subnetStateRefreshFunc(csClient.Clusters(), id, d, target)),
+		Timeout:         d.Timeout(schema.TimeoutCreate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1544,20 +1554,20 @@ func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, targe
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("Waiting for cluster (%s) version to be updated.", d.Id())
 	id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending:                   []string{"retry", versionUpdating},
-		Target:                    []string{clusterNormal},
-		Refresh:                   clusterVersionRefreshFunc(csClient.Clusters(), id, d, target),
-		Timeout:                   d.Timeout(schema.TimeoutUpdate),
-		Delay:                     20 * time.Second,
-		MinTimeout:                10 * time.Second,
-		ContinuousTargetOccurence: 5,
+	waiter := &asyncWaiter{
+		Description:      fmt.Sprintf("cluster %s version to be updated", id),
+		Pending:          []string{"retry", versionUpdating},
+		Target:           []string{clusterNormal},
+		Status:           refreshStatus(clusterVersionRefreshFunc(csClient.Clusters(), id, d, target)),
+		Timeout:          d.Timeout(schema.TimeoutUpdate),
+		Delay:            20 * time.Second,
+		MinPollInterval:  10 * time.Second,
+		ContinuousTarget: 5,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func clusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1591,10 +1601,8 @@ func resourceIBMContainerClusterExists(d *schema.ResourceData, meta interface{})
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().FindWithOutShowResourcesCompatible(clusterID, targetEnv)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -5,7 +5,6 @@ import (
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
@@ -153,13 +152,11 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 	}
 	if v, ok := d.GetOkExists("reload_workers"); ok {
 		if v.(bool) {
-			log.Printf("Waiting for cluster (%s) to be available.", cluster)
 			_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf(
 					"Error waiting for cluster (%s) to become ready: %s", cluster, err)
 			}
-			log.Printf("Waiting for workers (%s) to be available.", cluster)
 			_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf(
@@ -208,13 +205,11 @@ func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.R
 	if err != nil {
 		return err
 	}
-	log.Printf("Waiting for cluster (%s) to be available.", cluster)
 	_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for cluster (%s) to become ready: %s", d.Id(), err)
 	}
-	log.Printf("Waiting for workers (%s) to be available.", cluster)
 	_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
 	if err != nil {
 		return fmt.Errorf(
@@ -314,19 +309,19 @@ func WaitForClusterAvailableForFeatureUpdate(cluster string, timeout time.Durati
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("Waiting for cluster (%s) to be available.", cluster)
 	id := cluster
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", clusterProvisioning},
-		Target:     []string{clusterNormal},
-		Refresh:    clusterStateRefreshFunc(csClient.Clusters(), id, target),
-		Timeout:    timeout,
-		Delay:      60 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("cluster %s to be normal", id),
+		Pending:         []string{"retry", clusterProvisioning},
+		Target:          []string{clusterNormal},
+		Status:          refreshStatus(clusterStateRefreshFunc(csClient.Clusters(), id, target)),
+		Timeout:         timeout,
+		Delay:           60 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func WaitForWorkerAvailableForFeatureUpdate(cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
@@ -334,17 +329,17 @@ func WaitForWorkerAvailableForFeatureUpdate(cluster string, timeout time.Duratio
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("Waiting for worker of the cluster (%s) to be available.", cluster)
 	id := cluster
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    workerStateRefreshFunc(csClient.Workers(), id, target),
-		Timeout:    timeout,
-		Delay:      60 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the workers of the cluster %s to be normal", id),
+		Pending:         []string{"retry", workerProvisioning},
+		Target:          []string{workerNormal},
+		Status:          workerStateRefreshFunc(csClient.Workers(), id, target),
+		Timeout:         timeout,
+		Delay:           60 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
@@ -6,9 +6,7 @@ import (
 	"time"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -226,14 +224,15 @@ func waitForVpcContainerALB(d *schema.ResourceData, meta interface{}, albID, tim
 	if err != nil {
 		return false, err
 	}
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pending"},
-		Target:  []string{"active"},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("ALB %s to be updated", albID),
+		Pending:     []string{"pending"},
+		Target:      []string{"active"},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			targetEnv := v2.ClusterTargetHeader{}
 			alb, err := albClient.Albs().GetAlb(albID, targetEnv)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The resource alb %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", err
@@ -248,13 +247,13 @@ func waitForVpcContainerALB(d *schema.ResourceData, meta interface{}, albID, tim
 				}
 			}
 			return alb, "active", nil
-		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(timeout),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func resourceIBMContainerVpcALBDelete(d *schema.ResourceData, meta interface{}) error {
@@ -268,10 +267,11 @@ func waitForVpcClusterAvailable(d *schema.ResourceData, meta interface{}, albID,
 	if err != nil {
 		return false, err
 	}
-	createStateConf := &resource.StateChangeConf{
-		Pending: []string{deployRequested, deployInProgress},
-		Target:  []string{ready},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("the workers of the cluster of the ALB %s to be deployed", albID),
+		Pending:     []string{deployRequested, deployInProgress},
+		Target:      []string{ready},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			targetEnv := v2.ClusterTargetHeader{}
 			albInfo, err := albClient.Albs().GetAlb(albID, targetEnv)
 			if err == nil {
@@ -305,10 +305,10 @@ func waitForVpcClusterAvailable(d *schema.ResourceData, meta interface{}, albID,
 				return albInfo, deployInProgress, err
 			}
 			return albInfo, ready, nil
-		},
-		Timeout:    d.Timeout(timeout),
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		}),
+		Timeout:         d.Timeout(timeout),
+		Delay:           10 * time.Second,
+		MinPollInterval: 5 * time.Second,
 	}
-	return createStateConf.WaitForState()
+	return waiter.wait()
 }
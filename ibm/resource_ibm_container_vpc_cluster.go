@@ -17,7 +17,6 @@ import (
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 )
 
 const (
@@ -41,7 +40,7 @@ func resourceIBMContainerVpcCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -762,16 +761,17 @@ func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string
 	if err != nil {
 		return nil, err
 	}
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{workerDeleteState},
-		Refresh:    workerPoolV2ZoneDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the workers of the zone %s of the worker pool %s to be deleted", zone, workerPoolNameOrID),
+		Pending:         []string{"deleting"},
+		Target:          []string{workerDeleteState},
+		Status:          refreshStatus(workerPoolV2ZoneDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 func workerPoolV2ZoneDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID, zone string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -1015,26 +1015,25 @@ func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interfac
 		return nil, err
 	}
 	clusterID := d.Id()
-	deleteStateConf := &resource.StateChangeConf{
-		Pending: []string{clusterDeletePending},
-		Target:  []string{clusterDeleted},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("cluster %s to be deleted", clusterID),
+		Pending:     []string{clusterDeletePending},
+		Target:      []string{clusterDeleted},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && (apiErr.StatusCode() == 404) {
-					return cluster, clusterDeleted, nil
-				}
 				return nil, "", err
 			}
 			return cluster, clusterDeletePending, nil
-		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        10 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 5 * time.Second,
+		}),
+		NotFoundIsDeleted: true,
+		Timeout:           d.Timeout(schema.TimeoutDelete),
+		Delay:             10 * time.Second,
+		MinPollInterval:   5 * time.Second,
+		MaxPollInterval:   5 * time.Second,
 	}
 
-	return deleteStateConf.WaitForState()
+	return waiter.wait()
 }
 
 func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -1047,10 +1046,11 @@ func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{
 		return nil, err
 	}
 	clusterID := d.Id()
-	createStateConf := &resource.StateChangeConf{
-		Pending: []string{deployRequested, deployInProgress},
-		Target:  []string{normal},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("a worker of the cluster %s to be normal", clusterID),
+		Pending:     []string{deployRequested, deployInProgress},
+		Target:      []string{normal},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			workers, err := csClient.Workers().ListByWorkerPool(clusterID, "default", false, targetEnv)
 			if err != nil {
 				return workers, deployInProgress, err
@@ -1069,13 +1069,13 @@ func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{
 			}
 			return workers, deployInProgress, nil
 
-		},
-		Timeout:                   d.Timeout(schema.TimeoutCreate),
-		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
-		ContinuousTargetOccurence: 5,
+		}),
+		Timeout:          d.Timeout(schema.TimeoutCreate),
+		Delay:            10 * time.Second,
+		MinPollInterval:  5 * time.Second,
+		ContinuousTarget: 5,
 	}
-	return createStateConf.WaitForState()
+	return waiter.wait()
 }
 
 func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -1088,10 +1088,11 @@ func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{})
 		return nil, err
 	}
 	clusterID := d.Id()
-	createStateConf := &resource.StateChangeConf{
-		Pending: []string{deployRequested, deployInProgress},
-		Target:  []string{ready},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("the master of the cluster %s to be ready", clusterID),
+		Pending:     []string{deployRequested, deployInProgress},
+		Target:      []string{ready},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			clusterInfo, clusterInfoErr := csClient.Clusters().GetCluster(clusterID, targetEnv)
 
 			if err != nil || clusterInfoErr != nil {
@@ -1103,13 +1104,13 @@ func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{})
 			}
 			return clusterInfo, deployInProgress, nil
 
-		},
-		Timeout:                   d.Timeout(schema.TimeoutCreate),
-		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
-		ContinuousTargetOccurence: 5,
+		}),
+		Timeout:          d.Timeout(schema.TimeoutCreate),
+		Delay:            10 * time.Second,
+		MinPollInterval:  5 * time.Second,
+		ContinuousTarget: 5,
 	}
-	return createStateConf.WaitForState()
+	return waiter.wait()
 }
 
 func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -1122,10 +1123,11 @@ func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{})
 		return nil, err
 	}
 	clusterID := d.Id()
-	createStateConf := &resource.StateChangeConf{
-		Pending: []string{deployRequested, deployInProgress},
-		Target:  []string{ready},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("the ingress of the cluster %s to be ready", clusterID),
+		Pending:     []string{deployRequested, deployInProgress},
+		Target:      []string{ready},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			clusterInfo, clusterInfoErr := csClient.Clusters().GetCluster(clusterID, targetEnv)
 
 			if err != nil || clusterInfoErr != nil {
@@ -1137,13 +1139,13 @@ func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{})
 			}
 			return clusterInfo, deployInProgress, nil
 
-		},
-		Timeout:                   d.Timeout(schema.TimeoutCreate),
-		Delay:                     10 * time.Second,
-		MinTimeout:                5 * time.Second,
-		ContinuousTargetOccurence: 5,
+		}),
+		Timeout:          d.Timeout(schema.TimeoutCreate),
+		Delay:            10 * time.Second,
+		MinPollInterval:  5 * time.Second,
+		ContinuousTarget: 5,
 	}
-	return createStateConf.WaitForState()
+	return waiter.wait()
 }
 
 func getVpcClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v2.ClusterTargetHeader, error) {
@@ -1170,10 +1172,8 @@ func resourceIBMContainerVpcClusterExists(d *schema.ResourceData, meta interface
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -1186,20 +1186,20 @@ func WaitForVpcClusterVersionUpdate(d *schema.ResourceData, meta interface{}, ta
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("Waiting for cluster (%s) version to be updated.", d.Id())
 	id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending:                   []string{"retry", versionUpdating},
-		Target:                    []string{clusterNormal},
-		Refresh:                   vpcClusterVersionRefreshFunc(csClient.Clusters(), id, d, target),
-		Timeout:                   d.Timeout(schema.TimeoutUpdate),
-		Delay:                     10 * time.Second,
-		MinTimeout:                10 * time.Second,
-		ContinuousTargetOccurence: 5,
+	waiter := &asyncWaiter{
+		Description:      fmt.Sprintf("cluster %s version to be updated", id),
+		Pending:          []string{"retry", versionUpdating},
+		Target:           []string{clusterNormal},
+		Status:           refreshStatus(vpcClusterVersionRefreshFunc(csClient.Clusters(), id, d, target)),
+		Timeout:          d.Timeout(schema.TimeoutUpdate),
+		Delay:            10 * time.Second,
+		MinPollInterval:  10 * time.Second,
+		ContinuousTarget: 5,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *schema.ResourceData, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1225,19 +1225,19 @@ func WaitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface
 		return nil, err
 	}
 
-	log.Printf("Waiting for worker (%s) version to be updated.", workerID)
 	clusterID := d.Id()
-	stateConf := &resource.StateChangeConf{
-		Pending:                   []string{"retry", versionUpdating},
-		Target:                    []string{workerNormal},
-		Refresh:                   vpcClusterWorkersVersionRefreshFunc(csClient.Workers(), workerID, clusterID, d, target, masterVersion),
-		Timeout:                   d.Timeout(schema.TimeoutUpdate),
-		Delay:                     10 * time.Second,
-		MinTimeout:                10 * time.Second,
-		ContinuousTargetOccurence: 5,
-	}
-
-	return stateConf.WaitForState()
+	waiter := &asyncWaiter{
+		Description:      fmt.Sprintf("worker %s of the cluster %s version to be updated", workerID, clusterID),
+		Pending:          []string{"retry", versionUpdating},
+		Target:           []string{workerNormal},
+		Status:           refreshStatus(vpcClusterWorkersVersionRefreshFunc(csClient.Workers(), workerID, clusterID, d, target, masterVersion)),
+		Timeout:          d.Timeout(schema.TimeoutUpdate),
+		Delay:            10 * time.Second,
+		MinPollInterval:  10 * time.Second,
+		ContinuousTarget: 5,
+	}
+
+	return waiter.wait()
 }
 
 func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID string, d *schema.ResourceData, target v2.ClusterTargetHeader, masterVersion string) resource.StateRefreshFunc {
@@ -1262,10 +1262,11 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 	}
 
 	clusterID := d.Id()
-	deleteStateConf := &resource.StateChangeConf{
-		Pending: []string{workerDeletePending},
-		Target:  []string{workerDeleteState},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("worker %s of the cluster %s to be deleted", workerID, clusterID),
+		Pending:     []string{workerDeletePending},
+		Target:      []string{workerDeleteState},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			worker, err := csClient.Workers().Get(clusterID, workerID, targetEnv)
 			if err != nil {
 				return worker, workerDeletePending, nil
@@ -1274,13 +1275,13 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 				return worker, workerDeleteState, nil
 			}
 			return worker, workerDeletePending, nil
-		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        10 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 5 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutDelete),
+		Delay:           10 * time.Second,
+		MinPollInterval: 5 * time.Second,
+		MaxPollInterval: 5 * time.Second,
 	}
-	return deleteStateConf.WaitForState()
+	return waiter.wait()
 }
 
 func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
@@ -1290,10 +1291,11 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 	}
 
 	clusterID := d.Id()
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"creating"},
-		Target:  []string{"created"},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("the new workers of the cluster %s to be created", clusterID),
+		Pending:     []string{"creating"},
+		Target:      []string{"created"},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
 			if err != nil {
 				return workers, "", fmt.Errorf("Error in retriving the list of worker nodes")
@@ -1302,13 +1304,13 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 				return workers, "created", nil
 			}
 			return workers, "creating", nil
-		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        10 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 5 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutDelete),
+		Delay:           10 * time.Second,
+		MinPollInterval: 5 * time.Second,
+		MaxPollInterval: 5 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersInfo map[string]int) (string, int, error) {
@@ -11,13 +11,14 @@ import (
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 )
 
 const (
 	workerDesired = "deployed"
 )
 
+var containerVPCWorkerPoolIDTemplate = newIDTemplate("ibm_container_vpc_worker_pool", "{cluster}/{worker_pool_id}", "mycluster/5c4f4d06e0dc402084922dea70850e3b-7cafe35")
+
 func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 
 	return &schema.Resource{
@@ -26,7 +27,7 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Read:     resourceIBMContainerVpcWorkerPoolRead,
 		Delete:   resourceIBMContainerVpcWorkerPoolDelete,
 		Exists:   resourceIBMContainerVpcWorkerPoolExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: containerVPCWorkerPoolIDTemplate.importer(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
@@ -416,10 +417,8 @@ func resourceIBMContainerVpcWorkerPoolExists(d *schema.ResourceData, meta interf
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -433,39 +432,43 @@ func WaitForWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, cluste
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("Waiting for workerpool (%s) to be available.", d.Id())
 	// id := d.Id()
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"provision_pending"},
-		Target:     []string{workerDesired},
-		Refresh:    vpcWorkerPoolStateRefreshFunc(wpClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the workers of the worker pool %s to be deployed", workerPoolNameOrID),
+		Pending:         []string{"provision_pending"},
+		Target:          []string{workerDesired},
+		Status:          vpcWorkerPoolStateRefreshFunc(wpClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
-func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerPoolNameOrID string, target v2.ClusterTargetHeader) asyncStatusFunc {
+	return func() (interface{}, asyncStatus, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, "", false, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			return nil, asyncStatus{}, fmt.Errorf("Error retrieving workers for cluster: %s", err)
 		}
-		// Check active transactions
 		//Check for worker state to be deployed
 		//Done worker has two fields desiredState and actualState , so check for those 2
+		total, deployed := 0, 0
 		for _, e := range workerFields {
 			if e.PoolName == workerPoolNameOrID || e.PoolID == workerPoolNameOrID {
-				if strings.Compare(e.LifeCycle.ActualState, "deployed") != 0 {
-					log.Printf("worker: %s state: %s", e.ID, e.LifeCycle.ActualState)
-					return workerFields, "provision_pending", nil
+				total++
+				if strings.Compare(e.LifeCycle.ActualState, "deployed") == 0 {
+					deployed++
 				}
 			}
 		}
-		return workerFields, workerDesired, nil
+		status := asyncStatus{State: workerDesired, Progress: fmt.Sprintf("%d/%d workers deployed", deployed, total)}
+		if deployed < total {
+			status.State = "provision_pending"
+		}
+		return workerFields, status, nil
 	}
 }
 
@@ -475,16 +478,17 @@ func WaitForVpcWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta int
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{workerDeleteState},
-		Refresh:    vpcworkerPoolDeleteStateRefreshFunc(wpClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the workers of the worker pool %s to be deleted", workerPoolNameOrID),
+		Pending:         []string{"deleting"},
+		Target:          []string{workerDeleteState},
+		Status:          refreshStatus(vpcworkerPoolDeleteStateRefreshFunc(wpClient.Workers(), clusterNameOrID, workerPoolNameOrID, target)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
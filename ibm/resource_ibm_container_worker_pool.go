@@ -6,11 +6,12 @@ import (
 	"time"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var containerWorkerPoolIDTemplate = newIDTemplate("ibm_container_worker_pool", "{cluster}/{worker_pool_id}", "mycluster/5c4f4d06e0dc402084922dea70850e3b-7cafe35")
+
 func resourceIBMContainerWorkerPool() *schema.Resource {
 
 	return &schema.Resource{
@@ -19,7 +20,7 @@ func resourceIBMContainerWorkerPool() *schema.Resource {
 		Update:   resourceIBMContainerWorkerPoolUpdate,
 		Delete:   resourceIBMContainerWorkerPoolDelete,
 		Exists:   resourceIBMContainerWorkerPoolExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: containerWorkerPoolIDTemplate.importer(),
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(90 * time.Minute),
 		},
@@ -358,10 +359,8 @@ func resourceIBMContainerWorkerPoolExists(d *schema.ResourceData, meta interface
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -375,33 +374,26 @@ func WaitForWorkerNormal(clusterNameOrID, workerPoolNameOrID string, meta interf
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    workerPoolStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the workers of the worker pool %s to be normal", workerPoolNameOrID),
+		Pending:         []string{"retry", workerProvisioning},
+		Target:          []string{workerNormal},
+		Status:          workerPoolStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
-func workerPoolStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func workerPoolStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) asyncStatusFunc {
+	return func() (interface{}, asyncStatus, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, workerPoolNameOrID, false, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
-		}
-		//Done worker has two fields State and Status , so check for those 2
-		for _, e := range workerFields {
-			if strings.Contains(e.KubeVersion, "pending") || strings.Compare(e.State, workerNormal) != 0 || strings.Compare(e.Status, workerReadyState) != 0 {
-				if strings.Compare(e.State, "deleted") != 0 {
-					return workerFields, workerProvisioning, nil
-				}
-			}
+			return nil, asyncStatus{}, fmt.Errorf("Error retrieving workers for cluster: %s", err)
 		}
-		return workerFields, workerNormal, nil
+		return workerFields, workersStatus(workerFields, ""), nil
 	}
 }
 
@@ -411,16 +403,17 @@ func WaitForWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interf
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{workerDeleteState},
-		Refresh:    workerPoolDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the workers of the worker pool %s to be deleted", workerPoolNameOrID),
+		Pending:         []string{"deleting"},
+		Target:          []string{workerDeleteState},
+		Status:          refreshStatus(workerPoolDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, target)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func workerPoolDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -6,11 +6,13 @@ import (
 	"time"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var containerWorkerPoolZoneAttachmentIDTemplate = newIDTemplate("ibm_container_worker_pool_zone_attachment", "{cluster}/{worker_pool}/{zone}", "mycluster/5c4f4d06e0dc402084922dea70850e3b-7cafe35/dal10")
+var containerWorkerPoolZoneAttachmentLock = newParentLock("ibm_container_worker_pool_zone_attachment", "worker_pool")
+
 func resourceIBMContainerWorkerPoolZoneAttachment() *schema.Resource {
 
 	return &schema.Resource{
@@ -19,7 +21,7 @@ func resourceIBMContainerWorkerPoolZoneAttachment() *schema.Resource {
 		Update:   resourceIBMContainerWorkerPoolZoneAttachmentUpdate,
 		Delete:   resourceIBMContainerWorkerPoolZoneAttachmentDelete,
 		Exists:   resourceIBMContainerWorkerPoolZoneAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: containerWorkerPoolZoneAttachmentIDTemplate.importer(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Update: schema.DefaultTimeout(90 * time.Minute),
@@ -131,7 +133,9 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 		return err
 	}
 
-	err = workerPoolsAPI.AddZone(cluster, workerPool, workerPoolZone, targetEnv)
+	err = containerWorkerPoolZoneAttachmentLock.mutate(d.Timeout(schema.TimeoutCreate), func() error {
+		return workerPoolsAPI.AddZone(cluster, workerPool, workerPoolZone, targetEnv)
+	}, cluster, workerPool)
 	if err != nil {
 		return err
 	}
@@ -228,7 +232,9 @@ func resourceIBMContainerWorkerPoolZoneAttachmentUpdate(d *schema.ResourceData,
 		cluster := parts[0]
 		workerPool := parts[1]
 		zone := parts[2]
-		err = workerPoolsAPI.UpdateZoneNetwork(cluster, zone, workerPool, privateVLAN, publicVLAN, targetEnv)
+		err = containerWorkerPoolZoneAttachmentLock.mutate(d.Timeout(schema.TimeoutUpdate), func() error {
+			return workerPoolsAPI.UpdateZoneNetwork(cluster, zone, workerPool, privateVLAN, publicVLAN, targetEnv)
+		}, cluster, workerPool)
 		if err != nil {
 			return err
 		}
@@ -256,7 +262,9 @@ func resourceIBMContainerWorkerPoolZoneAttachmentDelete(d *schema.ResourceData,
 	if err != nil {
 		return err
 	}
-	err = workerPoolsAPI.RemoveZone(cluster, zone, workerPool, targetEnv)
+	err = containerWorkerPoolZoneAttachmentLock.mutate(d.Timeout(schema.TimeoutDelete), func() error {
+		return workerPoolsAPI.RemoveZone(cluster, zone, workerPool, targetEnv)
+	}, cluster, workerPool)
 	if err != nil {
 		return err
 	}
@@ -290,10 +298,8 @@ func resourceIBMContainerWorkerPoolZoneAttachmentExists(d *schema.ResourceData,
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -313,35 +319,26 @@ func WaitForWorkerZoneNormal(clusterNameOrID, workerPoolNameOrID, zone string, m
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", workerProvisioning},
-		Target:     []string{workerNormal},
-		Refresh:    workerPoolZoneStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the workers of the zone %s of the worker pool %s to be normal", zone, workerPoolNameOrID),
+		Pending:         []string{"retry", workerProvisioning},
+		Target:          []string{workerNormal},
+		Status:          workerPoolZoneStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
-func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) asyncStatusFunc {
+	return func() (interface{}, asyncStatus, error) {
 		workerFields, err := client.ListByWorkerPool(instanceID, workerPoolNameOrID, false, target)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
-		}
-		//Done worker has two fields State and Status , so check for those 2
-		for _, e := range workerFields {
-			if e.Location == zone {
-				if strings.Contains(e.KubeVersion, "pending") || strings.Compare(e.State, workerNormal) != 0 || strings.Compare(e.Status, workerReadyState) != 0 {
-					if strings.Compare(e.State, "deleted") != 0 {
-						return workerFields, workerProvisioning, nil
-					}
-				}
-			}
+			return nil, asyncStatus{}, fmt.Errorf("Error retrieving workers for cluster: %s", err)
 		}
-		return workerFields, workerNormal, nil
+		return workerFields, workersStatus(workerFields, zone), nil
 	}
 }
 
@@ -351,16 +348,17 @@ func WaitForWorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string,
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{workerDeleteState},
-		Refresh:    workerPoolZoneDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the workers of the zone %s of the worker pool %s to be deleted", zone, workerPoolNameOrID),
+		Pending:         []string{"deleting"},
+		Target:          []string{workerDeleteState},
+		Status:          refreshStatus(workerPoolZoneDeleteStateRefreshFunc(csClient.Workers(), clusterNameOrID, workerPoolNameOrID, zone, target)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func workerPoolZoneDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -387,16 +385,17 @@ func waitForWorkerZoneALB(clusterNameOrID, zone string, meta interface{}, timeou
 		return nil, err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending"},
-		Target:     []string{"ready"},
-		Refresh:    workerZoneALBStateRefreshFunc(csClient.Albs(), clusterNameOrID, zone, target),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("the ALBs of the zone %s of the cluster %s to be ready", zone, clusterNameOrID),
+		Pending:         []string{"pending"},
+		Target:          []string{"ready"},
+		Status:          refreshStatus(workerZoneALBStateRefreshFunc(csClient.Albs(), clusterNameOrID, zone, target)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func workerZoneALBStateRefreshFunc(client v1.Albs, instanceID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
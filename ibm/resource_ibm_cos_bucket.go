@@ -39,6 +39,10 @@ const (
 	keyAlgorithm = "AES256"
 )
 
+var cosBucketIDTemplate = newIDTemplate("ibm_cos_bucket",
+	"{crn}:{version}:{cname}:{ctype}:{service_name}:{location}:{scope}:{service_instance}:{resource_type}:{bucket_name}:{meta}:{bucket_type}:{bucket_location}:{endpoint_type?}",
+	"crn:v1:bluemix:public:cloud-object-storage:global:a/4ea1882a2d3401ed1e459979941966ea:31fa970d-51d0-4b05-893e-251cba75a7b3:bucket:mybucketname:meta:crl:eu:public")
+
 func resourceIBMCOS() *schema.Resource {
 	return &schema.Resource{
 		Read:     resourceIBMCOSRead,
@@ -46,7 +50,7 @@ func resourceIBMCOS() *schema.Resource {
 		Update:   resourceIBMCOSUpdate,
 		Delete:   resourceIBMCOSDelete,
 		Exists:   resourceIBMCOSExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: cosBucketIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -396,7 +400,7 @@ func resourceIBMCOSUpdate(d *schema.ResourceData, meta interface{}) error {
 			s3Conf = aws.NewConfig().WithEndpoint(envFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 		}
 		s3Sess := session.Must(session.NewSession())
-		s3Client := s3.New(s3Sess, s3Conf)
+		s3Client := s3.New(s3Sess, s3Conf.WithHTTPClient(s3HTTPClient(meta)))
 
 		var archive, archive_ok = d.GetOk("archive_rule")
 		var expire, expire_ok = d.GetOk("expire_rule")
@@ -525,6 +529,9 @@ func resourceIBMCOSUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMCOSRead(d *schema.ResourceData, meta interface{}) error {
+	if err := cosBucketIDTemplate.validate(d.Id()); err != nil {
+		return err
+	}
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
@@ -561,7 +568,7 @@ func resourceIBMCOSRead(d *schema.ResourceData, meta interface{}) error {
 		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
 	}
 	s3Sess := session.Must(session.NewSession())
-	s3Client := s3.New(s3Sess, s3Conf)
+	s3Client := s3.New(s3Sess, s3Conf.WithHTTPClient(s3HTTPClient(meta)))
 
 	headInput := &s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
@@ -748,7 +755,7 @@ func resourceIBMCOSCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	s3Sess := session.Must(session.NewSession())
-	s3Client := s3.New(s3Sess, s3Conf)
+	s3Client := s3.New(s3Sess, s3Conf.WithHTTPClient(s3HTTPClient(meta)))
 
 	_, err = s3Client.CreateBucket(create)
 	if err != nil {
@@ -815,7 +822,7 @@ func resourceIBMCOSDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	s3Sess := session.Must(session.NewSession())
-	s3Client := s3.New(s3Sess, s3Conf)
+	s3Client := s3.New(s3Sess, s3Conf.WithHTTPClient(s3HTTPClient(meta)))
 
 	if delbucket, ok := d.GetOk("force_delete"); ok {
 		if delbucket.(bool) {
@@ -856,6 +863,9 @@ func resourceIBMCOSDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMCOSExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	if err := cosBucketIDTemplate.validate(d.Id()); err != nil {
+		return false, err
+	}
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
@@ -898,7 +908,7 @@ func resourceIBMCOSExists(d *schema.ResourceData, meta interface{}) (bool, error
 	}
 
 	s3Sess := session.Must(session.NewSession())
-	s3Client := s3.New(s3Sess, s3Conf)
+	s3Client := s3.New(s3Sess, s3Conf.WithHTTPClient(s3HTTPClient(meta)))
 
 	bucketList, err := s3Client.ListBuckets(&s3.ListBucketsInput{})
 	if err != nil {
@@ -976,29 +986,28 @@ func selectCosApi(apiType string, bLocation string) (string, string) {
 	return "", ""
 }
 
+// parseBucketId returns the requested part of the bucket ID, or an empty string when
+// the ID is invalid
 func parseBucketId(id string, info string) string {
-	crn := strings.Split(id, ":meta:")[0]
-	meta := strings.Split(id, ":meta:")[1]
+	bucketID, err := cosBucketIDTemplate.parse(id)
+	if err != nil {
+		return ""
+	}
 
 	if info == "bucketName" {
-		return strings.Split(crn, ":bucket:")[1]
+		return bucketID.get("bucket_name")
 	}
 	if info == "serviceID" {
-		return fmt.Sprintf("%s::", strings.Split(crn, ":bucket:")[0])
+		return fmt.Sprintf("%s::", strings.Join(bucketID.values[:8], ":"))
 	}
 	if info == "apiType" {
-		return strings.Split(meta, ":")[0]
+		return bucketID.get("bucket_type")
 	}
 	if info == "bLocation" {
-		return strings.Split(meta, ":")[1]
+		return bucketID.get("bucket_location")
 	}
 	if info == "endpointType" {
-		s := strings.Split(meta, ":")
-		if len(s) > 2 {
-			return strings.Split(meta, ":")[2]
-		}
-		return ""
-
+		return bucketID.get("endpoint_type")
 	}
 	return ""
 }
@@ -3,7 +3,6 @@ package ibm
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
@@ -130,7 +129,7 @@ func resourceIBMContainerRegistryNamespaceDelete(d *schema.ResourceData, meta in
 	}
 
 	err = crAPI.DeleteNamespace(namespace, target)
-	if err != nil && !strings.Contains(err.Error(), "404") {
+	if err != nil && !isNotFound(err) {
 		return err
 	}
 	return nil
@@ -74,7 +74,7 @@ func resourceIBMDLGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -574,7 +574,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 
 	instance, response, err := directLink.GetGateway(getOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -876,7 +876,7 @@ func resourceIBMdlGatewayDelete(d *schema.ResourceData, meta interface{}) error
 	}
 	response, err := directLink.DeleteGateway(delOptions)
 
-	if err != nil && response.StatusCode != 404 {
+	if err != nil && !isNotFound(responseError(response, err)) {
 		log.Printf("Error deleting Direct Link Gateway : %s", response)
 		return err
 	}
@@ -898,7 +898,7 @@ func resourceIBMdlGatewayExists(d *schema.ResourceData, meta interface{}) (bool,
 	}
 	_, response, err := directLink.GetGateway(getOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return false, nil
 		}
@@ -22,6 +22,9 @@ const (
 	dlVirtualConnectionId        = "virtual_connection_id"
 )
 
+var dlGatewayVirtualConnectionIDTemplate = newIDTemplate("ibm_dl_virtual_connection", "{gateway_id}/{virtual_connection_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+var dlGatewayVirtualConnectionLock = newParentLock("ibm_dl_virtual_connection", "dl_gateway")
+
 func resourceIBMDLGatewayVC() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMdlGatewayVCCreate,
@@ -29,7 +32,7 @@ func resourceIBMDLGatewayVC() *schema.Resource {
 		Delete:   resourceIBMdlGatewayVCDelete,
 		Exists:   resourceIBMdlGatewayVCExists,
 		Update:   resourceIBMdlGatewayVCUpdate,
-		Importer: &schema.ResourceImporter{},
+		Importer: dlGatewayVirtualConnectionIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -140,9 +143,17 @@ func resourceIBMdlGatewayVCCreate(d *schema.ResourceData, meta interface{}) erro
 		createGatewayVCOptions.SetNetworkID(vcNetworkId)
 	}
 
-	gatewayVC, response, err := directLink.CreateGatewayVirtualConnection(createGatewayVCOptions)
+	var gatewayVC *directlinkv1.GatewayVirtualConnection
+	err = dlGatewayVirtualConnectionLock.mutate(d.Timeout(schema.TimeoutCreate), func() error {
+		created, response, err := directLink.CreateGatewayVirtualConnection(createGatewayVCOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Create Direct Link Gateway (Dedicated) Virtual connection err %s\n%s", err, response)
+			return responseError(response, err)
+		}
+		gatewayVC = created
+		return nil
+	}, gatewayId)
 	if err != nil {
-		log.Printf("[DEBUG] Create Direct Link Gateway (Dedicated) Virtual connection err %s\n%s", err, response)
 		return err
 	}
 
@@ -170,7 +181,7 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 	getGatewayVirtualConnectionOptions.SetID(ID)
 	instance, response, err := directLink.GetGatewayVirtualConnection(getGatewayVirtualConnectionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -243,9 +254,15 @@ func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	_, response, err := directLink.UpdateGatewayVirtualConnection(updateGatewayVCOptions)
+	err = dlGatewayVirtualConnectionLock.mutate(d.Timeout(schema.TimeoutUpdate), func() error {
+		_, response, err := directLink.UpdateGatewayVirtualConnection(updateGatewayVCOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Update Direct Link Gateway (Dedicated) Virtual Connection err %s\n%s", err, response)
+			return responseError(response, err)
+		}
+		return nil
+	}, gatewayId)
 	if err != nil {
-		log.Printf("[DEBUG] Update Direct Link Gateway (Dedicated) Virtual Connection err %s\n%s", err, response)
 		return err
 	}
 
@@ -269,10 +286,15 @@ func resourceIBMdlGatewayVCDelete(d *schema.ResourceData, meta interface{}) erro
 		ID: &ID,
 	}
 	delVCOptions.SetGatewayID(gatewayId)
-	response, err := directLink.DeleteGatewayVirtualConnection(delVCOptions)
-
-	if err != nil && response.StatusCode != 404 {
-		log.Printf("Error deleting Direct Link Gateway (Dedicated Template) Virtual Connection: %s", response)
+	err = dlGatewayVirtualConnectionLock.mutate(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err := directLink.DeleteGatewayVirtualConnection(delVCOptions)
+		if err != nil && !isNotFound(responseError(response, err)) {
+			log.Printf("Error deleting Direct Link Gateway (Dedicated Template) Virtual Connection: %s", response)
+			return responseError(response, err)
+		}
+		return nil
+	}, gatewayId)
+	if err != nil {
 		return err
 	}
 
@@ -299,16 +321,11 @@ func resourceIBMdlGatewayVCExists(d *schema.ResourceData, meta interface{}) (boo
 	getVCOptions.SetGatewayID(gatewayId)
 	_, response, err := directLink.GetGatewayVirtualConnection(getVCOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return false, nil
 		}
 		return false, fmt.Errorf("Error Getting Direct Link Gateway (Dedicated Template) Virtual Connection: %s\n%s", err, response)
 	}
-
-	if response.StatusCode == 404 {
-		d.SetId("")
-		return false, nil
-	}
 	return true, nil
 }
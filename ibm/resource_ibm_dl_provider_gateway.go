@@ -22,7 +22,7 @@ func resourceIBMDLProviderGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -236,7 +236,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 
 	instance, response, err := directLink.GetProviderGateway(getOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -385,7 +385,7 @@ func resourceIBMdlProviderGatewayExists(d *schema.ResourceData, meta interface{}
 	getOptions := directLink.NewGetProviderGatewayOptions(ID)
 	_, response, err := directLink.GetProviderGateway(getOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return false, nil
 		}
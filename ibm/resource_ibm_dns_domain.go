@@ -206,10 +206,8 @@ func resourceIBMDNSDomainExists(d *schema.ResourceData, meta interface{}) (bool,
 
 	result, err := service.Id(dnsId).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving domain info: %s", err)
 	}
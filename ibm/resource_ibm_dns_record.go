@@ -441,10 +441,8 @@ func resourceIBMDNSRecordExists(d *schema.ResourceData, meta interface{}) (bool,
 
 	record, err := service.Id(id).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving domain record info: %s", err)
 	}
@@ -134,10 +134,8 @@ func resourceIBMDNSREVERSERecordExists(d *schema.ResourceData, meta interface{})
 	}
 	record, err := service.Id(id).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving domain reverse record info: %s", err)
 	}
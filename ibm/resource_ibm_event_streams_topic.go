@@ -36,6 +36,10 @@ var (
 	}
 )
 
+var eventStreamsTopicIDTemplate = newIDTemplate("ibm_event_streams_topic",
+	"{crn}:{version}:{cname}:{ctype}:{service_name}:{location}:{scope}:{service_instance}:{resource_type}:{topic_name}",
+	"crn:v1:bluemix:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:cb5a0252-8b8d-4390-b017-80b743d32839:topic:my-es-topic")
+
 func resourceIBMEventStreamsTopic() *schema.Resource {
 	return &schema.Resource{
 		Exists:   resourceIBMEventStreamsTopicExists,
@@ -43,7 +47,7 @@ func resourceIBMEventStreamsTopic() *schema.Resource {
 		Read:     resourceIBMEventStreamsTopicRead,
 		Update:   resourceIBMEventStreamsTopicUpdate,
 		Delete:   resourceIBMEventStreamsTopicDelete,
-		Importer: &schema.ResourceImporter{},
+		Importer: eventStreamsTopicIDTemplate.importer(),
 		Schema: map[string]*schema.Schema{
 			"resource_instance_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -130,8 +134,11 @@ func resourceIBMEventStreamsTopicRead(d *schema.ResourceData, meta interface{})
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicRead createSaramaAdminClient err %s", err)
 		return err
 	}
-	topicID := d.Id()
-	topicName := getTopicName(topicID)
+	topicID, err := eventStreamsTopicIDTemplate.parse(d.Id())
+	if err != nil {
+		return err
+	}
+	topicName := topicID.get("topic_name")
 	topics, err := adminClient.ListTopics()
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicRead ListTopics err %s", err)
@@ -230,10 +237,13 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if len(instanceCRN) == 0 {
 		topicID := d.Id()
-		if len(topicID) == 0 || !strings.Contains(topicID, ":") {
+		if len(topicID) == 0 {
 			log.Printf("[DEBUG] createSaramaAdminClient resource_instance_id is missing")
 			return nil, "", fmt.Errorf("resource_instance_id is required")
 		}
+		if err := eventStreamsTopicIDTemplate.validate(topicID); err != nil {
+			return nil, "", err
+		}
 		instanceCRN = getInstanceCRN(topicID)
 	}
 	instance, err := rcAPI.GetInstance(instanceCRN)
@@ -270,7 +280,7 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	}
 	clientPool[instanceCRN] = adminClient
 	log.Printf("[INFO] createSaramaAdminClient instance %s 's client is initialized", instanceCRN)
-	return adminClient, instanceCRN, nil
+	return auditKafka(meta, eventStreamsService, brokerAddress, adminClient), instanceCRN, nil
 }
 
 func topicDetail2Config(topicConfigEntries map[string]*string) map[string]*string {
@@ -295,18 +305,32 @@ func config2TopicDetail(config map[string]interface{}) map[string]*string {
 }
 
 func getTopicID(instanceCRN string, topicName string) string {
-	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments := strings.SplitN(instanceCRN, ":", 10)
+	for len(crnSegments) < 10 {
+		crnSegments = append(crnSegments, "")
+	}
 	crnSegments[8] = "topic"
 	crnSegments[9] = topicName
-	return strings.Join(crnSegments, ":")
+	return eventStreamsTopicIDTemplate.format(crnSegments...)
 }
 
+// getTopicName returns the topic name of the topic ID, or an empty string when the ID
+// is invalid
 func getTopicName(topicID string) string {
-	return strings.Split(topicID, ":")[9]
+	id, err := eventStreamsTopicIDTemplate.parse(topicID)
+	if err != nil {
+		return ""
+	}
+	return id.get("topic_name")
 }
 
+// getInstanceCRN returns the CRN of the Event Streams instance of the topic ID, or an
+// empty string when the ID is invalid
 func getInstanceCRN(topicID string) string {
-	crnSegments := strings.Split(topicID, ":")
+	crnSegments, err := eventStreamsTopicIDTemplate.split(topicID)
+	if err != nil {
+		return ""
+	}
 	crnSegments[8] = ""
 	crnSegments[9] = ""
 	return strings.Join(crnSegments, ":")
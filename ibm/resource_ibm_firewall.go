@@ -35,6 +35,10 @@ func resourceIBMFirewall() *schema.Resource {
 		Exists:   resourceIBMFirewallExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"firewall_type": {
 				Type:     schema.TypeString,
@@ -153,7 +157,7 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err)
 	}
-	vlan, _, _, err := findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d)
+	vlan, _, _, err := findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err)
 	}
@@ -274,7 +278,7 @@ func resourceIBMFirewallExists(d *schema.ResourceData, meta interface{}) (bool,
 		GetObject()
 
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+		if isNotFound(err) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving firewall information: %s", err)
@@ -283,7 +287,7 @@ func resourceIBMFirewallExists(d *schema.ResourceData, meta interface{}) (bool,
 	return true, nil
 }
 
-func findDedicatedFirewallByOrderId(sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Vlan, datatypes.Network_Gateway, datatypes.Product_Upgrade_Request, error) {
+func findDedicatedFirewallByOrderId(sess *session.Session, orderId int, d *schema.ResourceData, timeout time.Duration) (datatypes.Network_Vlan, datatypes.Network_Gateway, datatypes.Product_Upgrade_Request, error) {
 	filterPath := "networkVlans.networkVlanFirewall.billingItem.orderItem.order.id"
 	multivlanfilterpath := "networkGateways.networkFirewall.billingItem.orderItem.order.id"
 	var vlans []datatypes.Network_Vlan
@@ -337,7 +341,7 @@ func findDedicatedFirewallByOrderId(sess *session.Session, orderId int, d *schem
 			}
 			return nil, "", fmt.Errorf("Expected one dedicated firewall: %s", err)
 		},
-		Timeout:        2 * time.Hour,
+		Timeout:        timeout,
 		Delay:          10 * time.Second,
 		MinTimeout:     10 * time.Second,
 		NotFoundChecks: 24 * 60,
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -29,6 +30,12 @@ func resourceIBMFirewallPolicy() *schema.Resource {
 		Exists:   resourceIBMFirewallPolicyExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"firewall_id": {
 				Type:        schema.TypeInt,
@@ -173,7 +180,7 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Creating dedicated hardware firewall rules")
 
-	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
+	request, err := services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
 		return fmt.Errorf("Error during creation of dedicated hardware firewall rules: %s", err)
 	}
@@ -181,8 +188,10 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 	d.SetId(strconv.Itoa(fwId))
 
 	log.Printf("[INFO] Firewall rules ID: %s", d.Id())
-	log.Printf("[INFO] Wait one minute for applying the rules.")
-	time.Sleep(time.Minute)
+	err = waitForFirewallUpdateRequest(sess, request, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error waiting for the dedicated hardware firewall rules to be applied: %s", err)
+	}
 
 	return resourceIBMFirewallPolicyRead(d, meta)
 }
@@ -276,11 +285,14 @@ func resourceIBMFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Updating dedicated hardware firewall rules")
 
-	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
+	request, err := services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
 		return fmt.Errorf("Error during updating of dedicated hardware firewall rules: %s", err)
 	}
-	time.Sleep(time.Minute)
+	err = waitForFirewallUpdateRequest(sess, request, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error waiting for the dedicated hardware firewall rules to be applied: %s", err)
+	}
 
 	return resourceIBMFirewallPolicyRead(d, meta)
 }
@@ -311,11 +323,14 @@ func resourceIBMFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Deleting dedicated hardware firewall rules")
 
-	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
+	request, err := services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
 		return fmt.Errorf("Error during deleting of dedicated hardware firewall rules: %s", err)
 	}
-	time.Sleep(time.Minute)
+	err = waitForFirewallUpdateRequest(sess, request, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("Error waiting for the dedicated hardware firewall rules to be removed: %s", err)
+	}
 
 	return nil
 }
@@ -334,10 +349,8 @@ func resourceIBMFirewallPolicyExists(d *schema.ResourceData, meta interface{}) (
 		GetObject()
 
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving firewall rules: %s", err)
 	}
@@ -348,3 +361,34 @@ func resourceIBMFirewallPolicyExists(d *schema.ResourceData, meta interface{}) (
 
 	return true, nil
 }
+
+// waitForFirewallUpdateRequest waits for the rules of the update request to be applied
+// to the firewall
+func waitForFirewallUpdateRequest(sess *session.Session, request datatypes.Network_Firewall_Update_Request, timeout time.Duration) error {
+	if request.Id == nil {
+		return nil
+	}
+	log.Printf("[INFO] Waiting for the firewall update request %d to be applied", *request.Id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"applied"},
+		Refresh: func() (interface{}, string, error) {
+			request, err := services.GetNetworkFirewallUpdateRequestService(sess).
+				Id(*request.Id).
+				Mask("id,applyDate").
+				GetObject()
+			if err != nil {
+				return nil, "", err
+			}
+			if request.ApplyDate == nil {
+				return request, "pending", nil
+			}
+			return request, "applied", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -18,6 +18,8 @@ const (
 	funcActionUsrDefParams = "user_defined_parameters"
 )
 
+var functionActionIDTemplate = newIDTemplate("ibm_function_action", "{namespace}:{name}", "Namespace-01:nodezip")
+
 func resourceIBMFunctionAction() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMFunctionActionCreate,
@@ -25,7 +27,7 @@ func resourceIBMFunctionAction() *schema.Resource {
 		Update:   resourceIBMFunctionActionUpdate,
 		Delete:   resourceIBMFunctionActionDelete,
 		Exists:   resourceIBMFunctionActionExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: functionActionIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			funcActionName: {
@@ -544,7 +546,7 @@ func resourceIBMFunctionActionExists(d *schema.ResourceData, meta interface{}) (
 
 	action, resp, err := actionService.Get(actionID, true)
 	if err != nil {
-		if resp.StatusCode == 404 {
+		if isNotFound(responseError(resp, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with IBM Cloud Function Client : %s", err)
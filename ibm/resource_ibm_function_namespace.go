@@ -118,7 +118,7 @@ func resourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{})
 	}
 	instance, response, err := nsClient.GetNamespace(getOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -188,7 +188,7 @@ func resourceIBMFunctionNamespaceDelete(d *schema.ResourceData, meta interface{}
 		ID: &ID,
 	}
 	response, err := nsClient.DeleteNamespace(delOptions)
-	if err != nil && response.StatusCode != 404 {
+	if err != nil && !isNotFound(responseError(response, err)) {
 		return fmt.Errorf("Error Deleting Namespace: %s\n%s", err, response)
 	}
 
@@ -208,7 +208,7 @@ func resourceIBMFunctionNamespaceExists(d *schema.ResourceData, meta interface{}
 		ID: &ID,
 	}
 	_, response, err := nsClient.GetNamespace(getOptions)
-	if err != nil && response.StatusCode == 404 {
+	if err != nil && isNotFound(responseError(response, err)) {
 		d.SetId("")
 		return false, fmt.Errorf("Error Getting Namesapce (IAM): %s\n%s", err, response)
 	}
@@ -19,6 +19,8 @@ const (
 	funcPkgBindPkgName  = "bind_package_name"
 )
 
+var functionPackageIDTemplate = newIDTemplate("ibm_function_package", "{namespace}:{name}", "Namespace-01:util")
+
 func resourceIBMFunctionPackage() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMFunctionPackageCreate,
@@ -26,7 +28,7 @@ func resourceIBMFunctionPackage() *schema.Resource {
 		Update:   resourceIBMFunctionPackageUpdate,
 		Delete:   resourceIBMFunctionPackageDelete,
 		Exists:   resourceIBMFunctionPackageExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: functionPackageIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			funcPkgNamespace: {
@@ -135,6 +137,13 @@ func resourceIBMFuncPackageValidator() *ResourceValidator {
 			Type:                       TypeString,
 			Default:                    "[]",
 			Optional:                   true})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 funcPkgUsrDefParams,
+			ValidateFunctionIdentifier: ValidateJSONString,
+			Type:                       TypeString,
+			Default:                    "[]",
+			Optional:                   true})
 	validateSchema = append(validateSchema,
 		ValidateSchema{
 			Identifier:                 funcPkgBindPkgName,
@@ -445,7 +454,7 @@ func resourceIBMFunctionPackageExists(d *schema.ResourceData, meta interface{})
 
 	pkg, resp, err := packageService.Get(packageID)
 	if err != nil {
-		if resp.StatusCode == 404 {
+		if isNotFound(responseError(resp, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with IBM Cloud Function Client : %s", err)
@@ -15,6 +15,8 @@ const (
 	funcRuleName      = "name"
 )
 
+var functionRuleIDTemplate = newIDTemplate("ibm_function_rule", "{namespace}:{name}", "Namespace-01:alarmrule")
+
 func resourceIBMFunctionRule() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMFunctionRuleCreate,
@@ -22,7 +24,7 @@ func resourceIBMFunctionRule() *schema.Resource {
 		Update:   resourceIBMFunctionRuleUpdate,
 		Delete:   resourceIBMFunctionRuleDelete,
 		Exists:   resourceIBMFunctionRuleExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: functionRuleIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			funcRuleNamespace: {
@@ -359,7 +361,7 @@ func resourceIBMFunctionRuleExists(d *schema.ResourceData, meta interface{}) (bo
 
 	rule, resp, err := ruleService.Get(ruleID)
 	if err != nil {
-		if resp.StatusCode == 404 {
+		if isNotFound(responseError(resp, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with IBM Cloud Function Client : %s", err)
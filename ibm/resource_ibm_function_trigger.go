@@ -24,6 +24,8 @@ const (
 	feedDelete         = "DELETE"
 )
 
+var functionTriggerIDTemplate = newIDTemplate("ibm_function_trigger", "{namespace}:{name}", "Namespace-01:alarmtrigger")
+
 func resourceIBMFunctionTrigger() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMFunctionTriggerCreate,
@@ -31,7 +33,7 @@ func resourceIBMFunctionTrigger() *schema.Resource {
 		Update:   resourceIBMFunctionTriggerUpdate,
 		Delete:   resourceIBMFunctionTriggerDelete,
 		Exists:   resourceIBMFunctionTriggerExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: functionTriggerIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			funcTriggerNamespace: {
@@ -546,7 +548,7 @@ func resourceIBMFunctionTriggerExists(d *schema.ResourceData, meta interface{})
 	triggerService := wskClient.Triggers
 	trigger, resp, err := triggerService.Get(triggerID)
 	if err != nil {
-		if resp.StatusCode == 404 {
+		if isNotFound(responseError(resp, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with IBM Cloud Function Client : %s", err)
@@ -2,14 +2,14 @@ package ibm
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iamuum/iamuumv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var iamAccessGroupDynamicRuleIDTemplate = newIDTemplate("ibm_iam_access_group_dynamic_rule", "{access_group_id}/{rule_id}", "AccessGroupId-5391772e-1207-45e8-b032-2a21941c11ab/ClaimRule-3c5cd5fd-5b95-45f3-a693-08047eee56b5")
+
 func resourceIBMIAMDynamicRule() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMIAMDynamicRuleCreate,
@@ -17,7 +17,7 @@ func resourceIBMIAMDynamicRule() *schema.Resource {
 		Update:   resourceIBMIAMDynamicRuleUpdate,
 		Delete:   resourceIBMIAMDynamicRuleDelete,
 		Exists:   resourceIBMIAMDynamicRuleExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: iamAccessGroupDynamicRuleIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -131,9 +131,9 @@ func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) err
 	ruleID := parts[1]
 
 	rules, _, err := iamuumClient.DynamicRule().Get(grpID, ruleID)
-	if err != nil && !strings.Contains(err.Error(), "404") {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("Error retrieving access group Rules: %s", err)
-	} else if err != nil && strings.Contains(err.Error(), "404") {
+	} else if err != nil && isNotFound(err) {
 		d.SetId("")
 
 		return nil
@@ -216,7 +216,7 @@ func resourceIBMIAMDynamicRuleDelete(d *schema.ResourceData, meta interface{}) e
 	ruleID := parts[1]
 
 	err = iamuumClient.DynamicRule().Delete(grpID, ruleID)
-	if err != nil && !strings.Contains(err.Error(), "404") {
+	if err != nil && !isNotFound(err) {
 		return err
 	}
 
@@ -240,10 +240,8 @@ func resourceIBMIAMDynamicRuleExists(d *schema.ResourceData, meta interface{}) (
 
 	rules, _, err := iamuumClient.DynamicRule().Get(grpID, ruleID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
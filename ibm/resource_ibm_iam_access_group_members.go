@@ -11,13 +11,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var iamAccessGroupMembersIDTemplate = newIDTemplate("ibm_iam_access_group_members", "{access_group_id}/{timestamp}", "AccessGroupId-5391772e-1207-45e8-b032-2a21941c11ab/2018-10-04 06:27:40.041599641 +0000 UTC")
+
 func resourceIBMIAMAccessGroupMembers() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMIAMAccessGroupMembersCreate,
 		Read:     resourceIBMIAMAccessGroupMembersRead,
 		Update:   resourceIBMIAMAccessGroupMembersUpdate,
 		Delete:   resourceIBMIAMAccessGroupMembersDelete,
-		Importer: &schema.ResourceImporter{},
+		Importer: iamAccessGroupMembersIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
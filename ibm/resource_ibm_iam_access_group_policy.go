@@ -7,11 +7,12 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/models"
 	"github.com/IBM-Cloud/bluemix-go/utils"
 )
 
+var iamAccessGroupPolicyIDTemplate = newIDTemplate("ibm_iam_access_group_policy", "{access_group_id}/{policy_id}", "AccessGroupId-1148204e-6ef2-4ce1-9fd2-05e82a390fcf/bf5d6807-371e-4755-a282-64ebf575b80a")
+
 func resourceIBMIAMAccessGroupPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMIAMAccessGroupPolicyCreate,
@@ -19,7 +20,7 @@ func resourceIBMIAMAccessGroupPolicy() *schema.Resource {
 		Update:   resourceIBMIAMAccessGroupPolicyUpdate,
 		Delete:   resourceIBMIAMAccessGroupPolicyDelete,
 		Exists:   resourceIBMIAMAccessGroupPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: iamAccessGroupPolicyIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -291,10 +292,8 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 
 	accgrpPolicy, err := iampapClient.V1Policy().Get(accgrpPolicyID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -7,7 +7,6 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
 	"github.com/IBM-Cloud/bluemix-go/models"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -258,10 +257,8 @@ func resourceIBMIAMAuthorizationPolicyExists(d *schema.ResourceData, meta interf
 
 	authorizationPolicy, err := iampapClient.V1Policy().Get(d.Id())
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
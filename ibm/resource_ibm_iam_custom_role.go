@@ -2,10 +2,8 @@ package ibm
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -164,9 +162,9 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 	roleID := d.Id()
 
 	role, _, err := iampapv2Client.IAMRoles().Get(roleID)
-	if err != nil && !strings.Contains(err.Error(), "404") {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("Error retrieving Custom Roles: %s", err)
-	} else if err != nil && strings.Contains(err.Error(), "404") {
+	} else if err != nil && isNotFound(err) {
 		d.SetId("")
 
 		return nil
@@ -229,7 +227,7 @@ func resourceIBMIAMCustomRoleDelete(d *schema.ResourceData, meta interface{}) er
 	roleID := d.Id()
 
 	err = iampapv2Client.IAMRoles().Delete(roleID)
-	if err != nil && !strings.Contains(err.Error(), "404") {
+	if err != nil && !isNotFound(err) {
 		return fmt.Errorf("Error deleting Custom Roles: %s", err)
 	}
 
@@ -247,10 +245,8 @@ func resourceIBMIAMCustomRoleExists(d *schema.ResourceData, meta interface{}) (b
 
 	role, _, err := iampapv2Client.IAMRoles().Get(roleID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -189,7 +189,7 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 
 	apiKey, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -274,7 +274,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 
 	_, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -307,7 +307,7 @@ func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{})
 
 	apiKey, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving Service API Key: %s\n%s", err, response)
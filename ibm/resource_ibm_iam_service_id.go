@@ -6,7 +6,6 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/models"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/crn"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -173,10 +172,8 @@ func resourceIBMIAMServiceIDExists(d *schema.ResourceData, meta interface{}) (bo
 
 	serviceID, err := iamClient.ServiceIds().Get(serviceIDUUID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
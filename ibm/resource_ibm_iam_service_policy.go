@@ -6,10 +6,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/models"
 )
 
+var iamServicePolicyIDTemplate = newIDTemplate("ibm_iam_service_policy", "{service_id}/{policy_id}", "ServiceId-d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+
 func resourceIBMIAMServicePolicy() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMIAMServicePolicyCreate,
@@ -17,7 +18,7 @@ func resourceIBMIAMServicePolicy() *schema.Resource {
 		Update:   resourceIBMIAMServicePolicyUpdate,
 		Delete:   resourceIBMIAMServicePolicyDelete,
 		Exists:   resourceIBMIAMServicePolicyExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: iamServicePolicyIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			"iam_service_id": {
@@ -304,10 +305,8 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 
 	servicePolicy, err := iampapClient.V1Policy().Get(servicePolicyID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
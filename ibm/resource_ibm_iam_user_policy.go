@@ -7,9 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 )
 
+var iamUserPolicyIDTemplate = newIDTemplate("ibm_iam_user_policy", "{ibm_id}/{policy_id}", "test@in.ibm.com/9ebf7018-3d0c-4965-9976-ef8e0c38a7e2")
+
 func resourceIBMIAMUserPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMIAMUserPolicyCreate,
@@ -17,7 +18,7 @@ func resourceIBMIAMUserPolicy() *schema.Resource {
 		Update:   resourceIBMIAMUserPolicyUpdate,
 		Delete:   resourceIBMIAMUserPolicyDelete,
 		Exists:   resourceIBMIAMUserPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: iamUserPolicyIDTemplate.importer(),
 		Schema: map[string]*schema.Schema{
 
 			"ibm_id": {
@@ -290,10 +291,8 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 
 	userPolicy, err := iampapClient.V1Policy().Get(userPolicyID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -27,6 +27,10 @@ func resourceIBMIPSecVPN() *schema.Resource {
 		Exists:   resourceIBMIPSecVPNExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"datacenter": {
 				Type:        schema.TypeString,
@@ -233,7 +237,7 @@ func resourceIBMIPSecVpnCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error during Place order for Creating: %s", err)
 	}
-	vpn, _ := findIPSecVpnByOrderID(sess, *receipt.OrderId, d)
+	vpn, _ := findIPSecVpnByOrderID(sess, *receipt.OrderId, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error during creation of IPSec VPN: %s", err)
 	}
@@ -243,7 +247,7 @@ func resourceIBMIPSecVpnCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceIBMIPSecVPNUpdate(d, meta)
 }
 
-func findIPSecVpnByOrderID(sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Tunnel_Module_Context, error) {
+func findIPSecVpnByOrderID(sess *session.Session, orderID int, d *schema.ResourceData, timeout time.Duration) (datatypes.Network_Tunnel_Module_Context, error) {
 	filterPath := "networkTunnelContexts.billingItem.orderItem.order.id"
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
@@ -266,7 +270,7 @@ func findIPSecVpnByOrderID(sess *session.Session, orderID int, d *schema.Resourc
 			}
 			return nil, "", fmt.Errorf("Expected one IPSec VPN: %s", err)
 		},
-		Timeout:        2 * time.Hour,
+		Timeout:        timeout,
 		Delay:          10 * time.Second,
 		MinTimeout:     10 * time.Second,
 		NotFoundChecks: 24 * 60,
@@ -341,7 +345,7 @@ func resourceIBMIPSecVPNExists(d *schema.ResourceData, meta interface{}) (bool,
 		GetObject()
 
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+		if isNotFound(err) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving vpn information: %s", err)
@@ -44,7 +44,7 @@ func resourceIBMISFloatingIP() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -332,7 +332,7 @@ func classicFipGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	floatingip, response, err := sess.GetFloatingIP(getFloatingIPOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -374,7 +374,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	floatingip, response, err := sess.GetFloatingIP(getFloatingIPOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -576,7 +576,7 @@ func classicFipDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	_, response, err := sess.GetFloatingIP(getFloatingIpOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 
@@ -609,7 +609,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetFloatingIP(getFloatingIpOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 
@@ -657,7 +657,7 @@ func classicFipExists(d *schema.ResourceData, meta interface{}, id string) (bool
 	}
 	_, response, err := sess.GetFloatingIP(getFloatingIpOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting floating IP: %s\n%s", err, response)
@@ -675,7 +675,7 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	}
 	_, response, err := sess.GetFloatingIP(getFloatingIpOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting floating IP: %s\n%s", err, response)
@@ -684,18 +684,17 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 }
 
 func isWaitForClassicFloatingIPDeleted(fip *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for FloatingIP (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isFloatingIPPending, isFloatingIPDeleting},
-		Target:     []string{"", isFloatingIPDeleted},
-		Refresh:    isClassicFloatingIPDeleteRefreshFunc(fip, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("floating IP %s to be deleted", id),
+		Pending:         []string{isFloatingIPPending, isFloatingIPDeleting},
+		Target:          []string{"", isFloatingIPDeleted},
+		Status:          refreshStatus(isClassicFloatingIPDeleteRefreshFunc(fip, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicFloatingIPDeleteRefreshFunc(fip *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -706,7 +705,7 @@ func isClassicFloatingIPDeleteRefreshFunc(fip *vpcclassicv1.VpcClassicV1, id str
 		}
 		FloatingIP, response, err := fip.GetFloatingIP(getfipoptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return FloatingIP, isFloatingIPDeleted, nil
 			}
 			return FloatingIP, "", fmt.Errorf("Error Getting Floating IP: %s\n%s", err, response)
@@ -716,18 +715,17 @@ func isClassicFloatingIPDeleteRefreshFunc(fip *vpcclassicv1.VpcClassicV1, id str
 }
 
 func isWaitForFloatingIPDeleted(fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for FloatingIP (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isFloatingIPPending, isFloatingIPDeleting},
-		Target:     []string{"", isFloatingIPDeleted},
-		Refresh:    isFloatingIPDeleteRefreshFunc(fip, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("floating IP %s to be deleted", id),
+		Pending:         []string{isFloatingIPPending, isFloatingIPDeleting},
+		Target:          []string{"", isFloatingIPDeleted},
+		Status:          refreshStatus(isFloatingIPDeleteRefreshFunc(fip, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -738,7 +736,7 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 		}
 		FloatingIP, response, err := fip.GetFloatingIP(getfipoptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return FloatingIP, isFloatingIPDeleted, nil
 			}
 			return FloatingIP, "", fmt.Errorf("Error Getting Floating IP: %s\n%s", err, response)
@@ -748,18 +746,17 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 }
 
 func isWaitForClassicInstanceFloatingIP(floatingipC *vpcclassicv1.VpcClassicV1, id string, d *schema.ResourceData) (interface{}, error) {
-	log.Printf("Waiting for floating IP (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isFloatingIPPending},
-		Target:     []string{isFloatingIPAvailable, ""},
-		Refresh:    isClassicInstanceFloatingIPRefreshFunc(floatingipC, id),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("floating IP %s to be available", id),
+		Pending:         []string{isFloatingIPPending},
+		Target:          []string{isFloatingIPAvailable, ""},
+		Status:          refreshStatus(isClassicInstanceFloatingIPRefreshFunc(floatingipC, id)),
+		Timeout:         d.Timeout(schema.TimeoutCreate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicInstanceFloatingIPRefreshFunc(floatingipC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -781,18 +778,17 @@ func isClassicInstanceFloatingIPRefreshFunc(floatingipC *vpcclassicv1.VpcClassic
 }
 
 func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
-	log.Printf("Waiting for floating IP (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isFloatingIPPending},
-		Target:     []string{isFloatingIPAvailable, ""},
-		Refresh:    isInstanceFloatingIPRefreshFunc(floatingipC, id),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("floating IP %s to be available", id),
+		Pending:         []string{isFloatingIPPending},
+		Target:          []string{isFloatingIPAvailable, ""},
+		Status:          refreshStatus(isInstanceFloatingIPRefreshFunc(floatingipC, id)),
+		Timeout:         d.Timeout(schema.TimeoutCreate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -45,7 +45,7 @@ func resourceIBMISFlowLog() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -377,7 +377,7 @@ func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error
 	}
 	response, err := sess.DeleteFlowLogCollector(delOptions)
 
-	if err != nil && response.StatusCode != 404 {
+	if err != nil && !isNotFound(responseError(response, err)) {
 		return fmt.Errorf("Error deleting flow log collector:%s\n%s", err, response)
 	}
 
@@ -397,10 +397,10 @@ func resourceIBMISFlowLogExists(d *schema.ResourceData, meta interface{}) (bool,
 		ID: &ID,
 	}
 	_, response, err := sess.GetFlowLogCollector(getOptions)
-	if err != nil && response.StatusCode != 404 {
+	if err != nil && !isNotFound(responseError(response, err)) {
 		return false, fmt.Errorf("Error Getting Flow Log Collector : %s\n%s", err, response)
 	}
-	if response.StatusCode == 404 {
+	if isNotFound(responseError(response, err)) {
 		d.SetId("")
 		return false, nil
 	}
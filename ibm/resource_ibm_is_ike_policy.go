@@ -324,7 +324,7 @@ func classicIkepGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	ike, response, err := sess.GetIkePolicy(getikepoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -387,7 +387,7 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	ike, response, err := sess.GetIkePolicy(getikepoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -556,7 +556,7 @@ func classicIkepDelete(d *schema.ResourceData, meta interface{}, id string) erro
 	}
 	_, response, err := sess.GetIkePolicy(getikepoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -585,7 +585,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetIkePolicy(getikepoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -629,7 +629,7 @@ func classicikepExists(d *schema.ResourceData, meta interface{}, id string) (boo
 	}
 	_, response, err := sess.GetIkePolicy(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
@@ -648,7 +648,7 @@ func ikepExists(d *schema.ResourceData, meta interface{}, id string) (bool, erro
 	}
 	_, response, err := sess.GetIkePolicy(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
@@ -51,7 +51,7 @@ func resourceIBMISImage() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -315,18 +315,17 @@ func imgCreate(d *schema.ResourceData, meta interface{}, href, name, operatingSy
 }
 
 func isWaitForClassicImageAvailable(imageC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for image (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isImageProvisioning},
-		Target:     []string{isImageProvisioningDone, ""},
-		Refresh:    isClassicImageRefreshFunc(imageC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("image %s to be available", id),
+		Pending:         []string{"retry", isImageProvisioning},
+		Target:          []string{isImageProvisioningDone, ""},
+		Status:          refreshStatus(isClassicImageRefreshFunc(imageC, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicImageRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -347,18 +346,17 @@ func isClassicImageRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) res
 	}
 }
 func isWaitForImageAvailable(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for image (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isImageProvisioning},
-		Target:     []string{isImageProvisioningDone, ""},
-		Refresh:    isImageRefreshFunc(imageC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("image %s to be available", id),
+		Pending:         []string{"retry", isImageProvisioning},
+		Target:          []string{isImageProvisioningDone, ""},
+		Status:          refreshStatus(isImageRefreshFunc(imageC, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -519,7 +517,7 @@ func classicImgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	image, response, err := sess.GetImage(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -573,7 +571,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	image, response, err := sess.GetImage(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -644,7 +642,7 @@ func classicImgDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	_, response, err := sess.GetImage(getImageOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting Image (%s): %s\n%s", id, err, response)
@@ -675,7 +673,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetImage(getImageOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting Image (%s): %s\n%s", id, err, response)
@@ -697,18 +695,17 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 }
 
 func isWaitForClassicImageDeleted(imageC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for image (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isImageDeleting},
-		Target:     []string{"", isImageDeleted},
-		Refresh:    isClassicImageDeleteRefreshFunc(imageC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("image %s to be deleted", id),
+		Pending:         []string{"retry", isImageDeleting},
+		Target:          []string{"", isImageDeleted},
+		Status:          refreshStatus(isClassicImageDeleteRefreshFunc(imageC, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicImageDeleteRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -719,7 +716,7 @@ func isClassicImageDeleteRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id strin
 		}
 		image, response, err := imageC.GetImage(getimgoptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return image, isImageDeleted, nil
 			}
 			return image, "", fmt.Errorf("Error Getting Image: %s\n%s", err, response)
@@ -728,18 +725,17 @@ func isClassicImageDeleteRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id strin
 	}
 }
 func isWaitForImageDeleted(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for image (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isImageDeleting},
-		Target:     []string{"", isImageDeleted},
-		Refresh:    isImageDeleteRefreshFunc(imageC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("image %s to be deleted", id),
+		Pending:         []string{"retry", isImageDeleting},
+		Target:          []string{"", isImageDeleted},
+		Status:          refreshStatus(isImageDeleteRefreshFunc(imageC, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -750,7 +746,7 @@ func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefr
 		}
 		image, response, err := imageC.GetImage(getimgoptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return image, isImageDeleted, nil
 			}
 			return image, "", fmt.Errorf("Error Getting Image: %s\n%s", err, response)
@@ -784,7 +780,7 @@ func classicImgExists(d *schema.ResourceData, meta interface{}, id string) (bool
 	}
 	_, response, err := sess.GetImage(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Image: %s\n%s", err, response)
@@ -802,7 +798,7 @@ func imgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	}
 	_, response, err := sess.GetImage(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Image: %s\n%s", err, response)
@@ -101,7 +101,7 @@ func resourceIBMISInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -860,39 +860,39 @@ func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func isWaitForClassicInstanceAvailable(instanceC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
-	log.Printf("Waiting for instance (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isInstanceProvisioning},
-		Target:     []string{isInstanceStatusRunning, "available", "failed", ""},
-		Refresh:    isClassicInstanceRefreshFunc(instanceC, id, d),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-
-	return stateConf.WaitForState()
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("instance %s to be available", id),
+		Pending:         []string{"retry", isInstanceProvisioning},
+		Target:          []string{isInstanceStatusRunning, "available", ""},
+		Failed:          []string{"failed"},
+		Status:          refreshStatus(isClassicInstanceRefreshFunc(instanceC, id, d)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+	}
+
+	return waiter.wait()
 }
 
 func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
-	log.Printf("Waiting for instance (%s) to be available.", id)
-
 	communicator := make(chan interface{})
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isInstanceProvisioning},
-		Target:     []string{isInstanceStatusRunning, "available", "failed", ""},
-		Refresh:    isInstanceRefreshFunc(instanceC, id, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("instance %s to be available", id),
+		Pending:         []string{"retry", isInstanceProvisioning},
+		Target:          []string{isInstanceStatusRunning, "available", ""},
+		Failed:          []string{"failed"},
+		Status:          refreshStatus(isInstanceRefreshFunc(instanceC, id, d, communicator)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
 		go isRestartStartAction(instanceC, id, d, forceTimeout, communicator)
 	}
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicInstanceRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -1011,7 +1011,7 @@ func classicInstanceGet(d *schema.ResourceData, meta interface{}, id string) err
 	}
 	instance, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1190,7 +1190,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	instance, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1883,7 +1883,7 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 	}
 	_, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1896,7 +1896,7 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 	}
 	_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
@@ -1963,7 +1963,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1976,7 +1976,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
@@ -2065,7 +2065,7 @@ func classicInstanceExists(d *schema.ResourceData, meta interface{}, id string)
 	}
 	_, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
@@ -2083,7 +2083,7 @@ func instanceExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 	}
 	_, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
@@ -2108,16 +2108,17 @@ func resourceIBMisInstanceExists(d *schema.ResourceData, meta interface{}) (bool
 
 func isWaitForClassicInstanceDelete(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceDeleting, isInstanceAvailable},
-		Target:  []string{isInstanceDeleteDone, ""},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("instance %s to be deleted", id),
+		Pending:     []string{isInstanceDeleting, isInstanceAvailable},
+		Target:      []string{isInstanceDeleteDone, ""},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			getinsoptions := &vpcclassicv1.GetInstanceOptions{
 				ID: &id,
 			}
 			instance, response, err := instanceC.GetInstance(getinsoptions)
 			if err != nil {
-				if response != nil && response.StatusCode == 404 {
+				if isNotFound(responseError(response, err)) {
 					return instance, isInstanceDeleteDone, nil
 				}
 				return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
@@ -2126,27 +2127,28 @@ func isWaitForClassicInstanceDelete(instanceC *vpcclassicv1.VpcClassicV1, d *sch
 				return instance, *instance.Status, fmt.Errorf("The  instance %s failed to delete: %v", d.Id(), err)
 			}
 			return instance, isInstanceDeleting, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutDelete),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceDeleting, isInstanceAvailable},
-		Target:  []string{isInstanceDeleteDone, ""},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("instance %s to be deleted", id),
+		Pending:     []string{isInstanceDeleting, isInstanceAvailable},
+		Target:      []string{isInstanceDeleteDone, ""},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			getinsoptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
 			instance, response, err := instanceC.GetInstance(getinsoptions)
 			if err != nil {
-				if response != nil && response.StatusCode == 404 {
+				if isNotFound(responseError(response, err)) {
 					return instance, isInstanceDeleteDone, nil
 				}
 				return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
@@ -2155,20 +2157,22 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 				return instance, *instance.Status, fmt.Errorf("The  instance %s failed to delete: %v", d.Id(), err)
 			}
 			return instance, isInstanceDeleting, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutDelete),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 func isWaitForClassicInstanceActionStop(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, meta interface{}, id string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
-		Target:  []string{isInstanceActionStatusStopped, isInstanceStatusFailed, ""},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("instance %s to be stopped", id),
+		Pending:     []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
+		Target:      []string{isInstanceActionStatusStopped, ""},
+		Failed:      []string{isInstanceStatusFailed},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			getinsoptions := &vpcclassicv1.GetInstanceOptions{
 				ID: &id,
 			}
@@ -2180,20 +2184,22 @@ func isWaitForClassicInstanceActionStop(instanceC *vpcclassicv1.VpcClassicV1, d
 				return instance, *instance.Status, fmt.Errorf("The  instance %s failed to stop: %v", d.Id(), err)
 			}
 			return instance, *instance.Status, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutDelete),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
-		Target:  []string{isInstanceActionStatusStopped, isInstanceStatusFailed, ""},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("instance %s to be stopped", id),
+		Pending:     []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
+		Target:      []string{isInstanceActionStatusStopped, ""},
+		Failed:      []string{isInstanceStatusFailed},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			getinsoptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
@@ -2213,10 +2219,10 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 				return instance, *instance.Status, fmt.Errorf("The  instance %s failed to stop: %v", id, err)
 			}
 			return instance, *instance.Status, nil
-		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
@@ -2224,7 +2230,7 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
@@ -2255,18 +2261,17 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 }
 
 func isWaitForClassicInstanceVolumeAttached(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
-	log.Printf("Waiting for instance volume (%s) to be attched.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isInstanceVolumeAttaching},
-		Target:     []string{isInstanceVolumeAttached, ""},
-		Refresh:    isClassicInstanceVolumeRefreshFunc(instanceC, id, volID),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("volume %s to be attached to the instance %s", volID, id),
+		Pending:         []string{isInstanceVolumeAttaching},
+		Target:          []string{isInstanceVolumeAttached, ""},
+		Status:          refreshStatus(isClassicInstanceVolumeRefreshFunc(instanceC, id, volID)),
+		Timeout:         d.Timeout(schema.TimeoutUpdate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicInstanceVolumeRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id, volID string) resource.StateRefreshFunc {
@@ -2289,18 +2294,17 @@ func isClassicInstanceVolumeRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id
 }
 
 func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
-	log.Printf("Waiting for instance volume (%s) to be attched.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isInstanceVolumeAttaching},
-		Target:     []string{isInstanceVolumeAttached, ""},
-		Refresh:    isInstanceVolumeRefreshFunc(instanceC, id, volID),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("volume %s to be attached to the instance %s", volID, id),
+		Pending:         []string{isInstanceVolumeAttaching},
+		Target:          []string{isInstanceVolumeAttached, ""},
+		Status:          refreshStatus(isInstanceVolumeRefreshFunc(instanceC, id, volID)),
+		Timeout:         d.Timeout(schema.TimeoutUpdate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resource.StateRefreshFunc {
@@ -2324,17 +2328,18 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 
 func isWaitForClassicInstanceVolumeDetached(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
-		Target:  []string{isInstanceDeleteDone, ""},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("volume %s to be detached from the instance %s", volID, id),
+		Pending:     []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
+		Target:      []string{isInstanceDeleteDone, ""},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			getvolattoptions := &vpcclassicv1.GetInstanceVolumeAttachmentOptions{
 				InstanceID: &id,
 				ID:         &volID,
 			}
 			vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
 			if err != nil {
-				if response != nil && response.StatusCode == 404 {
+				if isNotFound(responseError(response, err)) {
 					return vol, isInstanceDeleteDone, nil
 				}
 				return nil, "", fmt.Errorf("Error Detaching volume: %s\n%s", err, response)
@@ -2343,28 +2348,29 @@ func isWaitForClassicInstanceVolumeDetached(instanceC *vpcclassicv1.VpcClassicV1
 				return vol, *vol.Status, fmt.Errorf("The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
 			}
 			return vol, isInstanceVolumeDetaching, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutUpdate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
-		Target:  []string{isInstanceDeleteDone, ""},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("volume %s to be detached from the instance %s", volID, id),
+		Pending:     []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
+		Target:      []string{isInstanceDeleteDone, ""},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			getvolattoptions := &vpcv1.GetInstanceVolumeAttachmentOptions{
 				InstanceID: &id,
 				ID:         &volID,
 			}
 			vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
 			if err != nil {
-				if response != nil && response.StatusCode == 404 {
+				if isNotFound(responseError(response, err)) {
 					return vol, isInstanceDeleteDone, nil
 				}
 				return nil, "", fmt.Errorf("Error Detaching: %s\n%s", err, response)
@@ -2373,11 +2379,11 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 				return vol, *vol.Status, fmt.Errorf("The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
 			}
 			return vol, isInstanceVolumeDetaching, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutUpdate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
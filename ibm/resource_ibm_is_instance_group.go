@@ -8,7 +8,6 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -32,7 +31,7 @@ func resourceIBMISInstanceGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -343,7 +342,7 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 	instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -416,7 +415,7 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	deleteInstanceGroupOptions := vpcv1.DeleteInstanceGroupOptions{ID: &instanceGroupID}
 	response, Err := sess.DeleteInstanceGroup(&deleteInstanceGroupOptions)
 	if Err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, Err)) {
 			d.SetId("")
 			return nil
 		}
@@ -439,7 +438,7 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 	_, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error Getting InstanceGroup: %s\n%s", err, response)
@@ -456,12 +455,12 @@ func waitForHealthyInstanceGroup(d *schema.ResourceData, meta interface{}, timeo
 	instanceGroupID := d.Id()
 	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 
-	healthStateConf := &resource.StateChangeConf{
-		Pending: []string{SCALING},
-		Target:  []string{HEALTHY},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("instance group %s to be healthy", instanceGroupID),
+		Pending:     []string{SCALING},
+		Target:      []string{HEALTHY},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
-			log.Println("Status : ", *instanceGroup.Status)
 			if err != nil {
 				return nil, SCALING, fmt.Errorf("Error Getting InstanceGroup: %s\n%s", err, response)
 			}
@@ -469,34 +468,35 @@ func waitForHealthyInstanceGroup(d *schema.ResourceData, meta interface{}, timeo
 				return instanceGroup, SCALING, nil
 			}
 			return instanceGroup, *instanceGroup.Status, nil
-		},
-		Timeout:      timeout,
-		Delay:        20 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 10 * time.Second,
+		}),
+		Timeout:         timeout,
+		Delay:           20 * time.Second,
+		MinPollInterval: 10 * time.Second,
+		MaxPollInterval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return waiter.wait()
 
 }
 
 func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
-	healthStateConf := &resource.StateChangeConf{
-		Pending: []string{HEALTHY},
-		Target:  []string{DELETING},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("instance group %s to be deleted", d.Id()),
+		Pending:     []string{HEALTHY},
+		Target:      []string{DELETING},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			resp, err := resourceIBMISInstanceGroupExists(d, meta)
 			if resp {
 				return resp, HEALTHY, nil
 			}
 			return resp, DELETING, err
-		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        20 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutDelete),
+		Delay:           20 * time.Second,
+		MinPollInterval: 10 * time.Second,
+		MaxPollInterval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return waiter.wait()
 
 }
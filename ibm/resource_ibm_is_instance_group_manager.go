@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var isInstanceGroupManagerIDTemplate = newIDTemplate("ibm_is_instance_group_manager", "{instance_group_id}/{manager_id}", "r006-eea6b0b7-babd-47a8-82c5-ad73d1e10bef/r006-160b9a68-58c8-4ec3-84b0-ad553ccb1e5a")
+
 func resourceIBMISInstanceGroupManager() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISInstanceGroupManagerCreate,
@@ -14,7 +16,7 @@ func resourceIBMISInstanceGroupManager() *schema.Resource {
 		Update:   resourceIBMISInstanceGroupManagerUpdate,
 		Delete:   resourceIBMISInstanceGroupManagerDelete,
 		Exists:   resourceIBMISInstanceGroupManagerExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isInstanceGroupManagerIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 
@@ -290,7 +292,7 @@ func resourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interfac
 	}
 	instanceGroupManager, response, err := sess.GetInstanceGroupManager(&getInstanceGroupManagerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -335,7 +337,7 @@ func resourceIBMISInstanceGroupManagerDelete(d *schema.ResourceData, meta interf
 	}
 	response, err := sess.DeleteInstanceGroupManager(&deleteInstanceGroupManagerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -364,7 +366,7 @@ func resourceIBMISInstanceGroupManagerExists(d *schema.ResourceData, meta interf
 
 	_, response, err := sess.GetInstanceGroupManager(&getInstanceGroupManagerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error Getting InstanceGroup Manager: %s\n%s", err, response)
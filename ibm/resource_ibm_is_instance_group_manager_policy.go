@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var isInstanceGroupManagerPolicyIDTemplate = newIDTemplate("ibm_is_instance_group_manager_policy", "{instance_group_id}/{manager_id}/{policy_id}", "r006-eea6b0b7-babd-47a8-82c5-ad73d1e10bef/r006-160b9a68-58c8-4ec3-84b0-ad553ccb1e5a/r006-94d99d1d-be65-4939-9006-1a1a767245b5")
+
 func resourceIBMISInstanceGroupManagerPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISInstanceGroupManagerPolicyCreate,
@@ -14,7 +16,7 @@ func resourceIBMISInstanceGroupManagerPolicy() *schema.Resource {
 		Update:   resourceIBMISInstanceGroupManagerPolicyUpdate,
 		Delete:   resourceIBMISInstanceGroupManagerPolicyDelete,
 		Exists:   resourceIBMISInstanceGroupManagerPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isInstanceGroupManagerPolicyIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 
@@ -206,7 +208,7 @@ func resourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta in
 	}
 	data, response, err := sess.GetInstanceGroupManagerPolicy(&getInstanceGroupManagerPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -244,7 +246,7 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta
 	}
 	response, err := sess.DeleteInstanceGroupManagerPolicy(&deleteInstanceGroupManagerPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -275,7 +277,7 @@ func resourceIBMISInstanceGroupManagerPolicyExists(d *schema.ResourceData, meta
 
 	_, response, err := sess.GetInstanceGroupManagerPolicy(&getInstanceGroupManagerPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error Getting InstanceGroup Manager Policy: %s\n%s", err, response)
@@ -50,7 +50,7 @@ func resourceIBMISInstanceTemplate() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -715,7 +715,7 @@ func instanceTemplateExists(d *schema.ResourceData, meta interface{}, ID string)
 	}
 	_, response, err := instanceC.GetInstanceTemplate(getinsOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error Getting InstanceTemplate: %s\n%s", err, response)
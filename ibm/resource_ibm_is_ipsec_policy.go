@@ -304,7 +304,7 @@ func classicIpsecpGet(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	ipSec, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -368,7 +368,7 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	ipSec, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -535,7 +535,7 @@ func classicIpsecpDelete(d *schema.ResourceData, meta interface{}, id string) er
 	}
 	_, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -563,7 +563,7 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -606,7 +606,7 @@ func classicIpsecpExists(d *schema.ResourceData, meta interface{}, id string) (b
 	}
 	_, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
@@ -624,7 +624,7 @@ func ipsecpExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 	}
 	_, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
@@ -14,23 +14,24 @@ import (
 )
 
 const (
-	isLBName             = "name"
-	isLBStatus           = "status"
-	isLBTags             = "tags"
-	isLBType             = "type"
-	isLBSubnets          = "subnets"
-	isLBHostName         = "hostname"
-	isLBPublicIPs        = "public_ips"
-	isLBPrivateIPs       = "private_ips"
-	isLBListeners        = "listeners"
-	isLBPools            = "pools"
-	isLBOperatingStatus  = "operating_status"
-	isLBDeleting         = "deleting"
-	isLBDeleted          = "done"
-	isLBProvisioning     = "provisioning"
-	isLBProvisioningDone = "done"
-	isLBResourceGroup    = "resource_group"
-	isLBProfile          = "profile"
+	isLBName               = "name"
+	isLBStatus             = "status"
+	isLBTags               = "tags"
+	isLBType               = "type"
+	isLBSubnets            = "subnets"
+	isLBHostName           = "hostname"
+	isLBPublicIPs          = "public_ips"
+	isLBPrivateIPs         = "private_ips"
+	isLBListeners          = "listeners"
+	isLBPools              = "pools"
+	isLBOperatingStatus    = "operating_status"
+	isLBDeleting           = "deleting"
+	isLBDeleted            = "done"
+	isLBProvisioning       = "provisioning"
+	isLBProvisioningDone   = "done"
+	isLBProvisioningFailed = "failed"
+	isLBResourceGroup      = "resource_group"
+	isLBProfile            = "profile"
 )
 
 func resourceIBMISLB() *schema.Resource {
@@ -49,7 +50,7 @@ func resourceIBMISLB() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -356,7 +357,7 @@ func classicLBGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -430,7 +431,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -645,7 +646,7 @@ func classicLBDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	_, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -678,7 +679,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -701,18 +702,18 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 }
 
 func isWaitForClassicLBDeleted(lbc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for  (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBDeleting},
-		Target:     []string{isLBDeleted, "failed"},
-		Refresh:    isClassicLBDeleteRefreshFunc(lbc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-
-	return stateConf.WaitForState()
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer %s to be deleted", id),
+		Pending:         []string{"retry", isLBDeleting},
+		Target:          []string{isLBDeleted},
+		Failed:          []string{"failed"},
+		Status:          refreshStatus(isClassicLBDeleteRefreshFunc(lbc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+	}
+
+	return waiter.wait()
 }
 
 func isClassicLBDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -723,7 +724,7 @@ func isClassicLBDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, id string) res
 		}
 		lb, response, err := lbc.GetLoadBalancer(getLoadBalancerOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return lb, isLBDeleted, nil
 			}
 			return nil, "failed", fmt.Errorf("The vpc load balancer %s failed to delete: %s\n%s", id, err, response)
@@ -733,18 +734,18 @@ func isClassicLBDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, id string) res
 }
 
 func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for  (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBDeleting},
-		Target:     []string{isLBDeleted, "failed"},
-		Refresh:    isLBDeleteRefreshFunc(lbc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-
-	return stateConf.WaitForState()
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer %s to be deleted", id),
+		Pending:         []string{"retry", isLBDeleting},
+		Target:          []string{isLBDeleted},
+		Failed:          []string{"failed"},
+		Status:          refreshStatus(isLBDeleteRefreshFunc(lbc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+	}
+
+	return waiter.wait()
 }
 
 func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -755,7 +756,7 @@ func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFun
 		}
 		lb, response, err := lbc.GetLoadBalancer(getLoadBalancerOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return lb, isLBDeleted, nil
 			}
 			return nil, "failed", fmt.Errorf("The vpc load balancer %s failed to delete: %s\n%s", id, err, response)
@@ -789,7 +790,7 @@ func classicLBExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 	}
 	_, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting vpc load balancer: %s\n%s", err, response)
@@ -807,7 +808,7 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 	}
 	_, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting vpc load balancer: %s\n%s", err, response)
@@ -816,18 +817,18 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 }
 
 func isWaitForLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBProvisioning},
-		Target:     []string{isLBProvisioningDone, ""},
-		Refresh:    isLBRefreshFunc(sess, lbId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-
-	return stateConf.WaitForState()
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer %s to be available", lbId),
+		Pending:         []string{"retry", isLBProvisioning},
+		Target:          []string{isLBProvisioningDone, ""},
+		Failed:          []string{isLBProvisioningFailed},
+		Status:          refreshStatus(isLBRefreshFunc(sess, lbId)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+	}
+
+	return waiter.wait()
 }
 
 func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
@@ -841,7 +842,10 @@ func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
 			return nil, "", fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
 		}
 
-		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
+		if *lb.ProvisioningStatus == isLBProvisioningFailed {
+			return lb, isLBProvisioningFailed, nil
+		}
+		if *lb.ProvisioningStatus == "active" {
 			return lb, isLBProvisioningDone, nil
 		}
 
@@ -850,18 +854,18 @@ func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
 }
 
 func isWaitForClassicLBAvailable(sess *vpcclassicv1.VpcClassicV1, lbId string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBProvisioning},
-		Target:     []string{isLBProvisioningDone, ""},
-		Refresh:    isClassicLBRefreshFunc(sess, lbId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-
-	return stateConf.WaitForState()
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer %s to be available", lbId),
+		Pending:         []string{"retry", isLBProvisioning},
+		Target:          []string{isLBProvisioningDone, ""},
+		Failed:          []string{isLBProvisioningFailed},
+		Status:          refreshStatus(isClassicLBRefreshFunc(sess, lbId)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+	}
+
+	return waiter.wait()
 }
 
 func isClassicLBRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId string) resource.StateRefreshFunc {
@@ -875,7 +879,10 @@ func isClassicLBRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId string) resour
 			return nil, "", fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
 		}
 
-		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
+		if *lb.ProvisioningStatus == isLBProvisioningFailed {
+			return lb, isLBProvisioningFailed, nil
+		}
+		if *lb.ProvisioningStatus == "active" {
 			return lb, isLBProvisioningDone, nil
 		}
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -27,6 +28,9 @@ const (
 	isLBListenerID                  = "listener_id"
 )
 
+var isLBListenerIDTemplate = newIDTemplate("ibm_is_lb_listener", "{lb_id}/{listener_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+var isLBListenerLock = newParentLock("ibm_is_lb_listener", "load_balancer")
+
 func resourceIBMISLBListener() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISLBListenerCreate,
@@ -34,7 +38,7 @@ func resourceIBMISLBListener() *schema.Resource {
 		Update:   resourceIBMISLBListenerUpdate,
 		Delete:   resourceIBMISLBListenerDelete,
 		Exists:   resourceIBMISLBListenerExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isLBListenerIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -169,9 +173,8 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 		connLimit = int64(limit.(int))
 	}
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBListenerLock.lock(lbID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classicLBListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, port, connLimit)
@@ -216,7 +219,12 @@ func classicLBListenerCreate(d *schema.ResourceData, meta interface{}, lbID, pro
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	lbListener, response, err := sess.CreateLoadBalancerListener(options)
+	var lbListener *vpcclassicv1.LoadBalancerListener
+	var response *core.DetailedResponse
+	err = isLBListenerLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		lbListener, response, err = sess.CreateLoadBalancerListener(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error while creating Load Balanacer Listener err %s\n%s", err, response)
 	}
@@ -265,7 +273,12 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	lbListener, response, err := sess.CreateLoadBalancerListener(options)
+	var lbListener *vpcv1.LoadBalancerListener
+	var response *core.DetailedResponse
+	err = isLBListenerLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		lbListener, response, err = sess.CreateLoadBalancerListener(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error while creating Load Balanacer Listener err %s\n%s", err, response)
 	}
@@ -286,18 +299,17 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 }
 
 func isWaitForClassicLBListenerAvailable(sess *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for load balancer Listener(%s) to be available.", lbListenerID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerProvisioningDone, ""},
-		Refresh:    isClassicLBListenerRefreshFunc(sess, lbID, lbListenerID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("listener %s of the load balancer %s to be available", lbListenerID, lbID),
+		Pending:         []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:          []string{isLBListenerProvisioningDone, ""},
+		Status:          refreshStatus(isClassicLBListenerRefreshFunc(sess, lbID, lbListenerID)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicLBListenerRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -321,18 +333,17 @@ func isClassicLBListenerRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbID, lbLis
 }
 
 func isWaitForLBListenerAvailable(sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for load balancer Listener(%s) to be available.", lbListenerID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerProvisioningDone, ""},
-		Refresh:    isLBListenerRefreshFunc(sess, lbID, lbListenerID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("listener %s of the load balancer %s to be available", lbListenerID, lbID),
+		Pending:         []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:          []string{isLBListenerProvisioningDone, ""},
+		Status:          refreshStatus(isLBListenerRefreshFunc(sess, lbID, lbListenerID)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -395,7 +406,7 @@ func classicLBListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbList
 	}
 	lbListener, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -437,7 +448,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	}
 	lbListener, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -555,16 +566,19 @@ func classicLBListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbL
 		}
 		updateLoadBalancerListenerOptions.LoadBalancerListenerPatch = loadBalancerListenerPatch
 
-		isLBKey := "load_balancer_key_" + lbID
-		ibmMutexKV.Lock(isLBKey)
-		defer ibmMutexKV.Unlock(isLBKey)
+		unlock := isLBListenerLock.lock(lbID)
+		defer unlock()
 
 		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
-		_, response, err := sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
+		var response *core.DetailedResponse
+		err = isLBListenerLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating Load Balancer Listener : %s\n%s", err, response)
 		}
@@ -643,16 +657,19 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		}
 		updateLoadBalancerListenerOptions.LoadBalancerListenerPatch = loadBalancerListenerPatch
 
-		isLBKey := "load_balancer_key_" + lbID
-		ibmMutexKV.Lock(isLBKey)
-		defer ibmMutexKV.Unlock(isLBKey)
+		unlock := isLBListenerLock.lock(lbID)
+		defer unlock()
 
 		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
-		_, response, err := sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
+		var response *core.DetailedResponse
+		err = isLBListenerLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating Load Balancer Listener : %s\n%s", err, response)
 		}
@@ -685,9 +702,8 @@ func resourceIBMISLBListenerDelete(d *schema.ResourceData, meta interface{}) err
 	lbID := parts[0]
 	lbListenerID := parts[1]
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBListenerLock.lock(lbID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classicLBListenerDelete(d, meta, lbID, lbListenerID)
@@ -715,7 +731,7 @@ func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbL
 	_, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -730,7 +746,10 @@ func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbL
 		LoadBalancerID: &lbID,
 		ID:             &lbListenerID,
 	}
-	response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
+	err = isLBListenerLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
@@ -760,7 +779,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	_, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -775,7 +794,10 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		LoadBalancerID: &lbID,
 		ID:             &lbListenerID,
 	}
-	response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
+	err = isLBListenerLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
@@ -794,18 +816,17 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 }
 
 func isWaitForClassicLBListenerDeleted(lbc *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for  (%s) to be deleted.", lbListenerID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerDeleting, "delete_pending"},
-		Target:     []string{isLBListenerDeleted, ""},
-		Refresh:    isClassicLBListenerDeleteRefreshFunc(lbc, lbID, lbListenerID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("listener %s of the load balancer %s to be deleted", lbListenerID, lbID),
+		Pending:         []string{"retry", isLBListenerDeleting, "delete_pending"},
+		Target:          []string{isLBListenerDeleted, ""},
+		Status:          refreshStatus(isClassicLBListenerDeleteRefreshFunc(lbc, lbID, lbListenerID)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicLBListenerDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -816,7 +837,7 @@ func isClassicLBListenerDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID,
 		}
 		lbLis, response, err := lbc.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return lbLis, isLBListenerDeleted, nil
 			}
 			return nil, "", fmt.Errorf("The vpc load balancer listener %s failed to delete: %s\n%s", lbListenerID, err, response)
@@ -826,18 +847,17 @@ func isClassicLBListenerDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID,
 }
 
 func isWaitForLBListenerDeleted(lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for  (%s) to be deleted.", lbListenerID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerDeleting, "delete_pending"},
-		Target:     []string{isLBListenerDeleted, ""},
-		Refresh:    isLBListenerDeleteRefreshFunc(lbc, lbID, lbListenerID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("listener %s of the load balancer %s to be deleted", lbListenerID, lbID),
+		Pending:         []string{"retry", isLBListenerDeleting, "delete_pending"},
+		Target:          []string{isLBListenerDeleted, ""},
+		Status:          refreshStatus(isLBListenerDeleteRefreshFunc(lbc, lbID, lbListenerID)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -848,7 +868,7 @@ func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string)
 		}
 		lbLis, response, err := lbc.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return lbLis, isLBListenerDeleted, nil
 			}
 			return nil, "", fmt.Errorf("The vpc load balancer listener %s failed to delete: %s\n%s", lbListenerID, err, response)
@@ -892,7 +912,7 @@ func classicLBListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbL
 	}
 	_, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Load balancer Listener: %s\n%s", err, response)
@@ -912,7 +932,7 @@ func lbListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	}
 	_, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Load balancer Listener: %s\n%s", err, response)
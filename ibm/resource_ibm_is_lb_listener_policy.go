@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -40,6 +41,9 @@ const (
 	isLBListenerPolicyProvisioningDone     = "done"
 )
 
+var isLBListenerPolicyIDTemplate = newIDTemplate("ibm_is_lb_listener_policy", "{lb_id}/{listener_id}/{policy_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb/2161a3fb-123c-4a33-9a3d-b3154ef42009")
+var isLBListenerPolicyLock = newParentLock("ibm_is_lb_listener_policy", "load_balancer")
+
 func resourceIBMISLBListenerPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISLBListenerPolicyCreate,
@@ -47,7 +51,7 @@ func resourceIBMISLBListenerPolicy() *schema.Resource {
 		Update:   resourceIBMISLBListenerPolicyUpdate,
 		Delete:   resourceIBMISLBListenerPolicyDelete,
 		Exists:   resourceIBMISLBListenerPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isLBListenerPolicyIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -400,9 +404,8 @@ func classicLbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbI
 		Rules:          rulesInfo,
 	}
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBListenerPolicyLock.lock(lbID)
+	defer unlock()
 
 	_, err = isWaitForClassicLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -410,7 +413,12 @@ func classicLbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbI
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	policy, response, err := sess.CreateLoadBalancerListenerPolicy(options)
+	var policy *vpcclassicv1.LoadBalancerListenerPolicy
+	var response *core.DetailedResponse
+	err = isLBListenerPolicyLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		policy, response, err = sess.CreateLoadBalancerListenerPolicy(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
 	}
@@ -439,16 +447,18 @@ func getPoolID(id string) (string, error) {
 
 func isWaitForClassicLbAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBProvisioningDone},
-		Refresh:    isLbClassicRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer %s to be available", id),
+		Pending:         []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:          []string{isLBProvisioningDone},
+		Failed:          []string{isLBListenerPolicyFailed},
+		Status:          refreshStatus(isLbClassicRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLbClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -463,7 +473,10 @@ func isLbClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.
 			return nil, "", err
 		}
 
-		if *(lb.ProvisioningStatus) == isLBListenerPolicyAvailable || *lb.ProvisioningStatus == isLBListenerPolicyFailed {
+		if *(lb.ProvisioningStatus) == isLBListenerPolicyFailed {
+			return lb, isLBListenerPolicyFailed, nil
+		}
+		if *(lb.ProvisioningStatus) == isLBListenerPolicyAvailable {
 			return lb, isLBProvisioningDone, nil
 		}
 
@@ -473,16 +486,18 @@ func isLbClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.
 
 func isWaitForClassicLbListenerPolicyAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerPolicyProvisioningDone},
-		Refresh:    isLbListenerPolicyClassicRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer listener policy %s to be available", id),
+		Pending:         []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:          []string{isLBListenerPolicyProvisioningDone},
+		Failed:          []string{isLBListenerPolicyFailed},
+		Status:          refreshStatus(isLbListenerPolicyClassicRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLbListenerPolicyClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -508,7 +523,10 @@ func isLbListenerPolicyClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id str
 			return policy, "", err
 		}
 
-		if *policy.ProvisioningStatus == isLBListenerPolicyAvailable || *policy.ProvisioningStatus == isLBListenerPolicyFailed {
+		if *policy.ProvisioningStatus == isLBListenerPolicyFailed {
+			return policy, isLBListenerPolicyFailed, nil
+		}
+		if *policy.ProvisioningStatus == isLBListenerPolicyAvailable {
 			return policy, isLBListenerProvisioningDone, nil
 		}
 
@@ -616,9 +634,8 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 		Rules:          rulesInfo,
 	}
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBListenerPolicyLock.lock(lbID)
+	defer unlock()
 
 	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -626,7 +643,12 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	policy, response, err := sess.CreateLoadBalancerListenerPolicy(options)
+	var policy *vpcv1.LoadBalancerListenerPolicy
+	var response *core.DetailedResponse
+	err = isLBListenerPolicyLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		policy, response, err = sess.CreateLoadBalancerListenerPolicy(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
 	}
@@ -642,16 +664,17 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 
 func isWaitForLbAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyPending},
-		Target:     []string{isLBProvisioningDone},
-		Refresh:    isLbRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer %s to be available", id),
+		Pending:         []string{isLBListenerPolicyPending},
+		Target:          []string{isLBProvisioningDone},
+		Status:          refreshStatus(isLbRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -676,16 +699,18 @@ func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 
 func isWaitForLbListenerPolicyAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerProvisioningDone},
-		Refresh:    isLbListenerPolicyRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer listener policy %s to be available", id),
+		Pending:         []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:          []string{isLBListenerProvisioningDone},
+		Failed:          []string{isLBListenerPolicyFailed},
+		Status:          refreshStatus(isLbListenerPolicyRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -712,7 +737,10 @@ func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRe
 			return policy, "", err
 		}
 
-		if *policy.ProvisioningStatus == isLBListenerPolicyAvailable || *policy.ProvisioningStatus == isLBListenerPolicyFailed {
+		if *policy.ProvisioningStatus == isLBListenerPolicyFailed {
+			return policy, isLBListenerPolicyFailed, nil
+		}
+		if *policy.ProvisioningStatus == isLBListenerPolicyAvailable {
 			return policy, isLBListenerProvisioningDone, nil
 		}
 
@@ -793,7 +821,7 @@ func classicLbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID
 	_, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Load balancer policy: %s\n%s", err, response)
@@ -826,7 +854,7 @@ func lbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID string)
 	_, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Load balancer policy: %s\n%s", err, response)
@@ -939,9 +967,8 @@ func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbI
 		}
 	}
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBListenerPolicyLock.lock(lbID)
+	defer unlock()
 
 	if hasChanged {
 		loadBalancerListenerPolicyPatch, err := loadBalancerListenerPolicyPatchModel.AsPatch()
@@ -954,7 +981,11 @@ func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbI
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
-		_, response, err := sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
+		var response *core.DetailedResponse
+		err = isLBListenerPolicyLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
 		}
@@ -1043,16 +1074,19 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyPatch: %s", err)
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
-		isLBKey := "load_balancer_key_" + lbID
-		ibmMutexKV.Lock(isLBKey)
-		defer ibmMutexKV.Unlock(isLBKey)
+		unlock := isLBListenerPolicyLock.lock(lbID)
+		defer unlock()
 
 		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
-		_, response, err := sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
+		var response *core.DetailedResponse
+		err = isLBListenerPolicyLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating in policy : %s\n%s", err, response)
 		}
@@ -1082,9 +1116,8 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 	listenerID := parts[1]
 	policyID := parts[2]
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBListenerPolicyLock.lock(lbID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classicLbListenerPolicycDelete(d, meta, lbID, listenerID, policyID)
@@ -1118,7 +1151,7 @@ func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lb
 	//Getting lb listener policy
 	_, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1137,7 +1170,10 @@ func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lb
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
+	err = isLBListenerPolicyLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error in classicLbListenerPolicycDelete: %s\n%s", err, response)
 	}
@@ -1164,7 +1200,7 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 	//Getting lb listener policy
 	_, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1182,7 +1218,10 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
+	err = isLBListenerPolicyLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error in lbListenerPolicyDelete: %s\n%s", err, response)
 	}
@@ -1194,16 +1233,18 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 }
 func isWaitForLbListnerPolicyDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting},
-		Target:     []string{isLBListenerPolicyFailed, isLBListenerPolicyDeleted},
-		Refresh:    isLbListenerPolicyDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer listener policy %s to be deleted", id),
+		Pending:         []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting},
+		Target:          []string{isLBListenerPolicyDeleted},
+		Failed:          []string{isLBListenerPolicyFailed},
+		Status:          refreshStatus(isLbListenerPolicyDeleteRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1229,7 +1270,7 @@ func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.S
 		policy, response, err := vpc.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
 
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return policy, isLBListenerPolicyDeleted, nil
 			}
 			return nil, isLBListenerPolicyFailed, err
@@ -1254,7 +1295,7 @@ func classicLbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID,
 	//Getting lb listener policy
 	policy, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1345,7 +1386,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 	//Getting lb listener policy
 	policy, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1410,16 +1451,18 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 
 func isWaitForLbListenerPolicyClassicDeleted(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting, "delete_pending"},
-		Target:     []string{isLBListenerPolicyFailed, isLBListenerPolicyDeleted},
-		Refresh:    isLbListenerPolicyClassicDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer listener policy %s to be deleted", id),
+		Pending:         []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting, "delete_pending"},
+		Target:          []string{isLBListenerPolicyDeleted},
+		Failed:          []string{isLBListenerPolicyFailed},
+		Status:          refreshStatus(isLbListenerPolicyClassicDeleteRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLbListenerPolicyClassicDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -1445,7 +1488,7 @@ func isLbListenerPolicyClassicDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1,
 		policy, response, err := vpc.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
 
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return policy, isLBListenerPolicyDeleted, nil
 			}
 
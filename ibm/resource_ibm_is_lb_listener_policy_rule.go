@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
@@ -32,6 +33,9 @@ const (
 	isLBListenerPolicyRuleProvisioningDone = "done"
 )
 
+var isLBListenerPolicyRuleIDTemplate = newIDTemplate("ibm_is_lb_listener_policy_rule", "{lb_id}/{listener_id}/{policy_id}/{rule_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb/2161a3fb-123c-4a33-9a3d-b3154ef42009/356789a3-25b4-4c62-8cc7-0f7e092e7a8f")
+var isLBListenerPolicyRuleLock = newParentLock("ibm_is_lb_listener_policy_rule", "load_balancer")
+
 func resourceIBMISLBListenerPolicyRule() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISLBListenerPolicyRuleCreate,
@@ -39,7 +43,7 @@ func resourceIBMISLBListenerPolicyRule() *schema.Resource {
 		Update:   resourceIBMISLBListenerPolicyRuleUpdate,
 		Delete:   resourceIBMISLBListenerPolicyRuleDelete,
 		Exists:   resourceIBMISLBListenerPolicyRuleExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isLBListenerPolicyRuleIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -261,9 +265,8 @@ func classicLbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{},
 		Field:          &field,
 	}
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBListenerPolicyRuleLock.lock(lbID)
+	defer unlock()
 
 	_, err = isWaitForClassicLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -271,7 +274,12 @@ func classicLbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{},
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	rule, response, err := sess.CreateLoadBalancerListenerPolicyRule(options)
+	var rule *vpcclassicv1.LoadBalancerListenerPolicyRule
+	var response *core.DetailedResponse
+	err = isLBListenerPolicyRuleLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		rule, response, err = sess.CreateLoadBalancerListenerPolicyRule(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
 	}
@@ -287,16 +295,18 @@ func classicLbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{},
 
 func isWaitForClassicLoadbalancerAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBProvisioningDone},
-		Refresh:    isLoadbalancerClassicRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer %s to be available", id),
+		Pending:         []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:          []string{isLBProvisioningDone},
+		Failed:          []string{isLBListenerPolicyFailed},
+		Status:          refreshStatus(isLoadbalancerClassicRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLoadbalancerClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -311,7 +321,10 @@ func isLoadbalancerClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string)
 			return nil, "", err
 		}
 
-		if *(lb.ProvisioningStatus) == isLBListenerPolicyAvailable || *lb.ProvisioningStatus == isLBListenerPolicyFailed {
+		if *(lb.ProvisioningStatus) == isLBListenerPolicyFailed {
+			return lb, isLBListenerPolicyFailed, nil
+		}
+		if *(lb.ProvisioningStatus) == isLBListenerPolicyAvailable {
 			return lb, isLBProvisioningDone, nil
 		}
 
@@ -321,16 +334,18 @@ func isLoadbalancerClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string)
 
 func isWaitForClassicLbListenerPolicyRuleAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerPolicyProvisioningDone},
-		Refresh:    isLbListenerPolicyRuleClassicRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer listener policy rule %s to be available", id),
+		Pending:         []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:          []string{isLBListenerPolicyProvisioningDone},
+		Failed:          []string{isLBListenerPolicyFailed},
+		Status:          refreshStatus(isLbListenerPolicyRuleClassicRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLbListenerPolicyRuleClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -358,7 +373,10 @@ func isLbListenerPolicyRuleClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id
 			return rule, "", err
 		}
 
-		if *rule.ProvisioningStatus == isLBListenerPolicyAvailable || *rule.ProvisioningStatus == isLBListenerPolicyFailed {
+		if *rule.ProvisioningStatus == isLBListenerPolicyFailed {
+			return rule, isLBListenerPolicyFailed, nil
+		}
+		if *rule.ProvisioningStatus == isLBListenerPolicyAvailable {
 			return rule, isLBListenerProvisioningDone, nil
 		}
 
@@ -388,9 +406,8 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 		Field:          &field,
 	}
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBListenerPolicyRuleLock.lock(lbID)
+	defer unlock()
 
 	_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -398,7 +415,12 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	rule, response, err := sess.CreateLoadBalancerListenerPolicyRule(options)
+	var rule *vpcv1.LoadBalancerListenerPolicyRule
+	var response *core.DetailedResponse
+	err = isLBListenerPolicyRuleLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		rule, response, err = sess.CreateLoadBalancerListenerPolicyRule(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error while creating lb listener policy for LB %s: Error %v Response %v", lbID, err, *response)
 	}
@@ -415,16 +437,17 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 
 func isWaitForLoadbalancerAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRulePending},
-		Target:     []string{isLBProvisioningDone},
-		Refresh:    isLoadbalancerRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer %s to be available", id),
+		Pending:         []string{isLBListenerPolicyRulePending},
+		Target:          []string{isLBProvisioningDone},
+		Status:          refreshStatus(isLoadbalancerRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -449,16 +472,18 @@ func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefres
 
 func isWaitForLbListenerPolicyRuleAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBListenerPolicyRuleProvisioningDone},
-		Refresh:    isLbListenerPolicyRuleRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer listener policy rule %s to be available", id),
+		Pending:         []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
+		Target:          []string{isLBListenerPolicyRuleProvisioningDone},
+		Failed:          []string{isLBListenerPolicyRuleFailed},
+		Status:          refreshStatus(isLbListenerPolicyRuleRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -487,7 +512,10 @@ func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.Sta
 			return rule, "", err
 		}
 
-		if *rule.ProvisioningStatus == isLBListenerPolicyRuleAvailable || *rule.ProvisioningStatus == isLBListenerPolicyRuleFailed {
+		if *rule.ProvisioningStatus == isLBListenerPolicyRuleFailed {
+			return rule, isLBListenerPolicyRuleFailed, nil
+		}
+		if *rule.ProvisioningStatus == isLBListenerPolicyRuleAvailable {
 			return rule, isLBListenerPolicyRuleProvisioningDone, nil
 		}
 
@@ -570,7 +598,7 @@ func classicLbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{},
 	//Getting lb listener policy
 	_, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting policy: %s\n%s", err, response)
@@ -604,7 +632,7 @@ func lbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{}, ID str
 	_, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting policy: %s\n%s", err, response)
@@ -684,9 +712,8 @@ func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{},
 		hasChanged = true
 	}
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBListenerPolicyRuleLock.lock(lbID)
+	defer unlock()
 
 	if hasChanged {
 		loadBalancerListenerPolicyRulePatch, err := loadBalancerListenerPolicyRulePatchModel.AsPatch()
@@ -700,7 +727,11 @@ func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{},
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
-		_, response, err := sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
+		var response *core.DetailedResponse
+		err = isLBListenerPolicyRuleLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
 		}
@@ -758,9 +789,8 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 		}
 		updatePolicyRuleOptions.LoadBalancerListenerPolicyRulePatch = loadBalancerListenerPolicyRulePatch
 
-		isLBKey := "load_balancer_key_" + lbID
-		ibmMutexKV.Lock(isLBKey)
-		defer ibmMutexKV.Unlock(isLBKey)
+		unlock := isLBListenerPolicyRuleLock.lock(lbID)
+		defer unlock()
 
 		_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -768,7 +798,11 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 
-		_, response, err := sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
+		var response *core.DetailedResponse
+		err = isLBListenerPolicyRuleLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating in policy : %s\n%s", err, response)
 		}
@@ -799,9 +833,8 @@ func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interf
 	policyID := parts[2]
 	ruleID := parts[3]
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBListenerPolicyRuleLock.lock(lbID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classicLbListenerPolicyRuleDelete(d, meta, lbID, listenerID, policyID, ruleID)
@@ -837,7 +870,7 @@ func classicLbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{},
 	//Getting lb listener policy
 	_, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -851,7 +884,10 @@ func classicLbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{},
 		ID:             &ID,
 	}
 
-	response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
+	err = isLBListenerPolicyRuleLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
+		return responseError(response, err)
+	})
 
 	if err != nil {
 		return fmt.Errorf("Error in classicLbListenerPolicyRuleDelete: %s\n%s", err, response)
@@ -881,7 +917,7 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 	//Getting lb listener policy
 	_, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -894,7 +930,10 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 		PolicyID:       &policyID,
 		ID:             &ID,
 	}
-	response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
+	err = isLBListenerPolicyRuleLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error in lbListenerPolicyRuleDelete: %s\n%s", err, response)
 	}
@@ -906,16 +945,18 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 }
 func isWaitForLbListnerPolicyRuleDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting},
-		Target:     []string{isLBListenerPolicyRuleDeleted, isLBListenerPolicyRuleFailed},
-		Refresh:    isLbListenerPolicyRuleDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer listener policy rule %s to be deleted", id),
+		Pending:         []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting},
+		Target:          []string{isLBListenerPolicyRuleDeleted},
+		Failed:          []string{isLBListenerPolicyRuleFailed},
+		Status:          refreshStatus(isLbListenerPolicyRuleDeleteRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -943,7 +984,7 @@ func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resour
 		rule, response, err := vpc.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
 
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return rule, isLBListenerPolicyRuleDeleted, nil
 			}
 			return rule, isLBListenerPolicyRuleFailed, err
@@ -974,7 +1015,7 @@ func classicLbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lb
 	//Getting lb listener policy
 	rule, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1020,7 +1061,7 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 	//Getting lb listener policy
 	rule, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1051,16 +1092,18 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 
 func isWaitForLbListenerPolicyRuleClassicDeleted(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting, "delete_pending"},
-		Target:     []string{isLBListenerPolicyRuleDeleted, isLBListenerPolicyRuleFailed},
-		Refresh:    isLbListenerPolicyRuleClassicDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("load balancer listener policy rule %s to be deleted", id),
+		Pending:         []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting, "delete_pending"},
+		Target:          []string{isLBListenerPolicyRuleDeleted},
+		Failed:          []string{isLBListenerPolicyRuleFailed},
+		Status:          refreshStatus(isLbListenerPolicyRuleClassicDeleteRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLbListenerPolicyRuleClassicDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -1088,7 +1131,7 @@ func isLbListenerPolicyRuleClassicDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassic
 		rule, response, err := vpc.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
 		//failed := isLBListenerPolicyRuleFailed
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return rule, isLBListenerPolicyRuleDeleted, nil
 			}
 			return nil, isLBListenerPolicyRuleFailed, err
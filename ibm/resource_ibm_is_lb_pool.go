@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -35,6 +36,9 @@ const (
 	isLBPool                          = "pool_id"
 )
 
+var isLBPoolIDTemplate = newIDTemplate("ibm_is_lb_pool", "{lb_id}/{pool_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+var isLBPoolLock = newParentLock("ibm_is_lb_pool", "load_balancer")
+
 func resourceIBMISLBPool() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISLBPoolCreate,
@@ -42,7 +46,7 @@ func resourceIBMISLBPool() *schema.Resource {
 		Update:   resourceIBMISLBPoolUpdate,
 		Delete:   resourceIBMISLBPoolDelete,
 		Exists:   resourceIBMISLBPoolExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isLBPoolIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -233,9 +237,8 @@ func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	if hmp, ok := d.GetOk(isLBPoolHealthMonitorPort); ok {
 		healthMonitorPort = int64(hmp.(int))
 	}
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBPoolLock.lock(lbID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classicLBPoolCreate(d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
@@ -286,7 +289,12 @@ func classicLBPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, a
 			Type: &spType,
 		}
 	}
-	lbPool, response, err := sess.CreateLoadBalancerPool(options)
+	var lbPool *vpcclassicv1.LoadBalancerPool
+	var response *core.DetailedResponse
+	err = isLBPoolLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		lbPool, response, err = sess.CreateLoadBalancerPool(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("[DEBUG] lbpool create err: %s\n%s", err, response)
 	}
@@ -344,7 +352,12 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 			Type: &spType,
 		}
 	}
-	lbPool, response, err := sess.CreateLoadBalancerPool(options)
+	var lbPool *vpcv1.LoadBalancerPool
+	var response *core.DetailedResponse
+	err = isLBPoolLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		lbPool, response, err = sess.CreateLoadBalancerPool(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("[DEBUG] lbpool create err: %s\n%s", err, response)
 	}
@@ -406,7 +419,7 @@ func classicLBPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID s
 	}
 	lbPool, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -458,7 +471,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 
 	lbPool, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -583,9 +596,8 @@ func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 		loadBalancerPoolPatchModel.Name = &name
 		loadBalancerPoolPatchModel.Protocol = &protocol
 
-		isLBKey := "load_balancer_key_" + lbID
-		ibmMutexKV.Lock(isLBKey)
-		defer ibmMutexKV.Unlock(isLBKey)
+		unlock := isLBPoolLock.lock(lbID)
+		defer unlock()
 		_, err := isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
@@ -604,7 +616,11 @@ func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 		}
 		updateLoadBalancerPoolOptions.LoadBalancerPoolPatch = LoadBalancerPoolPatch
 
-		_, response, err := sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
+		var response *core.DetailedResponse
+		err = isLBPoolLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating Load Balancer Pool : %s\n%s", err, response)
 		}
@@ -680,9 +696,8 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		loadBalancerPoolPatchModel.Name = &name
 		loadBalancerPoolPatchModel.Protocol = &protocol
 
-		isLBKey := "load_balancer_key_" + lbID
-		ibmMutexKV.Lock(isLBKey)
-		defer ibmMutexKV.Unlock(isLBKey)
+		unlock := isLBPoolLock.lock(lbID)
+		defer unlock()
 		_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
@@ -701,7 +716,11 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		}
 		updateLoadBalancerPoolOptions.LoadBalancerPoolPatch = LoadBalancerPoolPatch
 
-		_, response, err := sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
+		var response *core.DetailedResponse
+		err = isLBPoolLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating Load Balancer Pool : %s\n%s", err, response)
 		}
@@ -735,9 +754,8 @@ func resourceIBMISLBPoolDelete(d *schema.ResourceData, meta interface{}) error {
 	lbID := parts[0]
 	lbPoolID := parts[1]
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBPoolLock.lock(lbID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classicLBPoolDelete(d, meta, lbID, lbPoolID)
@@ -765,7 +783,7 @@ func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	_, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -787,7 +805,10 @@ func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 		LoadBalancerID: &lbID,
 		ID:             &lbPoolID,
 	}
-	response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
+	err = isLBPoolLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
@@ -817,7 +838,7 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	}
 	_, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -839,7 +860,10 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		LoadBalancerID: &lbID,
 		ID:             &lbPoolID,
 	}
-	response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
+	err = isLBPoolLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
@@ -893,7 +917,7 @@ func classicLBPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	}
 	_, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Load balancer pool: %s\n%s", err, response)
@@ -913,7 +937,7 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	}
 	_, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Load balancer pool: %s\n%s", err, response)
@@ -922,18 +946,17 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 }
 
 func isWaitForLBPoolActive(sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for load balancer pool (%s) to be available.", lbPoolId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolCreatePending, isLBPoolUpdatePending, isLBPoolMaintainancePending},
-		Target:     []string{isLBPoolActive, ""},
-		Refresh:    isLBPoolRefreshFunc(sess, lbId, lbPoolId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("pool %s of the load balancer %s to be active", lbPoolId, lbId),
+		Pending:         []string{isLBPoolCreatePending, isLBPoolUpdatePending, isLBPoolMaintainancePending},
+		Target:          []string{isLBPoolActive, ""},
+		Status:          refreshStatus(isLBPoolRefreshFunc(sess, lbId, lbPoolId)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -957,18 +980,17 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 }
 
 func isWaitForClassicLBPoolActive(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for load balancer pool (%s) to be available.", lbPoolId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolCreatePending, isLBPoolUpdatePending, isLBPoolMaintainancePending},
-		Target:     []string{isLBPoolActive, ""},
-		Refresh:    isClassicLBPoolRefreshFunc(sess, lbId, lbPoolId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("pool %s of the load balancer %s to be active", lbPoolId, lbId),
+		Pending:         []string{isLBPoolCreatePending, isLBPoolUpdatePending, isLBPoolMaintainancePending},
+		Target:          []string{isLBPoolActive, ""},
+		Status:          refreshStatus(isClassicLBPoolRefreshFunc(sess, lbId, lbPoolId)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicLBPoolRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -992,18 +1014,17 @@ func isClassicLBPoolRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId
 }
 
 func isWaitForClassicLBPoolDeleted(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for  (%s) to be deleted.", lbPoolId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolUpdatePending, isLBPoolMaintainancePending, isLBPoolDeletePending},
-		Target:     []string{isLBPoolDeleteDone, ""},
-		Refresh:    isClassicLBPoolDeleteRefreshFunc(lbc, lbId, lbPoolId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("pool %s of the load balancer %s to be deleted", lbPoolId, lbId),
+		Pending:         []string{isLBPoolUpdatePending, isLBPoolMaintainancePending, isLBPoolDeletePending},
+		Target:          []string{isLBPoolDeleteDone, ""},
+		Status:          refreshStatus(isClassicLBPoolDeleteRefreshFunc(lbc, lbId, lbPoolId)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicLBPoolDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -1015,7 +1036,7 @@ func isClassicLBPoolDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPo
 		}
 		lbPool, response, err := lbc.GetLoadBalancerPool(getlbpOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return lbPool, isLBPoolDeleteDone, nil
 			}
 			return nil, "", fmt.Errorf("The vpc load balancer pool %s failed to delete: %s\n%s", lbPoolId, err, response)
@@ -1025,18 +1046,17 @@ func isClassicLBPoolDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPo
 }
 
 func isWaitForLBPoolDeleted(lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for  (%s) to be deleted.", lbPoolId)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolUpdatePending, isLBPoolMaintainancePending, isLBPoolDeletePending},
-		Target:     []string{isLBPoolDeleteDone, ""},
-		Refresh:    isLBPoolDeleteRefreshFunc(lbc, lbId, lbPoolId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("pool %s of the load balancer %s to be deleted", lbPoolId, lbId),
+		Pending:         []string{isLBPoolUpdatePending, isLBPoolMaintainancePending, isLBPoolDeletePending},
+		Target:          []string{isLBPoolDeleteDone, ""},
+		Status:          refreshStatus(isLBPoolDeleteRefreshFunc(lbc, lbId, lbPoolId)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -1048,7 +1068,7 @@ func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource
 		}
 		lbPool, response, err := lbc.GetLoadBalancerPool(getlbpOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return lbPool, isLBPoolDeleteDone, nil
 			}
 			return nil, "", fmt.Errorf("The vpc load balancer pool %s failed to delete: %s\n%s", lbPoolId, err, response)
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -27,6 +28,9 @@ const (
 	isLBPoolUpdating                 = "updating"
 )
 
+var isLBPoolMemberIDTemplate = newIDTemplate("ibm_is_lb_pool_member", "{lb_id}/{pool_id}/{member_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb/2161a3fb-123c-4a33-9a3d-b3154ef42009")
+var isLBPoolMemberLock = newParentLock("ibm_is_lb_pool_member", "load_balancer")
+
 func resourceIBMISLBPoolMember() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISLBPoolMemberCreate,
@@ -34,7 +38,7 @@ func resourceIBMISLBPoolMember() *schema.Resource {
 		Update:   resourceIBMISLBPoolMemberUpdate,
 		Delete:   resourceIBMISLBPoolMemberDelete,
 		Exists:   resourceIBMISLBPoolMemberExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isLBPoolMemberIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -148,9 +152,8 @@ func resourceIBMISLBPoolMemberCreate(d *schema.ResourceData, meta interface{}) e
 	if w, ok := d.GetOk(isLBPoolMemberWeight); ok {
 		weight = int64(w.(int))
 	}
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBPoolMemberLock.lock(lbID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		targetAddress := d.Get(isLBPoolMemberTargetAddress).(string)
@@ -196,7 +199,12 @@ func classiclbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	if weight > int64(0) {
 		options.Weight = &weight
 	}
-	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMember(options)
+	var lbPoolMember *vpcclassicv1.LoadBalancerPoolMember
+	var response *core.DetailedResponse
+	err = isLBPoolMemberLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		lbPoolMember, response, err = sess.CreateLoadBalancerPoolMember(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("[DEBUG] lbpool member create err: %s\n%s", err, response)
 	}
@@ -264,7 +272,12 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	if weight > int64(0) {
 		options.Weight = &weight
 	}
-	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMember(options)
+	var lbPoolMember *vpcv1.LoadBalancerPoolMember
+	var response *core.DetailedResponse
+	err = isLBPoolMemberLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		lbPoolMember, response, err = sess.CreateLoadBalancerPoolMember(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("[DEBUG] lbpool member create err: %s\n%s", err, response)
 	}
@@ -293,18 +306,17 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 }
 
 func isWaitForClassicLBPoolMemberAvailable(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for load balancer pool member(%s) to be available.", lbPoolMemID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBPoolMemberActive, ""},
-		Refresh:    isClassicLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("member %s of the load balancer pool %s to be available", lbPoolMemID, lbPoolID),
+		Pending:         []string{"create_pending", "update_pending", "maintenance_pending"},
+		Target:          []string{isLBPoolMemberActive, ""},
+		Status:          refreshStatus(isClassicLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
@@ -329,18 +341,17 @@ func isClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPo
 }
 
 func isWaitForLBPoolMemberAvailable(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for load balancer pool member(%s) to be available.", lbPoolMemID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"create_pending", "update_pending", "maintenance_pending"},
-		Target:     []string{isLBPoolMemberActive, ""},
-		Refresh:    isLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("member %s of the load balancer pool %s to be available", lbPoolMemID, lbPoolID),
+		Pending:         []string{"create_pending", "update_pending", "maintenance_pending"},
+		Target:          []string{isLBPoolMemberActive, ""},
+		Status:          refreshStatus(isLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
@@ -411,7 +422,7 @@ func classiclbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	}
 	lbPoolMem, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -451,7 +462,7 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 	}
 	lbPoolMem, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -523,9 +534,8 @@ func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 		targetAddress := d.Get(isLBPoolMemberTargetAddress).(string)
 		weight := int64(d.Get(isLBPoolMemberWeight).(int))
 
-		isLBKey := "load_balancer_key_" + lbID
-		ibmMutexKV.Lock(isLBKey)
-		defer ibmMutexKV.Unlock(isLBKey)
+		unlock := isLBPoolMemberLock.lock(lbID)
+		defer unlock()
 
 		_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -564,7 +574,11 @@ func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 		}
 		updatelbpmoptions.LoadBalancerPoolMemberPatch = loadBalancerPoolMemberPatch
 
-		_, response, err := sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
+		var response *core.DetailedResponse
+		err = isLBPoolMemberLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating Load Balancer Pool Member: %s\n%s", err, response)
 		}
@@ -599,9 +613,8 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		port := int64(d.Get(isLBPoolMemberPort).(int))
 		weight := int64(d.Get(isLBPoolMemberWeight).(int))
 
-		isLBKey := "load_balancer_key_" + lbID
-		ibmMutexKV.Lock(isLBKey)
-		defer ibmMutexKV.Unlock(isLBKey)
+		unlock := isLBPoolMemberLock.lock(lbID)
+		defer unlock()
 
 		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -651,7 +664,11 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		}
 		updatelbpmoptions.LoadBalancerPoolMemberPatch = loadBalancerPoolMemberPatch
 
-		_, response, err := sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
+		var response *core.DetailedResponse
+		err = isLBPoolMemberLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating Load Balancer Pool Member: %s\n%s", err, response)
 		}
@@ -691,9 +708,8 @@ func resourceIBMISLBPoolMemberDelete(d *schema.ResourceData, meta interface{}) e
 	lbPoolID := parts[1]
 	lbPoolMemID := parts[2]
 
-	isLBKey := "load_balancer_key_" + lbID
-	ibmMutexKV.Lock(isLBKey)
-	defer ibmMutexKV.Unlock(isLBKey)
+	unlock := isLBPoolMemberLock.lock(lbID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classiclbpmemberDelete(d, meta, lbID, lbPoolID, lbPoolMemID)
@@ -722,7 +738,7 @@ func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	}
 	_, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -750,7 +766,10 @@ func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPo
 		PoolID:         &lbPoolID,
 		ID:             &lbPoolMemID,
 	}
-	response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
+	err = isLBPoolMemberLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
 	}
@@ -789,7 +808,7 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	}
 	_, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -817,7 +836,10 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		PoolID:         &lbPoolID,
 		ID:             &lbPoolMemID,
 	}
-	response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
+	err = isLBPoolMemberLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
 	}
@@ -843,32 +865,30 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	return nil
 }
 func isWaitForClassicLBPoolMemberDeleted(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for  (%s) to be deleted.", lbPoolMemID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolMemberDeletePending},
-		Target:     []string{isLBPoolMemberDeleted, ""},
-		Refresh:    isDeleteClassicLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("member %s of the load balancer pool %s to be deleted", lbPoolMemID, lbPoolID),
+		Pending:         []string{isLBPoolMemberDeletePending},
+		Target:          []string{isLBPoolMemberDeleted, ""},
+		Status:          refreshStatus(isDeleteClassicLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 func isWaitForLBPoolMemberDeleted(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for  (%s) to be deleted.", lbPoolMemID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isLBPoolMemberDeletePending},
-		Target:     []string{isLBPoolMemberDeleted, ""},
-		Refresh:    isDeleteLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("member %s of the load balancer pool %s to be deleted", lbPoolMemID, lbPoolID),
+		Pending:         []string{isLBPoolMemberDeletePending},
+		Target:          []string{isLBPoolMemberDeleted, ""},
+		Status:          refreshStatus(isDeleteLBPoolMemberRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isDeleteClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
@@ -881,7 +901,7 @@ func isDeleteClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID
 		}
 		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return lbPoolMem, isLBPoolMemberDeleted, nil
 			}
 			return nil, "", fmt.Errorf("Error Deleting Load balancer pool member: %s\n%s", err, response)
@@ -900,7 +920,7 @@ func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMem
 		}
 		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return lbPoolMem, isLBPoolMemberDeleted, nil
 			}
 			return nil, "", fmt.Errorf("Error Deleting Load balancer pool member: %s\n%s", err, response)
@@ -949,7 +969,7 @@ func classiclbpmemberExists(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	}
 	_, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Load balancer pool member: %s\n%s", err, response)
@@ -970,7 +990,7 @@ func lbpmemberExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	}
 	_, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Load balancer pool member: %s\n%s", err, response)
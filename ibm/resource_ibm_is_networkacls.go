@@ -495,7 +495,7 @@ func classicNwaclGet(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	nwacl, response, err := sess.GetNetworkACL(getNetworkAclOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -604,7 +604,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	nwacl, response, err := sess.GetNetworkACL(getNetworkAclOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -855,7 +855,7 @@ func classicNwaclDelete(d *schema.ResourceData, meta interface{}, id string) err
 	}
 	_, response, err := sess.GetNetworkACL(getNetworkAclOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -885,7 +885,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	_, response, err := sess.GetNetworkACL(getNetworkAclOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -928,7 +928,7 @@ func classicNwaclExists(d *schema.ResourceData, meta interface{}, id string) (bo
 	}
 	_, response, err := sess.GetNetworkACL(getNetworkAclOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Network ACL: %s\n%s", err, response)
@@ -946,7 +946,7 @@ func nwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 	}
 	_, response, err := sess.GetNetworkACL(getNetworkAclOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Network ACL: %s\n%s", err, response)
@@ -46,7 +46,7 @@ func resourceIBMISPublicGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -295,18 +295,17 @@ func pgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone string)
 }
 
 func isWaitForClassicPublicGatewayAvailable(publicgwC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for public gateway (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isPublicGatewayProvisioning},
-		Target:     []string{isPublicGatewayProvisioningDone, ""},
-		Refresh:    isClassicPublicGatewayRefreshFunc(publicgwC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("public gateway %s to be available", id),
+		Pending:         []string{"retry", isPublicGatewayProvisioning},
+		Target:          []string{isPublicGatewayProvisioningDone, ""},
+		Status:          refreshStatus(isClassicPublicGatewayRefreshFunc(publicgwC, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicPublicGatewayRefreshFunc(publicgwC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -328,18 +327,17 @@ func isClassicPublicGatewayRefreshFunc(publicgwC *vpcclassicv1.VpcClassicV1, id
 }
 
 func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for public gateway (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isPublicGatewayProvisioning},
-		Target:     []string{isPublicGatewayProvisioningDone, ""},
-		Refresh:    isPublicGatewayRefreshFunc(publicgwC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("public gateway %s to be available", id),
+		Pending:         []string{"retry", isPublicGatewayProvisioning},
+		Target:          []string{isPublicGatewayProvisioningDone, ""},
+		Status:          refreshStatus(isPublicGatewayRefreshFunc(publicgwC, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -390,7 +388,7 @@ func classicPgwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -435,7 +433,7 @@ func pgwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -615,7 +613,7 @@ func classicPgwDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	_, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -648,7 +646,7 @@ func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -671,18 +669,17 @@ func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 }
 
 func isWaitForClassicPublicGatewayDeleted(pg *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for public gateway (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isPublicGatewayDeleting},
-		Target:     []string{isPublicGatewayDeleted, ""},
-		Refresh:    isClassicPublicGatewayDeleteRefreshFunc(pg, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("public gateway %s to be deleted", id),
+		Pending:         []string{"retry", isPublicGatewayDeleting},
+		Target:          []string{isPublicGatewayDeleted, ""},
+		Status:          refreshStatus(isClassicPublicGatewayDeleteRefreshFunc(pg, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicPublicGatewayDeleteRefreshFunc(pg *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -693,7 +690,7 @@ func isClassicPublicGatewayDeleteRefreshFunc(pg *vpcclassicv1.VpcClassicV1, id s
 		}
 		pgw, response, err := pg.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return pgw, isPublicGatewayDeleted, nil
 			}
 			return nil, "", fmt.Errorf("The Public Gateway %s failed to delete: %s\n%s", id, err, response)
@@ -703,18 +700,17 @@ func isClassicPublicGatewayDeleteRefreshFunc(pg *vpcclassicv1.VpcClassicV1, id s
 }
 
 func isWaitForPublicGatewayDeleted(pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for public gateway (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isPublicGatewayDeleting},
-		Target:     []string{isPublicGatewayDeleted, ""},
-		Refresh:    isPublicGatewayDeleteRefreshFunc(pg, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("public gateway %s to be deleted", id),
+		Pending:         []string{"retry", isPublicGatewayDeleting},
+		Target:          []string{isPublicGatewayDeleted, ""},
+		Status:          refreshStatus(isPublicGatewayDeleteRefreshFunc(pg, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -725,7 +721,7 @@ func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.State
 		}
 		pgw, response, err := pg.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return pgw, isPublicGatewayDeleted, nil
 			}
 			return nil, "", fmt.Errorf("The Public Gateway %s failed to delete: %s\n%s", id, err, response)
@@ -759,7 +755,7 @@ func classicPgwExists(d *schema.ResourceData, meta interface{}, id string) (bool
 	}
 	_, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Public Gateway: %s\n%s", err, response)
@@ -777,7 +773,7 @@ func pgwExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	}
 	_, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Public Gateway: %s\n%s", err, response)
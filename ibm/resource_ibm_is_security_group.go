@@ -212,7 +212,7 @@ func classicSgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -342,7 +342,7 @@ func sgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -564,7 +564,7 @@ func classicSgDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -592,7 +592,7 @@ func sgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -635,7 +635,7 @@ func classicSgExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 	}
 	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Security Group: %s\n%s", err, response)
@@ -653,7 +653,7 @@ func sgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 	}
 	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Security Group: %s\n%s", err, response)
@@ -29,13 +29,15 @@ const (
 	isSGNICAFloatingIpCRN         = "crn"
 )
 
+var isSecurityGroupNetworkInterfaceAttachmentIDTemplate = newIDTemplate("ibm_is_security_group_network_interface_attachment", "{security_group_id}/{network_interface_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+
 func resourceIBMISSecurityGroupNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate,
 		Read:     resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead,
 		Delete:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete,
 		Exists:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isSecurityGroupNetworkInterfaceAttachmentIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			isSGNICAGroupId: {
@@ -241,7 +243,7 @@ func classicSgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID strin
 	}
 	instanceNic, response, err := sess.GetSecurityGroupNetworkInterface(getSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -301,7 +303,7 @@ func sgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID string) erro
 	}
 	instanceNic, response, err := sess.GetSecurityGroupNetworkInterface(getSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -389,7 +391,7 @@ func classicSgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID st
 	}
 	_, response, err := sess.GetSecurityGroupNetworkInterface(getSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -420,7 +422,7 @@ func sgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID string) e
 	}
 	_, response, err := sess.GetSecurityGroupNetworkInterface(getSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -471,7 +473,7 @@ func classicSgnicExists(d *schema.ResourceData, meta interface{}, sgID, nicID st
 	}
 	_, response, err := sess.GetSecurityGroupNetworkInterface(getSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
@@ -490,7 +492,7 @@ func sgnicExists(d *schema.ResourceData, meta interface{}, sgID, nicID string) (
 	}
 	_, response, err := sess.GetSecurityGroupNetworkInterface(getSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
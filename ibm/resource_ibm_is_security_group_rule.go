@@ -5,11 +5,14 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var isSecurityGroupRuleLock = newParentLock("ibm_is_security_group_rule", "security_group")
+
 const (
 	isSecurityGroupRuleCode             = "code"
 	isSecurityGroupRuleDirection        = "direction"
@@ -251,16 +254,20 @@ func classicSgRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	ibmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer ibmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock := isSecurityGroupRuleLock.lock(parsed.secgrpID)
+	defer unlock()
 
 	options := &vpcclassicv1.CreateSecurityGroupRuleOptions{
 		SecurityGroupID:            &parsed.secgrpID,
 		SecurityGroupRulePrototype: sgTemplate,
 	}
 
-	rule, response, err := sess.CreateSecurityGroupRule(options)
+	var rule vpcclassicv1.SecurityGroupRuleIntf
+	var response *core.DetailedResponse
+	err = isSecurityGroupRuleLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		rule, response, err = sess.CreateSecurityGroupRule(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error while creating Security Group Rule %s\n%s", err, response)
 	}
@@ -299,16 +306,20 @@ func sgRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	ibmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer ibmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock := isSecurityGroupRuleLock.lock(parsed.secgrpID)
+	defer unlock()
 
 	options := &vpcv1.CreateSecurityGroupRuleOptions{
 		SecurityGroupID:            &parsed.secgrpID,
 		SecurityGroupRulePrototype: sgTemplate,
 	}
 
-	rule, response, err := sess.CreateSecurityGroupRule(options)
+	var rule vpcv1.SecurityGroupRuleIntf
+	var response *core.DetailedResponse
+	err = isSecurityGroupRuleLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		rule, response, err = sess.CreateSecurityGroupRule(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error while creating Security Group Rule %s\n%s", err, response)
 	}
@@ -372,7 +383,7 @@ func classicSgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID
 	}
 	sgrule, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -493,7 +504,7 @@ func sgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string
 	}
 	sgrule, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -630,16 +641,19 @@ func classicSgRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	ibmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer ibmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock := isSecurityGroupRuleLock.lock(parsed.secgrpID)
+	defer unlock()
 	securityGroupRulePatchBody, _ := sgTemplate.AsPatch()
 	updateSecurityGroupRuleOptions := &vpcclassicv1.UpdateSecurityGroupRuleOptions{
 		SecurityGroupID:        &parsed.secgrpID,
 		ID:                     &parsed.ruleID,
 		SecurityGroupRulePatch: securityGroupRulePatchBody,
 	}
-	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
+	var response *core.DetailedResponse
+	err = isSecurityGroupRuleLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+		_, response, err = sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Updating Security Group Rule : %s\n%s", err, response)
 	}
@@ -655,12 +669,15 @@ func sgRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	ibmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer ibmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock := isSecurityGroupRuleLock.lock(parsed.secgrpID)
+	defer unlock()
 
 	updateSecurityGroupRuleOptions := sgTemplate
-	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
+	var response *core.DetailedResponse
+	err = isSecurityGroupRuleLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+		_, response, err = sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Updating Security Group Rule : %s\n%s", err, response)
 	}
@@ -677,9 +694,8 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
-	ibmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer ibmMutexKV.Unlock(isSecurityGroupRuleKey)
+	unlock := isSecurityGroupRuleLock.lock(secgrpID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classicSgRuleDelete(d, meta, secgrpID, ruleID)
@@ -709,7 +725,7 @@ func classicSgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, rul
 	_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -720,7 +736,10 @@ func classicSgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, rul
 		SecurityGroupID: &secgrpID,
 		ID:              &ruleID,
 	}
-	response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+	err = isSecurityGroupRuleLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Security Group Rule : %s\n%s", err, response)
 	}
@@ -741,7 +760,7 @@ func sgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, ruleID str
 	_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -752,7 +771,10 @@ func sgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, ruleID str
 		SecurityGroupID: &secgrpID,
 		ID:              &ruleID,
 	}
-	response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+	err = isSecurityGroupRuleLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Security Group Rule : %s\n%s", err, response)
 	}
@@ -789,7 +811,7 @@ func classicSgRuleExists(d *schema.ResourceData, meta interface{}, secgrpID, rul
 	}
 	_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
@@ -808,7 +830,7 @@ func sgRuleExists(d *schema.ResourceData, meta interface{}, secgrpID, ruleID str
 	}
 	_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
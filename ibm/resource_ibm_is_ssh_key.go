@@ -32,7 +32,7 @@ func resourceIBMISSSHKey() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -256,7 +256,7 @@ func classicKeyGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	key, response, err := sess.GetKey(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -297,7 +297,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	key, response, err := sess.GetKey(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -468,7 +468,7 @@ func classicKeyDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	_, response, err := sess.GetKey(getKeyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting SSH Key (%s): %s\n%s", id, err, response)
@@ -496,7 +496,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetKey(getKeyOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting SSH Key (%s): %s\n%s", id, err, response)
@@ -539,7 +539,7 @@ func classicKeyExists(d *schema.ResourceData, meta interface{}, id string) (bool
 	}
 	_, response, err := sess.GetKey(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting SSH Key: %s\n%s", err, response)
@@ -558,7 +558,7 @@ func keyExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	}
 	_, response, err := sess.GetKey(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting SSH Key: %s\n%s", err, response)
@@ -6,12 +6,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var isSubnetLock = newParentLock("ibm_is_subnet", "vpc_zone")
+
 const (
 	isSubnetIpv4CidrBlock             = "ipv4_cidr_block"
 	isSubnetIpv6CidrBlock             = "ipv6_cidr_block"
@@ -228,9 +231,8 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	if ipv4cidr != "" && ipv4addrcount != 0 {
 		return fmt.Errorf("only one of %s or %s needs to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
 	}
-	isSubnetKey := "subnet_key_" + vpc + "_" + zone
-	ibmMutexKV.Lock(isSubnetKey)
-	defer ibmMutexKV.Unlock(isSubnetKey)
+	unlock := isSubnetLock.lock(vpc, zone)
+	defer unlock()
 
 	acl := ""
 	if nwacl, ok := d.GetOk(isSubnetNetworkACL); ok {
@@ -297,7 +299,12 @@ func classicSubnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zo
 	createSubnetOptions := &vpcclassicv1.CreateSubnetOptions{
 		SubnetPrototype: subnetTemplate,
 	}
-	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
+	var subnet *vpcclassicv1.Subnet
+	var response *core.DetailedResponse
+	err = isSubnetLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		subnet, response, err = sess.CreateSubnet(createSubnetOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
 		return fmt.Errorf("Error while creating Subnet %s\n%s", err, response)
@@ -359,7 +366,12 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	createSubnetOptions := &vpcv1.CreateSubnetOptions{
 		SubnetPrototype: subnetTemplate,
 	}
-	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
+	var subnet *vpcv1.Subnet
+	var response *core.DetailedResponse
+	err = isSubnetLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		subnet, response, err = sess.CreateSubnet(createSubnetOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
 		return fmt.Errorf("Error while creating Subnet %s\n%s", err, response)
@@ -374,18 +386,17 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 }
 
 func isWaitForClassicSubnetAvailable(subnetC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for subnet (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isSubnetProvisioning},
-		Target:     []string{isSubnetProvisioningDone, ""},
-		Refresh:    isClassicSubnetRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("subnet %s to be available", id),
+		Pending:         []string{"retry", isSubnetProvisioning},
+		Target:          []string{isSubnetProvisioningDone, ""},
+		Status:          refreshStatus(isClassicSubnetRefreshFunc(subnetC, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicSubnetRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -407,18 +418,17 @@ func isClassicSubnetRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) r
 }
 
 func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for subnet (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isSubnetProvisioning},
-		Target:     []string{isSubnetProvisioningDone, ""},
-		Refresh:    isSubnetRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("subnet %s to be available", id),
+		Pending:         []string{"retry", isSubnetProvisioning},
+		Target:          []string{isSubnetProvisioningDone, ""},
+		Status:          refreshStatus(isSubnetRefreshFunc(subnetC, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -468,7 +478,7 @@ func classicSubnetGet(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	subnet, response, err := sess.GetSubnet(getSubnetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -512,7 +522,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	subnet, response, err := sess.GetSubnet(getSubnetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -563,6 +573,8 @@ func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	id := d.Id()
+	unlock := isSubnetLock.lock(d.Get(isSubnetVPC).(string), d.Get(isSubnetZone).(string))
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classicSubnetUpdate(d, meta, id)
@@ -640,7 +652,11 @@ func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) er
 		}
 		updateSubnetOptions.SubnetPatch = subnetPatch
 		updateSubnetOptions.ID = &id
-		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
+		var response *core.DetailedResponse
+		err = isSubnetLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateSubnet(updateSubnetOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating Subnet : %s\n%s", err, response)
 		}
@@ -727,7 +743,11 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		updateSubnetOptions.SubnetPatch = subnetPatch
 		updateSubnetOptions.ID = &id
-		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
+		var response *core.DetailedResponse
+		err = isSubnetLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateSubnet(updateSubnetOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating Subnet : %s\n%s", err, response)
 		}
@@ -741,6 +761,8 @@ func resourceIBMISSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	id := d.Id()
+	unlock := isSubnetLock.lock(d.Get(isSubnetVPC).(string), d.Get(isSubnetZone).(string))
+	defer unlock()
 	if userDetails.generation == 1 {
 		err := classicSubnetDelete(d, meta, id)
 		if err != nil {
@@ -767,7 +789,7 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 	}
 	subnet, response, err := sess.GetSubnet(getSubnetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
@@ -788,7 +810,10 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 	deleteSubnetOptions := &vpcclassicv1.DeleteSubnetOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteSubnet(deleteSubnetOptions)
+	err = isSubnetLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteSubnet(deleteSubnetOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Subnet : %s\n%s", err, response)
 	}
@@ -810,7 +835,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	subnet, response, err := sess.GetSubnet(getSubnetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
@@ -831,7 +856,10 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteSubnetOptions := &vpcv1.DeleteSubnetOptions{
 		ID: &id,
 	}
-	response, err = sess.DeleteSubnet(deleteSubnetOptions)
+	err = isSubnetLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteSubnet(deleteSubnetOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Subnet : %s\n%s", err, response)
 	}
@@ -844,18 +872,17 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 }
 
 func isWaitForClassicSubnetDeleted(subnetC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for subnet (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isSubnetDeleting},
-		Target:     []string{isSubnetDeleted, ""},
-		Refresh:    isClassicSubnetDeleteRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("subnet %s to be deleted", id),
+		Pending:         []string{"retry", isSubnetDeleting},
+		Target:          []string{isSubnetDeleted, ""},
+		Status:          refreshStatus(isClassicSubnetDeleteRefreshFunc(subnetC, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicSubnetDeleteRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -866,7 +893,7 @@ func isClassicSubnetDeleteRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id str
 		}
 		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return subnet, isSubnetDeleted, nil
 			}
 			return subnet, "", fmt.Errorf("The Subnet %s failed to delete: %s\n%s", id, err, response)
@@ -876,18 +903,17 @@ func isClassicSubnetDeleteRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id str
 }
 
 func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for subnet (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isSubnetDeleting},
-		Target:     []string{isSubnetDeleted, ""},
-		Refresh:    isSubnetDeleteRefreshFunc(subnetC, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("subnet %s to be deleted", id),
+		Pending:         []string{"retry", isSubnetDeleting},
+		Target:          []string{isSubnetDeleted, ""},
+		Status:          refreshStatus(isSubnetDeleteRefreshFunc(subnetC, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -898,7 +924,7 @@ func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRe
 		}
 		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return subnet, isSubnetDeleted, nil
 			}
 			if response != nil && strings.Contains(err.Error(), "please detach all network interfaces from subnet before deleting it") {
@@ -935,7 +961,7 @@ func classicSubnetExists(d *schema.ResourceData, meta interface{}, id string) (b
 	}
 	_, response, err := sess.GetSubnet(getsubnetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Subnet: %s\n%s", err, response)
@@ -953,7 +979,7 @@ func subnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 	}
 	_, response, err := sess.GetSubnet(getsubnetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Subnet: %s\n%s", err, response)
@@ -6,10 +6,13 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var isSubnetNetworkACLAttachmentLock = newParentLock("ibm_is_subnet_network_acl_attachment", "network_acl")
+
 const (
 	isSubnetID     = "subnet"
 	isNetworkACLID = "network_acl"
@@ -202,8 +205,12 @@ func resourceIBMISSubnetNetworkACLAttachmentCreate(d *schema.ResourceData, meta
 	replaceSubnetNetworkACLOptionsModel := new(vpcv1.ReplaceSubnetNetworkACLOptions)
 	replaceSubnetNetworkACLOptionsModel.ID = &subnet
 	replaceSubnetNetworkACLOptionsModel.NetworkACLIdentity = networkACLIdentityModel
-	resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
-
+	var resultACL *vpcv1.NetworkACL
+	var response *core.DetailedResponse
+	err = isSubnetNetworkACLAttachmentLock.mutate(d.Timeout(schema.TimeoutCreate), func() error {
+		resultACL, response, err = sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
+		return responseError(response, err)
+	}, networkACL)
 	if err != nil {
 		log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
 		return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
@@ -228,7 +235,7 @@ func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta in
 	nwacl, response, err := sess.GetSubnetNetworkACL(getSubnetNetworkACLOptionsModel)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -341,8 +348,12 @@ func resourceIBMISSubnetNetworkACLAttachmentUpdate(d *schema.ResourceData, meta
 		replaceSubnetNetworkACLOptionsModel := new(vpcv1.ReplaceSubnetNetworkACLOptions)
 		replaceSubnetNetworkACLOptionsModel.ID = &subnet
 		replaceSubnetNetworkACLOptionsModel.NetworkACLIdentity = networkACLIdentityModel
-		resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
-
+		var resultACL *vpcv1.NetworkACL
+		var response *core.DetailedResponse
+		err = isSubnetNetworkACLAttachmentLock.mutate(d.Timeout(schema.TimeoutUpdate), func() error {
+			resultACL, response, err = sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
+			return responseError(response, err)
+		}, networkACL)
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
 			return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
@@ -368,7 +379,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 	}
 	subnet, response, err := sess.GetSubnet(getSubnetOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -382,7 +393,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 	}
 	vpc, response, err := sess.GetVPC(getvpcOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -400,8 +411,12 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 		replaceSubnetNetworkACLOptionsModel := new(vpcv1.ReplaceSubnetNetworkACLOptions)
 		replaceSubnetNetworkACLOptionsModel.ID = &id
 		replaceSubnetNetworkACLOptionsModel.NetworkACLIdentity = networkACLIdentityModel
-		resultACL, response, err := sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
-
+		var resultACL *vpcv1.NetworkACL
+		var response *core.DetailedResponse
+		err = isSubnetNetworkACLAttachmentLock.mutate(d.Timeout(schema.TimeoutDelete), func() error {
+			resultACL, response, err = sess.ReplaceSubnetNetworkACL(replaceSubnetNetworkACLOptionsModel)
+			return responseError(response, err)
+		}, *vpc.DefaultNetworkACL.ID)
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
 			return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
@@ -426,7 +441,7 @@ func resourceIBMISSubnetNetworkACLAttachmentExists(d *schema.ResourceData, meta
 	}
 	_, response, err := sess.GetSubnetNetworkACL(getSubnetNetworkACLOptionsModel)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting subnet's attached network ACL: %s\n%s", err, response)
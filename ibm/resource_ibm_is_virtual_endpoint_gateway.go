@@ -46,7 +46,7 @@ func resourceIBMISEndpointGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -338,7 +338,7 @@ func resourceIBMisVirtualEndpointGatewayExists(d *schema.ResourceData, meta inte
 	opt := sess.NewGetEndpointGatewayOptions(d.Id())
 	_, response, err := sess.GetEndpointGateway(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("Endpoint Gateway does not exist.")
 			return false, nil
 		}
@@ -23,13 +23,15 @@ const (
 	isVirtualEndpointGatewayIPTargetResourceType = "resource_type"
 )
 
+var isVirtualEndpointGatewayIPIDTemplate = newIDTemplate("ibm_is_virtual_endpoint_gateway_ip", "{gateway_id}/{ip_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+
 func resourceIBMISEndpointGatewayIP() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMisVirtualEndpointGatewayIPCreate,
 		Read:     resourceIBMisVirtualEndpointGatewayIPRead,
 		Delete:   resourceIBMisVirtualEndpointGatewayIPDelete,
 		Exists:   resourceIBMisVirtualEndpointGatewayIPExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isVirtualEndpointGatewayIPIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -161,7 +163,7 @@ func resourceIBMisVirtualEndpointGatewayIPDelete(d *schema.ResourceData, meta in
 	ipID := parts[1]
 	opt := sess.NewRemoveEndpointGatewayIPOptions(gatewayID, ipID)
 	response, err := sess.RemoveEndpointGatewayIP(opt)
-	if err != nil && response.StatusCode != 404 {
+	if err != nil && !isNotFound(responseError(response, err)) {
 		log.Printf("Remove Endpoint Gateway IP failed: %v", response)
 		return err
 	}
@@ -184,7 +186,7 @@ func resourceIBMisVirtualEndpointGatewayIPExists(d *schema.ResourceData, meta in
 	opt := sess.NewGetEndpointGatewayIPOptions(gatewayID, ipID)
 	_, response, err := sess.GetEndpointGatewayIP(opt)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			log.Printf("Endpoint Gateway IP does not exist.")
 			return false, nil
 		}
@@ -46,7 +46,7 @@ func resourceIBMISVolume() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -352,7 +352,7 @@ func classicVolGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	vol, response, err := sess.GetVolume(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -400,7 +400,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	vol, response, err := sess.GetVolume(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -578,7 +578,7 @@ func classicVolDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	_, response, err := sess.GetVolume(getvoloptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting Volume (%s): %s\n%s", id, err, response)
@@ -610,7 +610,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetVolume(getvoloptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting Volume (%s): %s\n%s", id, err, response)
@@ -632,18 +632,17 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 }
 
 func isWaitForClassicVolumeDeleted(vol *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for  (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVolumeDeleting},
-		Target:     []string{"done", ""},
-		Refresh:    isClassicVolumeDeleteRefreshFunc(vol, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("volume %s to be deleted", id),
+		Pending:         []string{"retry", isVolumeDeleting},
+		Target:          []string{"done", ""},
+		Status:          refreshStatus(isClassicVolumeDeleteRefreshFunc(vol, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicVolumeDeleteRefreshFunc(vol *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -653,7 +652,7 @@ func isClassicVolumeDeleteRefreshFunc(vol *vpcclassicv1.VpcClassicV1, id string)
 		}
 		vol, response, err := vol.GetVolume(volgetoptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return vol, isVolumeDeleted, nil
 			}
 			return vol, "", fmt.Errorf("Error Getting Volume: %s\n%s", err, response)
@@ -663,18 +662,17 @@ func isClassicVolumeDeleteRefreshFunc(vol *vpcclassicv1.VpcClassicV1, id string)
 }
 
 func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for  (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVolumeDeleting},
-		Target:     []string{"done", ""},
-		Refresh:    isVolumeDeleteRefreshFunc(vol, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("volume %s to be deleted", id),
+		Pending:         []string{"retry", isVolumeDeleting},
+		Target:          []string{"done", ""},
+		Status:          refreshStatus(isVolumeDeleteRefreshFunc(vol, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -684,7 +682,7 @@ func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefres
 		}
 		vol, response, err := vol.GetVolume(volgetoptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return vol, isVolumeDeleted, nil
 			}
 			return vol, "", fmt.Errorf("Error Getting Volume: %s\n%s", err, response)
@@ -719,7 +717,7 @@ func classicVolExists(d *schema.ResourceData, meta interface{}, id string) (bool
 	}
 	_, response, err := sess.GetVolume(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Volume: %s\n%s", err, response)
@@ -737,7 +735,7 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	}
 	_, response, err := sess.GetVolume(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Volume: %s\n%s", err, response)
@@ -746,18 +744,17 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 }
 
 func isWaitForClassicVolumeAvailable(client *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for Volume (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVolumeProvisioning},
-		Target:     []string{isVolumeProvisioningDone, ""},
-		Refresh:    isClassicVolumeRefreshFunc(client, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("volume %s to be available", id),
+		Pending:         []string{"retry", isVolumeProvisioning},
+		Target:          []string{isVolumeProvisioningDone, ""},
+		Status:          refreshStatus(isClassicVolumeRefreshFunc(client, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicVolumeRefreshFunc(client *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -779,18 +776,17 @@ func isClassicVolumeRefreshFunc(client *vpcclassicv1.VpcClassicV1, id string) re
 }
 
 func isWaitForVolumeAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for Volume (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVolumeProvisioning},
-		Target:     []string{isVolumeProvisioningDone, ""},
-		Refresh:    isVolumeRefreshFunc(client, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("volume %s to be available", id),
+		Pending:         []string{"retry", isVolumeProvisioning},
+		Target:          []string{isVolumeProvisioningDone, ""},
+		Status:          refreshStatus(isVolumeRefreshFunc(client, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -66,7 +66,7 @@ func resourceIBMISVPC() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -419,18 +419,18 @@ func classicVpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg st
 }
 
 func isWaitForClassicVPCAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for VPC (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isVPCPending},
-		Target:     []string{isVPCAvailable, isVPCFailed},
-		Refresh:    isClassicVPCRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-
-	return stateConf.WaitForState()
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("VPC %s to be available", id),
+		Pending:         []string{isVPCPending},
+		Target:          []string{isVPCAvailable},
+		Failed:          []string{isVPCFailed},
+		Status:          refreshStatus(isClassicVPCRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+	}
+
+	return waiter.wait()
 }
 
 func isClassicVPCRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -492,18 +492,18 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 }
 
 func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for VPC (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isVPCPending},
-		Target:     []string{isVPCAvailable, isVPCFailed},
-		Refresh:    isVPCRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-
-	return stateConf.WaitForState()
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("VPC %s to be available", id),
+		Pending:         []string{isVPCPending},
+		Target:          []string{isVPCAvailable},
+		Failed:          []string{isVPCFailed},
+		Status:          refreshStatus(isVPCRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+	}
+
+	return waiter.wait()
 }
 
 func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -554,7 +554,7 @@ func classicVpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	vpc, response, err := sess.GetVPC(getvpcOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -774,7 +774,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	vpc, response, err := sess.GetVPC(getvpcOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1122,7 +1122,7 @@ func classicVpcDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	_, response, err := sess.GetVPC(getVpcOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1157,7 +1157,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetVPC(getVpcOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -1180,18 +1180,18 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 }
 
 func isWaitForClassicVPCDeleted(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for VPC (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPCDeleting},
-		Target:     []string{isVPCDeleted, isVPCFailed},
-		Refresh:    isClassicVPCDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-
-	return stateConf.WaitForState()
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("VPC %s to be deleted", id),
+		Pending:         []string{"retry", isVPCDeleting},
+		Target:          []string{isVPCDeleted},
+		Failed:          []string{isVPCFailed},
+		Status:          refreshStatus(isClassicVPCDeleteRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+	}
+
+	return waiter.wait()
 }
 
 func isClassicVPCDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -1202,7 +1202,7 @@ func isClassicVPCDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) re
 		}
 		vpc, response, err := vpc.GetVPC(getvpcOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return vpc, isVPCDeleted, nil
 			}
 			return nil, isVPCFailed, fmt.Errorf("The VPC %s failed to delete: %s\n%s", id, err, response)
@@ -1213,18 +1213,18 @@ func isClassicVPCDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) re
 }
 
 func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for VPC (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPCDeleting},
-		Target:     []string{isVPCDeleted, isVPCFailed},
-		Refresh:    isVPCDeleteRefreshFunc(vpc, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-
-	return stateConf.WaitForState()
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("VPC %s to be deleted", id),
+		Pending:         []string{"retry", isVPCDeleting},
+		Target:          []string{isVPCDeleted},
+		Failed:          []string{isVPCFailed},
+		Status:          refreshStatus(isVPCDeleteRefreshFunc(vpc, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+	}
+
+	return waiter.wait()
 }
 
 func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1235,7 +1235,7 @@ func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFu
 		}
 		vpc, response, err := vpc.GetVPC(getvpcOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return vpc, isVPCDeleted, nil
 			}
 			return nil, isVPCFailed, fmt.Errorf("The VPC %s failed to delete: %s\n%s", id, err, response)
@@ -1270,7 +1270,7 @@ func classicVpcExists(d *schema.ResourceData, meta interface{}, id string) (bool
 	}
 	_, response, err := sess.GetVPC(getvpcOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting VPC: %s\n%s", err, response)
@@ -1289,7 +1289,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	}
 	_, response, err := sess.GetVPC(getvpcOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting VPC: %s\n%s", err, response)
@@ -3,6 +3,7 @@ package ibm
 import (
 	"fmt"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -16,6 +17,9 @@ const (
 	isVPCAddressPrefixHasSubnets = "has_subnets"
 )
 
+var isVPCAddressPrefixIDTemplate = newIDTemplate("ibm_is_vpc_address_prefix", "{vpc_id}/{address_prefix_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+var isVPCAddressPrefixLock = newParentLock("ibm_is_vpc_address_prefix", "vpc")
+
 func resourceIBMISVpcAddressPrefix() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISVpcAddressPrefixCreate,
@@ -23,7 +27,7 @@ func resourceIBMISVpcAddressPrefix() *schema.Resource {
 		Update:   resourceIBMISVpcAddressPrefixUpdate,
 		Delete:   resourceIBMISVpcAddressPrefixDelete,
 		Exists:   resourceIBMISVpcAddressPrefixExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isVPCAddressPrefixIDTemplate.importer(),
 
 		Schema: map[string]*schema.Schema{
 			isVPCAddressPrefixPrefixName: {
@@ -84,7 +88,7 @@ func resourceIBMISAddressPrefixValidator() *ResourceValidator {
 			MaxValueLength:             63})
 	validateSchema = append(validateSchema,
 		ValidateSchema{
-			Identifier:                 isVPCRouteDestinationCIDR,
+			Identifier:                 isVPCAddressPrefixCIDR,
 			ValidateFunctionIdentifier: ValidateCIDRAddress,
 			Type:                       TypeString,
 			ForceNew:                   true,
@@ -105,9 +109,8 @@ func resourceIBMISVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{
 	cidr := d.Get(isVPCAddressPrefixCIDR).(string)
 	vpcID := d.Get(isVPCAddressPrefixVPCID).(string)
 
-	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	ibmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer ibmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock := isVPCAddressPrefixLock.lock(vpcID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classicVpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID)
@@ -136,7 +139,12 @@ func classicVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, nam
 			Name: &zone,
 		},
 	}
-	addrPrefix, response, err := sess.CreateVPCAddressPrefix(options)
+	var addrPrefix *vpcclassicv1.AddressPrefix
+	var response *core.DetailedResponse
+	err = isVPCAddressPrefixLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		addrPrefix, response, err = sess.CreateVPCAddressPrefix(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error while creating VPC Address Prefix %s\n%s", err, response)
 	}
@@ -160,7 +168,12 @@ func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone
 			Name: &zone,
 		},
 	}
-	addrPrefix, response, err := sess.CreateVPCAddressPrefix(options)
+	var addrPrefix *vpcv1.AddressPrefix
+	var response *core.DetailedResponse
+	err = isVPCAddressPrefixLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		addrPrefix, response, err = sess.CreateVPCAddressPrefix(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error while creating VPC Address Prefix %s\n%s", err, response)
 	}
@@ -208,7 +221,7 @@ func classicVpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID,
 	}
 	addrPrefix, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -244,7 +257,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 	}
 	addrPrefix, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -285,9 +298,8 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 	vpcID := parts[0]
 	addrPrefixID := parts[1]
 
-	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	ibmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer ibmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock := isVPCAddressPrefixLock.lock(vpcID)
+	defer unlock()
 
 	if d.HasChange(isVPCAddressPrefixPrefixName) {
 		name = d.Get(isVPCAddressPrefixPrefixName).(string)
@@ -327,7 +339,11 @@ func classicVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpc
 			return fmt.Errorf("Error calling asPatch for AddressPrefixPatch: %s", err)
 		}
 		updatevpcAddressPrefixoptions.AddressPrefixPatch = addressPrefixPatch
-		_, response, err := sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
+		var response *core.DetailedResponse
+		err = isVPCAddressPrefixLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating VPC Address Prefix: %s\n%s", err, response)
 		}
@@ -353,7 +369,11 @@ func vpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, add
 			return fmt.Errorf("Error calling asPatch for AddressPrefixPatch: %s", err)
 		}
 		updatevpcAddressPrefixoptions.AddressPrefixPatch = addressPrefixPatch
-		_, response, err := sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
+		var response *core.DetailedResponse
+		err = isVPCAddressPrefixLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error Updating VPC Address Prefix: %s\n%s", err, response)
 		}
@@ -374,9 +394,8 @@ func resourceIBMISVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{
 	vpcID := parts[0]
 	addrPrefixID := parts[1]
 
-	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	ibmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer ibmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock := isVPCAddressPrefixLock.lock(vpcID)
+	defer unlock()
 
 	if userDetails.generation == 1 {
 		err := classicVpcAddressPrefixDelete(d, meta, vpcID, addrPrefixID)
@@ -406,7 +425,7 @@ func classicVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpc
 	}
 	_, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
@@ -415,9 +434,12 @@ func classicVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpc
 		VPCID: &vpcID,
 		ID:    &addrPrefixID,
 	}
-	response, err = sess.DeleteVPCAddressPrefix(deletevpcAddressPrefixOptions)
+	err = isVPCAddressPrefixLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteVPCAddressPrefix(deletevpcAddressPrefixOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Deleting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
@@ -438,7 +460,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 	}
 	_, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
@@ -448,9 +470,12 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		VPCID: &vpcID,
 		ID:    &addrPrefixID,
 	}
-	response, err = sess.DeleteVPCAddressPrefix(deletevpcAddressPrefixOptions)
+	err = isVPCAddressPrefixLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteVPCAddressPrefix(deletevpcAddressPrefixOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Deleting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
@@ -491,7 +516,7 @@ func classicVpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpc
 	}
 	_, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting VPC Address Prefix: %s\n%s", err, response)
@@ -510,7 +535,7 @@ func vpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpcID, add
 	}
 	_, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting VPC Address Prefix: %s\n%s", err, response)
@@ -2,12 +2,10 @@ package ibm
 
 import (
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -28,6 +26,8 @@ const (
 	isRouteStatusDeleted  = "deleted"
 )
 
+var isVPCRouteIDTemplate = newIDTemplate("ibm_is_vpc_route", "{vpc_id}/{route_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+
 func resourceIBMISVpcRoute() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISVpcRouteCreate,
@@ -35,7 +35,7 @@ func resourceIBMISVpcRoute() *schema.Resource {
 		Update:   resourceIBMISVpcRouteUpdate,
 		Delete:   resourceIBMISVpcRouteDelete,
 		Exists:   resourceIBMISVpcRouteExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isVPCRouteIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -206,10 +206,12 @@ func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneNam
 
 func isWaitForClassicRouteStable(sess *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isRouteStatusPending, isRouteStatusUpdating},
-		Target:  []string{isRouteStatusStable, isRouteStatusFailed},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("route %s of the VPC %s to be stable", routeID, vpcID),
+		Pending:     []string{isRouteStatusPending, isRouteStatusUpdating},
+		Target:      []string{isRouteStatusStable},
+		Failed:      []string{isRouteStatusFailed},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			getVpcRouteOptions := &vpcclassicv1.GetVPCRouteOptions{
 				VPCID: &vpcID,
 				ID:    &routeID,
@@ -223,21 +225,23 @@ func isWaitForClassicRouteStable(sess *vpcclassicv1.VpcClassicV1, d *schema.Reso
 				return route, *route.LifecycleState, nil
 			}
 			return route, *route.LifecycleState, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutCreate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{isRouteStatusPending, isRouteStatusUpdating},
-		Target:  []string{isRouteStatusStable, isRouteStatusFailed},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("route %s of the VPC %s to be stable", routeID, vpcID),
+		Pending:     []string{isRouteStatusPending, isRouteStatusUpdating},
+		Target:      []string{isRouteStatusStable},
+		Failed:      []string{isRouteStatusFailed},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			getVpcRouteOptions := &vpcv1.GetVPCRouteOptions{
 				VPCID: &vpcID,
 				ID:    &routeID,
@@ -251,13 +255,13 @@ func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, rout
 				return route, *route.LifecycleState, nil
 			}
 			return route, *route.LifecycleState, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         d.Timeout(schema.TimeoutCreate),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func resourceIBMISVpcRouteRead(d *schema.ResourceData, meta interface{}) error {
@@ -297,7 +301,7 @@ func classicVpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID
 	}
 	route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -334,7 +338,7 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 	}
 	route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -488,7 +492,7 @@ func classicVpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, rout
 	}
 	_, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
@@ -521,7 +525,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 	}
 	_, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
@@ -544,59 +548,61 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 
 func isWaitForClassicVPCRouteDeleted(sess *vpcclassicv1.VpcClassicV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
 
-	log.Printf("Waiting for VPC Route (%s) to be deleted.", routeID)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"retry", isRouteStatusDeleting},
-		Target:  []string{isRouteStatusDeleted, isRouteStatusFailed},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("route %s of the VPC %s to be deleted", routeID, vpcID),
+		Pending:     []string{"retry", isRouteStatusDeleting},
+		Target:      []string{isRouteStatusDeleted},
+		Failed:      []string{isRouteStatusFailed},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			getVpcRouteOptions := &vpcclassicv1.GetVPCRouteOptions{
 				VPCID: &vpcID,
 				ID:    &routeID,
 			}
 			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 			if err != nil {
-				if response != nil && response.StatusCode == 404 {
+				if isNotFound(responseError(response, err)) {
 					return route, isRouteStatusDeleted, nil
 				}
 				return route, isRouteStatusDeleting, fmt.Errorf("The VPC route %s failed to delete: %s\n%s", routeID, err, response)
 			}
 
 			return route, isRouteStatusDeleting, nil
-		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
 
-	log.Printf("Waiting for VPC Route (%s) to be deleted.", routeID)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"retry", isRouteStatusDeleting},
-		Target:  []string{isRouteStatusDeleted, isRouteStatusFailed},
-		Refresh: func() (interface{}, string, error) {
+	waiter := &asyncWaiter{
+		Description: fmt.Sprintf("route %s of the VPC %s to be deleted", routeID, vpcID),
+		Pending:     []string{"retry", isRouteStatusDeleting},
+		Target:      []string{isRouteStatusDeleted},
+		Failed:      []string{isRouteStatusFailed},
+		Status: refreshStatus(func() (interface{}, string, error) {
 			getVpcRouteOptions := &vpcv1.GetVPCRouteOptions{
 				VPCID: &vpcID,
 				ID:    &routeID,
 			}
 			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 			if err != nil {
-				if response != nil && response.StatusCode == 404 {
+				if isNotFound(responseError(response, err)) {
 					return route, isRouteStatusDeleted, nil
 				}
 				return route, isRouteStatusDeleting, fmt.Errorf("The VPC route %s failed to delete: %s\n%s", routeID, err, response)
 			}
 			return route, isRouteStatusDeleting, nil
-		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		}),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func resourceIBMISVpcRouteExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -631,7 +637,7 @@ func classicVpcRouteExists(d *schema.ResourceData, meta interface{}, vpcID, rout
 	}
 	_, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting VPC Route: %s\n%s", err, response)
@@ -650,7 +656,7 @@ func vpcRouteExists(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 	}
 	_, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting VPC Route: %s\n%s", err, response)
@@ -204,7 +204,7 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 	getVpcRoutingTableOptions := sess.NewGetVPCRoutingTableOptions(idSet[0], idSet[1])
 	routeTable, response, err := sess.GetVPCRoutingTable(getVpcRoutingTableOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -289,7 +289,7 @@ func resourceIBMISVPCRoutingTableDelete(d *schema.ResourceData, meta interface{}
 
 	deleteTableOptions := sess.NewDeleteVPCRoutingTableOptions(idSet[0], idSet[1])
 	response, err := sess.DeleteVPCRoutingTable(deleteTableOptions)
-	if err != nil && response.StatusCode != 404 {
+	if err != nil && !isNotFound(responseError(response, err)) {
 		log.Printf("Error deleting VPC Routing table : %s", response)
 		return err
 	}
@@ -308,7 +308,7 @@ func resourceIBMISVPCRoutingTableExists(d *schema.ResourceData, meta interface{}
 	getVpcRoutingTableOptions := sess.NewGetVPCRoutingTableOptions(idSet[0], idSet[1])
 	_, response, err := sess.GetVPCRoutingTable(getVpcRoutingTableOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return false, nil
 		}
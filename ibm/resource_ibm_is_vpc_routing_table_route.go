@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var isVPCRoutingTableRouteLock = newParentLock("ibm_is_vpc_routing_table_route", "vpc_routing_table")
+
 const (
 	rID          = "route_id"
 	rDestination = "destination"
@@ -171,9 +173,17 @@ func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interf
 		createVpcRoutingTableRouteOptions.SetName(routeName)
 	}
 
-	route, response, err := sess.CreateVPCRoutingTableRoute(createVpcRoutingTableRouteOptions)
+	var route *vpcv1.Route
+	err = isVPCRoutingTableRouteLock.mutate(d.Timeout(schema.TimeoutCreate), func() error {
+		created, response, err := sess.CreateVPCRoutingTableRoute(createVpcRoutingTableRouteOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Create VPC Routing table route err %s\n%s", err, response)
+			return responseError(response, err)
+		}
+		route = created
+		return nil
+	}, vpcID, tableID)
 	if err != nil {
-		log.Printf("[DEBUG] Create VPC Routing table route err %s\n%s", err, response)
 		return err
 	}
 
@@ -192,7 +202,7 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 	getVpcRoutingTableRouteOptions := sess.NewGetVPCRoutingTableRouteOptions(idSet[0], idSet[1], idSet[2])
 	route, response, err := sess.GetVPCRoutingTableRoute(getVpcRoutingTableRouteOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -241,9 +251,15 @@ func resourceIBMISVPCRoutingTableRouteUpdate(d *schema.ResourceData, meta interf
 		}
 
 		updateVpcRoutingTableRouteOptions.RoutePatch = routePatchModelAsPatch
-		_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
+		err = isVPCRoutingTableRouteLock.mutate(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
+			if err != nil {
+				log.Printf("[DEBUG] Update VPC Routing table route err %s\n%s", err, response)
+				return responseError(response, err)
+			}
+			return nil
+		}, idSet[0], idSet[1])
 		if err != nil {
-			log.Printf("[DEBUG] Update VPC Routing table route err %s\n%s", err, response)
 			return err
 		}
 	}
@@ -259,9 +275,15 @@ func resourceIBMISVPCRoutingTableRouteDelete(d *schema.ResourceData, meta interf
 
 	idSet := strings.Split(d.Id(), "/")
 	deleteVpcRoutingTableRouteOptions := sess.NewDeleteVPCRoutingTableRouteOptions(idSet[0], idSet[1], idSet[2])
-	response, err := sess.DeleteVPCRoutingTableRoute(deleteVpcRoutingTableRouteOptions)
-	if err != nil && response.StatusCode != 404 {
-		log.Printf("Error deleting VPC Routing table route : %s", response)
+	err = isVPCRoutingTableRouteLock.mutate(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err := sess.DeleteVPCRoutingTableRoute(deleteVpcRoutingTableRouteOptions)
+		if err != nil && !isNotFound(responseError(response, err)) {
+			log.Printf("Error deleting VPC Routing table route : %s", response)
+			return responseError(response, err)
+		}
+		return nil
+	}, idSet[0], idSet[1])
+	if err != nil {
 		return err
 	}
 
@@ -279,7 +301,7 @@ func resourceIBMISVPCRoutingTableRouteExists(d *schema.ResourceData, meta interf
 	getVpcRoutingTableRouteOptions := sess.NewGetVPCRoutingTableRouteOptions(idSet[0], idSet[1], idSet[2])
 	_, response, err := sess.GetVPCRoutingTableRoute(getVpcRoutingTableRouteOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return false, nil
 		}
@@ -3,12 +3,16 @@ package ibm
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
@@ -212,3 +216,99 @@ func testAccCheckIBMISVPCSgConfig(vpcname string, sgname string) string {
 `, vpcname, sgname)
 
 }
+
+func TestUnitIBMISVPC_basic(t *testing.T) {
+	cloud := newFakeCloud(t)
+	defer cloud.close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    cloud.providers(),
+		CheckDestroy: testUnitCheckIBMISVPCDestroy(cloud),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCConfig("fake-vpc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "name", "fake-vpc"),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "status", isVPCAvailable),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "resource_group", fakeResourceGroupID),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "tags.#", "2"),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "security_group.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_is_vpc.testacc_vpc", "default_security_group"),
+					testUnitCheckIBMISVPCTags(cloud, "ibm_is_vpc.testacc_vpc", "tag1", "tag2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCConfigUpdate("fake-vpc-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "name", "fake-vpc-renamed"),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "tags.#", "1"),
+					testUnitCheckIBMISVPCTags(cloud, "ibm_is_vpc.testacc_vpc", "tag1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc.testacc_vpc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The address prefix management is only set on create
+				ImportStateVerifyIgnore: []string{isVPCAddressPrefixManagement},
+			},
+		},
+	})
+}
+
+func TestUnitIBMISVPC_createError(t *testing.T) {
+	cloud := newFakeCloud(t)
+	defer cloud.close()
+	cloud.once(http.MethodPost, "/vpc/vpcs", func(r *fakeRequest) (int, interface{}) {
+		return http.StatusBadRequest, fakeVPCError("vpc_quota_exceeded", "The VPC quota is exceeded")
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    cloud.providers(),
+		CheckDestroy: testUnitCheckIBMISVPCDestroy(cloud),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISVPCConfig("fake-vpc"),
+				ExpectError: regexp.MustCompile("The VPC quota is exceeded"),
+			},
+		},
+	})
+}
+
+func TestUnitIBMISVPCRead_notFound(t *testing.T) {
+	cloud := newFakeCloud(t)
+	defer cloud.close()
+
+	d := schema.TestResourceDataRaw(t, resourceIBMISVPC().Schema, map[string]interface{}{"name": "fake-vpc"})
+	d.SetId("r006-deleted")
+	if err := resourceIBMISVPCRead(d, cloud.session()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected the VPC deleted out of band to be removed from the state, got %q", d.Id())
+	}
+}
+
+func testUnitCheckIBMISVPCDestroy(cloud *fakeCloud) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type == "ibm_is_vpc" && cloud.vpc.get(rs.Primary.ID) != nil {
+				return fmt.Errorf("VPC still exists: %s", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testUnitCheckIBMISVPCTags(cloud *fakeCloud, n string, tags ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if attached := cloud.tagging.tags(rs.Primary.Attributes["crn"]); !reflect.DeepEqual(attached, tags) {
+			return fmt.Errorf("Expected the tags %v to be attached to the VPC, got %v", tags, attached)
+		}
+		return nil
+	}
+}
@@ -46,7 +46,7 @@ func resourceIBMISVPNGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -315,18 +315,17 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 }
 
 func isWaitForClassicVpnGatewayAvailable(vpnGateway *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for vpn gateway (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayProvisioning},
-		Target:     []string{isVPNGatewayProvisioningDone, ""},
-		Refresh:    isClassicVpnGatewayRefreshFunc(vpnGateway, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("VPN gateway %s to be available", id),
+		Pending:         []string{"retry", isVPNGatewayProvisioning},
+		Target:          []string{isVPNGatewayProvisioningDone, ""},
+		Status:          refreshStatus(isClassicVpnGatewayRefreshFunc(vpnGateway, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicVpnGatewayRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -349,18 +348,17 @@ func isClassicVpnGatewayRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id st
 }
 
 func isWaitForVpnGatewayAvailable(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for vpn gateway (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayProvisioning},
-		Target:     []string{isVPNGatewayProvisioningDone, ""},
-		Refresh:    isVpnGatewayRefreshFunc(vpnGateway, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("VPN gateway %s to be available", id),
+		Pending:         []string{"retry", isVPNGatewayProvisioning},
+		Target:          []string{isVPNGatewayProvisioningDone, ""},
+		Status:          refreshStatus(isVpnGatewayRefreshFunc(vpnGateway, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -413,7 +411,7 @@ func classicVpngwGet(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -464,7 +462,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -665,7 +663,7 @@ func classicVpngwDelete(d *schema.ResourceData, meta interface{}, id string) err
 	}
 	_, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
@@ -697,7 +695,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	_, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
@@ -719,18 +717,17 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 }
 
 func isWaitForClassicVpnGatewayDeleted(vpnGateway *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for VPNGateway (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayDeleting},
-		Target:     []string{isVPNGatewayDeleted, ""},
-		Refresh:    isClassicVpnGatewayDeleteRefreshFunc(vpnGateway, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("VPN gateway %s to be deleted", id),
+		Pending:         []string{"retry", isVPNGatewayDeleting},
+		Target:          []string{isVPNGatewayDeleted, ""},
+		Status:          refreshStatus(isClassicVpnGatewayDeleteRefreshFunc(vpnGateway, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicVpnGatewayDeleteRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -740,7 +737,7 @@ func isClassicVpnGatewayDeleteRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1,
 		}
 		vpngw, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return "", isVPNGatewayDeleted, nil
 			}
 			return "", "", fmt.Errorf("Error Getting Vpn Gateway: %s\n%s", err, response)
@@ -750,18 +747,17 @@ func isClassicVpnGatewayDeleteRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1,
 }
 
 func isWaitForVpnGatewayDeleted(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for VPNGateway (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayDeleting},
-		Target:     []string{isVPNGatewayDeleted, ""},
-		Refresh:    isVpnGatewayDeleteRefreshFunc(vpnGateway, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("VPN gateway %s to be deleted", id),
+		Pending:         []string{"retry", isVPNGatewayDeleting},
+		Target:          []string{isVPNGatewayDeleted, ""},
+		Status:          refreshStatus(isVpnGatewayDeleteRefreshFunc(vpnGateway, id)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -771,7 +767,7 @@ func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.
 		}
 		vpngw, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return "", isVPNGatewayDeleted, nil
 			}
 			return "", "", fmt.Errorf("Error Getting Vpn Gateway: %s\n%s", err, response)
@@ -805,7 +801,7 @@ func classicVpngwExists(d *schema.ResourceData, meta interface{}, id string) (bo
 	}
 	_, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Vpn Gatewa: %s\n%s", err, response)
@@ -823,7 +819,7 @@ func vpngwExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 	}
 	_, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Vpn Gatewa: %s\n%s", err, response)
@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -36,6 +37,9 @@ const (
 	isVPNGatewayConnectionCreatedat                 = "created_at"
 )
 
+var isVPNGatewayConnectionIDTemplate = newIDTemplate("ibm_is_vpn_gateway_connection", "{gateway_id}/{connection_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+var isVPNGatewayConnectionLock = newParentLock("ibm_is_vpn_gateway_connection", "vpn_gateway")
+
 func resourceIBMISVPNGatewayConnection() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISVPNGatewayConnectionCreate,
@@ -43,7 +47,7 @@ func resourceIBMISVPNGatewayConnection() *schema.Resource {
 		Update:   resourceIBMISVPNGatewayConnectionUpdate,
 		Delete:   resourceIBMISVPNGatewayConnectionDelete,
 		Exists:   resourceIBMISVPNGatewayConnectionExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: isVPNGatewayConnectionIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -272,6 +276,8 @@ func resourceIBMISVPNGatewayConnectionCreate(d *schema.ResourceData, meta interf
 		action = "none"
 	}
 
+	unlock := isVPNGatewayConnectionLock.lock(gatewayID)
+	defer unlock()
 	if userDetails.generation == 1 {
 		err := classicVpngwconCreate(d, meta, name, gatewayID, peerAddress, prephasedKey, action, interval, timeout, stateUp)
 		if err != nil {
@@ -331,7 +337,12 @@ func classicVpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatew
 		}
 	}
 
-	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
+	var vpnGatewayConnectionIntf vpcclassicv1.VPNGatewayConnectionIntf
+	var response *core.DetailedResponse
+	err = isVPNGatewayConnectionLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		vpnGatewayConnectionIntf, response, err = sess.CreateVPNGatewayConnection(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
 	}
@@ -391,7 +402,12 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 		vpnGatewayConnectionPrototypeModel.IpsecPolicy = nil
 	}
 
-	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
+	var vpnGatewayConnectionIntf vpcv1.VPNGatewayConnectionIntf
+	var response *core.DetailedResponse
+	err = isVPNGatewayConnectionLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		vpnGatewayConnectionIntf, response, err = sess.CreateVPNGatewayConnection(options)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
 	}
@@ -440,7 +456,7 @@ func classicVpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID s
 	}
 	vpnGatewayConnectionIntf, response, err := sess.GetVPNGatewayConnection(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -487,7 +503,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	}
 	vpnGatewayConnectionIntf, response, err := sess.GetVPNGatewayConnection(options)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -574,6 +590,8 @@ func resourceIBMISVPNGatewayConnectionUpdate(d *schema.ResourceData, meta interf
 	gID := parts[0]
 	gConnID := parts[1]
 
+	unlock := isVPNGatewayConnectionLock.lock(gID)
+	defer unlock()
 	if userDetails.generation == 1 {
 		err := classicVpngwconUpdate(d, meta, gID, gConnID, hasChanged)
 		if err != nil {
@@ -659,7 +677,11 @@ func classicVpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnI
 			return fmt.Errorf("Error calling asPatch for VPNGatewayConnectionPatch: %s", err)
 		}
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
-		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
+		var response *core.DetailedResponse
+		err = isVPNGatewayConnectionLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error updating Vpn Gateway Connection: %s\n%s", err, response)
 		}
@@ -742,7 +764,11 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 			return fmt.Errorf("Error calling asPatch for VPNGatewayConnectionPatch: %s", err)
 		}
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
-		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
+		var response *core.DetailedResponse
+		err = isVPNGatewayConnectionLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, response, err = sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
+			return responseError(response, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error updating Vpn Gateway Connection: %s\n%s", err, response)
 		}
@@ -764,6 +790,8 @@ func resourceIBMISVPNGatewayConnectionDelete(d *schema.ResourceData, meta interf
 	gID := parts[0]
 	gConnID := parts[1]
 
+	unlock := isVPNGatewayConnectionLock.lock(gID)
+	defer unlock()
 	if userDetails.generation == 1 {
 		err := classicVpngwconDelete(d, meta, gID, gConnID)
 		if err != nil {
@@ -790,7 +818,7 @@ func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnI
 	_, response, err := sess.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -800,7 +828,10 @@ func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnI
 		VPNGatewayID: &gID,
 		ID:           &gConnID,
 	}
-	response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
+	err = isVPNGatewayConnectionLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
 	}
@@ -827,7 +858,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	_, response, err := sess.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -838,7 +869,10 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		VPNGatewayID: &gID,
 		ID:           &gConnID,
 	}
-	response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
+	err = isVPNGatewayConnectionLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
 	}
@@ -854,18 +888,17 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 }
 
 func isWaitForClassicVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcclassicv1.VpcClassicV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayConnectionDeleting},
-		Target:     []string{"", isVPNGatewayConnectionDeleted},
-		Refresh:    isClassicVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection, gID, gConnID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("connection %s of the VPN gateway %s to be deleted", gConnID, gID),
+		Pending:         []string{"retry", isVPNGatewayConnectionDeleting},
+		Target:          []string{"", isVPNGatewayConnectionDeleted},
+		Status:          refreshStatus(isClassicVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection, gID, gConnID)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isClassicVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcclassicv1.VpcClassicV1, gID, gConnID string) resource.StateRefreshFunc {
@@ -876,7 +909,7 @@ func isClassicVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpccla
 		}
 		vpngwcon, response, err := vpnGatewayConnection.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return "", isVPNGatewayConnectionDeleted, nil
 			}
 			return "", "", fmt.Errorf("The Vpn Gateway Connection %s failed to delete: %s\n%s", gConnID, err, response)
@@ -886,18 +919,17 @@ func isClassicVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpccla
 }
 
 func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isVPNGatewayConnectionDeleting},
-		Target:     []string{"", isVPNGatewayConnectionDeleted},
-		Refresh:    isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection, gID, gConnID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("connection %s of the VPN gateway %s to be deleted", gConnID, gID),
+		Pending:         []string{"retry", isVPNGatewayConnectionDeleting},
+		Target:          []string{"", isVPNGatewayConnectionDeleted},
+		Status:          refreshStatus(isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection, gID, gConnID)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) resource.StateRefreshFunc {
@@ -908,7 +940,7 @@ func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1,
 		}
 		vpngwcon, response, err := vpnGatewayConnection.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return "", isVPNGatewayConnectionDeleted, nil
 			}
 			return "", "", fmt.Errorf("The Vpn Gateway Connection %s failed to delete: %s\n%s", gConnID, err, response)
@@ -950,7 +982,7 @@ func classicVpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnI
 	}
 	_, response, err := sess.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Vpn Gateway Connection: %s\n%s", err, response)
@@ -970,7 +1002,7 @@ func vpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	}
 	_, response, err := sess.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error getting Vpn Gateway Connection: %s\n%s", err, response)
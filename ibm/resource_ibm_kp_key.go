@@ -262,8 +262,7 @@ func resourceIBMKeyExists(d *schema.ResourceData, meta interface{}) (bool, error
 	// keyid := d.Id()
 	_, err = api.GetKey(context.Background(), keyid)
 	if err != nil {
-		kpError := err.(*kp.Error)
-		if kpError.StatusCode == 404 {
+		if isNotFound(err) {
 			return false, nil
 		}
 		return false, err
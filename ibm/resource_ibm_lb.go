@@ -383,10 +383,8 @@ func resourceIBMLbExists(d *schema.ResourceData, meta interface{}) (bool, error)
 		GetObject()
 
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -26,6 +26,12 @@ func resourceIBMLbService() *schema.Resource {
 		Exists:   resourceIBMLbServiceExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"service_group_id": {
 				Type:        schema.TypeInt,
@@ -132,7 +138,7 @@ func resourceIBMLbServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Println("[INFO] Creating load balancer service")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("Error creating load balancer service: %s", err)
@@ -219,7 +225,7 @@ func resourceIBMLbServiceUpdate(d *schema.ResourceData, meta interface{}) error
 
 	log.Println("[INFO] Updating load balancer service")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		return fmt.Errorf("Error updating load balancer service: %s", err)
@@ -273,7 +279,7 @@ func resourceIBMLbServiceDelete(d *schema.ResourceData, meta interface{}) error
 					strings.Contains(apiErr.Message, "The resource '480' is already in use."):
 					// The LB is busy with another transaction. Retry
 					return false, "pending", nil
-				case apiErr.StatusCode == 404 || // 404 - service was deleted on the previous attempt
+				case isNotFound(err) || // 404 - service was deleted on the previous attempt
 					strings.Contains(apiErr.Message, "Unable to find object with id"): // xmlrpc returns 200 instead of 404
 					return true, "complete", nil
 				default:
@@ -284,7 +290,7 @@ func resourceIBMLbServiceDelete(d *schema.ResourceData, meta interface{}) error
 
 			return true, "complete", nil
 		},
-		Timeout:    10 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -309,10 +315,8 @@ func resourceIBMLbServiceExists(d *schema.ResourceData, meta interface{}) (bool,
 		GetObject()
 
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -338,7 +342,7 @@ func getHealthCheckTypeId(sess *session.Session, healthCheckTypeName string) (in
 	return *healthCheckTypes[0].Id, nil
 }
 
-func updateLoadBalancerService(sess *session.Session, vipID int, vip *datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) error {
+func updateLoadBalancerService(sess *session.Session, vipID int, vip *datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -362,7 +366,7 @@ func updateLoadBalancerService(sess *session.Session, vipID int, vip *datatypes.
 
 			return true, "complete", nil
 		},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -27,6 +27,12 @@ func resourceIBMLbServiceGroup() *schema.Resource {
 		Exists:   resourceIBMLbServiceGroupExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"virtual_server_id": {
 				Type:        schema.TypeInt,
@@ -116,7 +122,7 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Creating load balancer service group")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("Error creating load balancer service group: %s", err)
@@ -182,7 +188,7 @@ func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Updating load balancer service group")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		return fmt.Errorf("Error creating load balancer service group: %s", err)
@@ -238,7 +244,7 @@ func resourceIBMLbServiceGroupDelete(d *schema.ResourceData, meta interface{}) e
 					strings.Contains(apiErr.Message, "The resource '480' is already in use."):
 					// The LB is busy with another transaction. Retry
 					return false, "pending", nil
-				case apiErr.StatusCode == 404:
+				case isNotFound(err):
 					// 404 - service was deleted on the previous attempt
 					return true, "complete", nil
 				default:
@@ -249,7 +255,7 @@ func resourceIBMLbServiceGroupDelete(d *schema.ResourceData, meta interface{}) e
 
 			return true, "complete", nil
 		},
-		Timeout:    10 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -274,10 +280,8 @@ func resourceIBMLbServiceGroupExists(d *schema.ResourceData, meta interface{}) (
 		GetObject()
 
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
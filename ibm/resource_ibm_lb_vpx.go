@@ -34,6 +34,10 @@ func resourceIBMLbVpx() *schema.Resource {
 		Exists:   resourceIBMLbVpxExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -271,7 +275,7 @@ func findVPXPriceItems(version string, speed int, plan string, ipCount int, meta
 	}, nil
 }
 
-func findVPXByOrderId(orderId int, meta interface{}) (datatypes.Network_Application_Delivery_Controller, error) {
+func findVPXByOrderId(orderId int, meta interface{}, timeout time.Duration) (datatypes.Network_Application_Delivery_Controller, error) {
 	service := services.GetAccountService(meta.(ClientSession).SoftLayerSession())
 
 	stateConf := &resource.StateChangeConf{
@@ -296,7 +300,7 @@ func findVPXByOrderId(orderId int, meta interface{}) (datatypes.Network_Applicat
 				return nil, "", fmt.Errorf("Expected one VPX: %s", err)
 			}
 		},
-		Timeout:    45 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
@@ -408,7 +412,7 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Wait VPX provisioning
-	VPX, err := findVPXByOrderId(*receipt.OrderId, meta)
+	VPX, err := findVPXByOrderId(*receipt.OrderId, meta, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("Error creating network application delivery controller: %s", err)
@@ -613,10 +617,8 @@ func resourceIBMLbVpxExists(d *schema.ResourceData, meta interface{}) (bool, err
 	}
 	nadc, err := service.Mask("id").Id(id).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
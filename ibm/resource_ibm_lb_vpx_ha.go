@@ -21,6 +21,11 @@ func resourceIBMLbVpxHa() *schema.Resource {
 		Delete:   resourceIBMLbVpxHaDelete,
 		Exists:   resourceIBMLbVpxHaExists,
 		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"primary_id": {
@@ -52,7 +57,7 @@ func resourceIBMLbVpxHa() *schema.Resource {
 	}
 }
 
-func configureHA(nClient1 *client.NitroClient, nClient2 *client.NitroClient, staySecondary bool) error {
+func configureHA(nClient1 *client.NitroClient, nClient2 *client.NitroClient, staySecondary bool, timeout time.Duration) error {
 	// 1. VPX2 : Sync password
 	systemuserReq2 := dt.SystemuserReq{
 		Systemuser: &dt.Systemuser{
@@ -79,17 +84,16 @@ func configureHA(nClient1 *client.NitroClient, nClient2 *client.NitroClient, sta
 		return err
 	}
 
-	// Wait 5 secs to make VPX1 a primary node.
-	time.Sleep(time.Second * 5)
-
-	// 3. VPX2 : Register hanode
+	// 3. VPX2 : Register hanode, retried until VPX1 is a primary node.
 	hanodeReq2 := dt.HanodeReq{
 		Hanode: &dt.Hanode{
 			Id:        op.String("2"),
 			Ipaddress: op.String(nClient1.IpAddress),
 		},
 	}
-	err = nClient2.Add(&hanodeReq2)
+	err = retryVpxOperation(timeout, func() error {
+		return nClient2.Add(&hanodeReq2)
+	})
 	if err != nil {
 		return err
 	}
@@ -206,7 +210,7 @@ func resourceIBMLbVpxHaCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error getting secondary netscaler information ID: %d", secondaryId)
 	}
 
-	err = configureHA(nClientPrimary, nClientSecondary, staySecondary)
+	err = configureHA(nClientPrimary, nClientSecondary, staySecondary, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error configuration HA %s", err.Error())
 	}
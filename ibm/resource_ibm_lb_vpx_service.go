@@ -45,6 +45,12 @@ func resourceIBMLbVpxService() *schema.Resource {
 		Exists:   resourceIBMLbVpxServiceExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"vip_id": {
@@ -133,23 +139,16 @@ func parseServiceId(id string) (string, int, string, error) {
 	return vipId, nacdId, serviceName, nil
 }
 
-func updateVpxService(sess *session.Session, nadcId int, lbVip *datatypes.Network_LoadBalancer_VirtualIpAddress) (bool, error) {
+func updateVpxService(sess *session.Session, nadcId int, lbVip *datatypes.Network_LoadBalancer_VirtualIpAddress, timeout time.Duration) (bool, error) {
 	service := services.GetNetworkApplicationDeliveryControllerService(sess)
 	serviceName := *lbVip.Services[0].Name
 	successFlag := true
-	var err error
-	for count := 0; count < 10; count++ {
+	err := retryVpxOperation(timeout, func() error {
+		var err error
 		successFlag, err = service.Id(nadcId).UpdateLiveLoadBalancer(lbVip)
 		log.Printf("[INFO] Updating LoadBalancer Service %s successFlag : %t", serviceName, successFlag)
-
-		if err != nil && strings.Contains(err.Error(), "Operation already in progress") {
-			log.Printf("[INFO] Updating LoadBalancer Service %s Error : %s. Retry in 10 secs", serviceName, err.Error())
-			time.Sleep(time.Second * 10)
-			continue
-		}
-
-		break
-	}
+		return err
+	}, "Operation already in progress")
 	return successFlag, err
 }
 
@@ -284,7 +283,7 @@ func resourceIBMLbVpxServiceCreate101(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[INFO] Creating LoadBalancer Service %s", serviceName)
 
-	successFlag, err := updateVpxService(sess.SetRetries(0), nadcId, lbVip)
+	successFlag, err := updateVpxService(sess.SetRetries(0), nadcId, lbVip, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("Error creating LoadBalancer Service: %s", err)
@@ -501,7 +500,7 @@ func resourceIBMLbVpxServiceUpdate101(d *schema.ResourceData, meta interface{})
 			template},
 	}
 
-	successFlag, err := updateVpxService(sess.SetRetries(0), nadcId, lbVip)
+	successFlag, err := updateVpxService(sess.SetRetries(0), nadcId, lbVip, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		return fmt.Errorf("Error updating LoadBalancer Service: %s", err)
@@ -607,27 +606,18 @@ func resourceIBMLbVpxServiceDelete101(d *schema.ResourceData, meta interface{})
 		},
 	}
 
-	for count := 0; count < 10; count++ {
-		err = service.Id(nadcId).DeleteLiveLoadBalancerService(&lbSvc)
+	err = retryVpxOperation(d.Timeout(schema.TimeoutDelete), func() error {
+		err := service.Id(nadcId).DeleteLiveLoadBalancerService(&lbSvc)
 		log.Printf("[INFO] Deleting Loadbalancer service %s", serviceName)
 
-		if err != nil &&
-			(strings.Contains(err.Error(), "Operation already in progress") ||
-				strings.Contains(err.Error(), "Internal Error")) {
-			log.Printf("[INFO] Deleting Loadbalancer service Error : %s. Retry in 10 secs", err.Error())
-			time.Sleep(time.Second * 10)
-			continue
-		}
-
 		if err != nil &&
 			(strings.Contains(err.Error(), "No Service") ||
 				strings.Contains(err.Error(), "Unable to find object with unknown identifier of")) {
 			log.Printf("[INFO] Deleting Loadbalancer service %s Error : %s ", serviceName, err.Error())
-			err = nil
+			return nil
 		}
-
-		break
-	}
+		return err
+	}, "Operation already in progress", "Internal Error")
 
 	if err != nil {
 		return fmt.Errorf("Error deleting LoadBalancer Service %s: %s", serviceName, err)
@@ -663,10 +653,8 @@ func resourceIBMLbVpxServiceExists101(d *schema.ResourceData, meta interface{})
 	}
 	lbService, err := network.GetNadcLbVipServiceByName(meta.(ClientSession).SoftLayerSession(), nadcId, vipName, serviceName)
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/helpers/network"
@@ -62,6 +63,12 @@ func resourceIBMLbVpxVip() *schema.Resource {
 		Exists:   resourceIBMLbVpxVipExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"nad_controller_id": {
 				Type:        schema.TypeInt,
@@ -256,28 +263,19 @@ func resourceIBMLbVpxVipCreate101(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Creating Virtual Ip Address %s", *template.VirtualIpAddress)
 
-	var err error
 	var successFlag bool
-
-	for count := 0; count < 10; count++ {
+	err := retryVpxOperation(d.Timeout(schema.TimeoutCreate), func() error {
+		var err error
 		successFlag, err = service.Id(nadcId).CreateLiveLoadBalancer(&template)
 		log.Printf("[INFO] Creating Virtual Ip Address %s successFlag : %t", *template.VirtualIpAddress, successFlag)
 
 		if err != nil && strings.Contains(err.Error(), "already exists") {
 			log.Printf("[INFO] Creating Virtual Ip Address %s error : %s. Ingore the error.", *template.VirtualIpAddress, err.Error())
 			successFlag = true
-			err = nil
-			break
-		}
-
-		if err != nil && strings.Contains(err.Error(), "Operation already in progress") {
-			log.Printf("[INFO] Creating Virtual Ip Address %s error : %s. Retry in 10 secs", *template.VirtualIpAddress, err.Error())
-			time.Sleep(time.Second * 10)
-			continue
+			return nil
 		}
-
-		break
-	}
+		return err
+	}, "Operation already in progress")
 
 	if err != nil {
 		return fmt.Errorf("Error creating Virtual Ip Address: %s", err)
@@ -483,21 +481,11 @@ func resourceIBMLbVpxVipUpdate101(d *schema.ResourceData, meta interface{}) erro
 		template.VirtualIpAddress = sl.String(d.Get("virtual_ip_address").(string))
 	}
 
-	var err error
-
-	for count := 0; count < 10; count++ {
-		var successFlag bool
-		successFlag, err = service.Id(nadcId).UpdateLiveLoadBalancer(&template)
+	err := retryVpxOperation(d.Timeout(schema.TimeoutUpdate), func() error {
+		successFlag, err := service.Id(nadcId).UpdateLiveLoadBalancer(&template)
 		log.Printf("[INFO]  Updating Virtual Ip Address successFlag : %t", successFlag)
-
-		if err != nil && strings.Contains(err.Error(), "Operation already in progress") {
-			log.Printf("[INFO] Updating Virtual Ip Address error : %s. Retry in 10 secs", err.Error())
-			time.Sleep(time.Second * 10)
-			continue
-		}
-
-		break
-	}
+		return err
+	}, "Operation already in progress")
 
 	if err != nil {
 		return fmt.Errorf("Error updating Virtual Ip Address: %s", err)
@@ -556,29 +544,19 @@ func resourceIBMLbVpxVipDelete101(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("ibm_lb_vpx : %s", err)
 	}
 
-	for count := 0; count < 10; count++ {
-		var successFlag bool
-		successFlag, err = service.Id(nadcId).DeleteLiveLoadBalancer(
+	err = retryVpxOperation(d.Timeout(schema.TimeoutDelete), func() error {
+		successFlag, err := service.Id(nadcId).DeleteLiveLoadBalancer(
 			&datatypes.Network_LoadBalancer_VirtualIpAddress{Name: sl.String(vipName)},
 		)
 		log.Printf("[INFO] Deleting Virtual Ip Address %s successFlag : %t", vipName, successFlag)
 
-		if err != nil &&
-			(strings.Contains(err.Error(), "Operation already in progress") ||
-				strings.Contains(err.Error(), "No Service")) {
-			log.Printf("[INFO] Deleting Virtual Ip Address %s Error : %s  Retry in 10 secs", vipName, err.Error())
-			time.Sleep(time.Second * 10)
-			continue
-		}
-
 		// Check if the resource is already deleted.
 		if err != nil && strings.Contains(err.Error(), "Unable to find object with unknown identifier of") {
 			log.Printf("[INFO] Deleting Virtual Ip Address %s Error : %s . Ignore the error.", vipName, err.Error())
-			err = nil
+			return nil
 		}
-
-		break
-	}
+		return err
+	}, "Operation already in progress", "No Service")
 
 	if err != nil {
 		return fmt.Errorf("Error deleting Virtual Ip Address %s: %s", vipName, err)
@@ -623,10 +601,8 @@ func resourceIBMLbVpxVipExists101(d *schema.ResourceData, meta interface{}) (boo
 
 	vip, err := network.GetNadcLbVipByName(sess, nadcId, vipName)
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -657,6 +633,40 @@ func resourceIBMLbVpxVipExists105(d *schema.ResourceData, meta interface{}) (boo
 	return true, nil
 }
 
+// retryVpxOperation runs the operation until it no longer fails with one of the busy
+// errors, e.g. while another operation of the netscaler is in progress, or until the
+// timeout. Every error is retried when no busy error is given.
+func retryVpxOperation(timeout time.Duration, operation func() error, busyErrors ...string) error {
+	var lastErr error
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"complete"},
+		Refresh: func() (interface{}, string, error) {
+			err := operation()
+			if err == nil {
+				return true, "complete", nil
+			}
+			busy := len(busyErrors) == 0
+			for _, busyErr := range busyErrors {
+				busy = busy || strings.Contains(err.Error(), busyErr)
+			}
+			if !busy {
+				return nil, "", err
+			}
+			log.Printf("[INFO] Netscaler operation error : %s. Retry in 10 secs", err.Error())
+			lastErr = err
+			return false, "pending", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if _, ok := err.(*resource.TimeoutError); ok && lastErr != nil {
+		return fmt.Errorf("%s: %s", err, lastErr)
+	}
+	return err
+}
+
 func getNitroClient(sess *session.Session, nadcId int) (*client.NitroClient, error) {
 	service := services.GetNetworkApplicationDeliveryControllerService(sess)
 	nadc, err := service.Id(nadcId).Mask("managementIpAddress,password[password]").GetObject()
@@ -1,9 +1,12 @@
 package ibm
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -11,6 +14,24 @@ import (
 	"github.com/softlayer/softlayer-go/helpers/network"
 )
 
+func TestRetryVpxOperation(t *testing.T) {
+	calls := 0
+	err := retryVpxOperation(time.Minute, func() error {
+		calls++
+		return errors.New("Invalid VIP name")
+	}, "Operation already in progress")
+	if err == nil || err.Error() != "Invalid VIP name" || calls != 1 {
+		t.Errorf("expected the error not to be retried, got %v after %d calls", err, calls)
+	}
+
+	err = retryVpxOperation(time.Millisecond, func() error {
+		return errors.New("Operation already in progress")
+	}, "Operation already in progress")
+	if err == nil || !strings.Contains(err.Error(), "Operation already in progress") {
+		t.Errorf("expected the last error after the timeout, got %v", err)
+	}
+}
+
 func TestAccIBMLbVpxVip_Basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -47,7 +47,7 @@ func init() {
 }
 
 func resourceIBMLbaas() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMLbaasCreate,
 		Read:     resourceIBMLbaasRead,
 		Delete:   resourceIBMLbaasDelete,
@@ -55,6 +55,12 @@ func resourceIBMLbaas() *schema.Resource {
 		Update:   resourceIBMLbaasUpdate,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -177,9 +183,9 @@ func resourceIBMLbaas() *schema.Resource {
 				//ValidateFunc: validateAllowedStringValue([]string{"ECDHE-RSA-AES256-GCM-SHA384", "ECDHE-RSA-AES256-SHA384", "AES256-GCM-SHA384", "AES256-SHA256", "ECDHE-RSA-AES128-GCM-SHA256", "ECDHE-RSA-AES128-SHA256", "AES128-GCM-SHA256", "AES128-SHA256"}),
 			},
 			"wait_time_minutes": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  90,
+				Type:       schema.TypeInt,
+				Optional:   true,
+				Deprecated: waitTimeMinutesDeprecation,
 			},
 			"health_monitors": {
 				Type:     schema.TypeList,
@@ -234,7 +240,7 @@ func resourceIBMLbaas() *schema.Resource {
 				Description: "The status of the resource",
 			},
 		},
-	}
+	}, upgradeWaitTimeMinutesState(90))
 }
 
 func resourceIBMLbaasCreate(d *schema.ResourceData, meta interface{}) error {
@@ -425,7 +431,7 @@ func resourceIBMLbaasExists(d *schema.ResourceData, meta interface{}) (bool, err
 
 	result, err := service.GetLoadBalancer(sl.String(d.Id()))
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && (apiErr.StatusCode == 404 || apiErr.Exception == NOT_FOUND) {
+		if isNotFound(err) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving load balancer: %s", err)
@@ -525,7 +531,7 @@ func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.Resource
 			}
 			return nil, lbPending, nil
 		},
-		Timeout:        time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute,
+		Timeout:        waitTimeout(d, schema.TimeoutCreate),
 		Delay:          60 * time.Second,
 		MinTimeout:     3 * time.Second,
 		PollInterval:   60 * time.Second,
@@ -556,7 +562,7 @@ func waitForLbaasLBAvailable(d *schema.ResourceData, meta interface{}) (interfac
 		Refresh: func() (interface{}, string, error) {
 			lb, err := service.GetLoadBalancer(sl.String(d.Id()))
 			if err != nil {
-				if apiErr, ok := err.(sl.Error); ok && (apiErr.StatusCode == 404 || apiErr.Exception == NOT_FOUND) {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The load balancer %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", err
@@ -566,7 +572,7 @@ func waitForLbaasLBAvailable(d *schema.ResourceData, meta interface{}) (interfac
 			}
 			return lb, lbUpdatePening, nil
 		},
-		Timeout:        time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute,
+		Timeout:        waitTimeout(d, schema.TimeoutUpdate),
 		Delay:          60 * time.Second,
 		MinTimeout:     3 * time.Second,
 		PollInterval:   60 * time.Second,
@@ -586,14 +592,14 @@ func waitForLbaasLBDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		Refresh: func() (interface{}, string, error) {
 			lb, err := service.GetLoadBalancer(sl.String(d.Id()))
 			if err != nil {
-				if apiErr, ok := err.(sl.Error); ok && (apiErr.StatusCode == 404 || apiErr.Exception == NOT_FOUND) {
+				if isNotFound(err) {
 					return lb, lbDeleted, nil
 				}
 				return datatypes.Network_LBaaS_LoadBalancer{}, "", err
 			}
 			return lb, lbDeletePending, nil
 		},
-		Timeout:      time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute,
+		Timeout:      waitTimeout(d, schema.TimeoutDelete),
 		Delay:        60 * time.Second,
 		MinTimeout:   10 * time.Second,
 		PollInterval: 60 * time.Second,
@@ -2,6 +2,7 @@ package ibm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -9,13 +10,20 @@ import (
 	"github.com/softlayer/softlayer-go/sl"
 )
 
+var lbaasHealthMonitorIDTemplate = newIDTemplate("ibm_lbaas_health_monitor", "{lbaas_id}/{monitor_id}", "988-454f-45vf-454542/d343f-f44r-wer3-fe3")
+
 func resourceIBMLbaasHealthMonitor() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMLbaasHealthMonitorCreate,
 		Read:     resourceIBMLbaasHealthMonitorRead,
 		Delete:   resourceIBMLbaasHealthMonitorDelete,
 		Update:   resourceIBMLbaasHealthMonitorUpdate,
-		Importer: &schema.ResourceImporter{},
+		Importer: lbaasHealthMonitorIDTemplate.importer(),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -93,7 +101,7 @@ func resourceIBMLbaasHealthMonitorCreate(d *schema.ResourceData, meta interface{
 
 	healthMonitors = append(healthMonitors, healthMonitor)
 
-	_, err := waitForLbaasLBActive(d, meta)
+	_, err := waitForLbaasLBActive(d, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
@@ -103,7 +111,7 @@ func resourceIBMLbaasHealthMonitorCreate(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return fmt.Errorf("Error adding health monitors: %#v", err)
 	}
-	_, err = waitForLbaasLBActive(d, meta)
+	_, err = waitForLbaasLBActive(d, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
@@ -168,7 +176,7 @@ func resourceIBMLbaasHealthMonitorUpdate(d *schema.ResourceData, meta interface{
 
 		healthMonitors = append(healthMonitors, healthMonitor)
 
-		_, err = waitForLbaasLBActive(d, meta)
+		_, err = waitForLbaasLBActive(d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
@@ -178,7 +186,7 @@ func resourceIBMLbaasHealthMonitorUpdate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return fmt.Errorf("Error adding health monitors: %#v", err)
 		}
-		_, err = waitForLbaasLBActive(d, meta)
+		_, err = waitForLbaasLBActive(d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
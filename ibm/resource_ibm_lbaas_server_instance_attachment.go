@@ -21,6 +21,12 @@ func resourceIBMLbaasServerInstanceAttachment() *schema.Resource {
 		Update:   resourceIBMLbaasServerInstanceAttachmentUpdate,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"private_ip_address": {
 				Type:         schema.TypeString,
@@ -63,7 +69,7 @@ func resourceIBMLbaasServerInstanceAttachmentCreate(d *schema.ResourceData, meta
 	p.Weight = sl.Int(weight)
 	members := make([]datatypes.Network_LBaaS_LoadBalancerServerInstanceInfo, 0, 1)
 	members = append(members, *p)
-	_, err := waitForLbaasLBActive(d, meta)
+	_, err := waitForLbaasLBActive(d, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", d.Id(), err)
@@ -72,7 +78,7 @@ func resourceIBMLbaasServerInstanceAttachmentCreate(d *schema.ResourceData, meta
 	if err != nil {
 		return fmt.Errorf("Error adding server instances: %#v", err)
 	}
-	_, err = waitForLbaasLBActive(d, meta)
+	_, err = waitForLbaasLBActive(d, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
@@ -121,7 +127,7 @@ func resourceIBMLbaasServerInstanceAttachmentUpdate(d *schema.ResourceData, meta
 		updateParam.Address = sl.String(privateIpAddress)
 		members := make([]datatypes.Network_LBaaS_Member, 0, 1)
 		members = append(members, *updateParam)
-		_, err := waitForLbaasLBActive(d, meta)
+		_, err := waitForLbaasLBActive(d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", d.Id(), err)
@@ -130,7 +136,7 @@ func resourceIBMLbaasServerInstanceAttachmentUpdate(d *schema.ResourceData, meta
 		if err != nil {
 			return fmt.Errorf("Error updating loadbalnacer: %#v", err)
 		}
-		_, err = waitForLbaasLBActive(d, meta)
+		_, err = waitForLbaasLBActive(d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer (%s) to become ready: %s", lbaasId, err)
@@ -147,7 +153,7 @@ func resourceIBMLbaasServerInstanceAttachmentExists(d *schema.ResourceData, meta
 	memId, _ := strconv.Atoi(d.Id())
 	result, err := memberService.Id(memId).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && (apiErr.StatusCode == 404 || apiErr.Exception == NOT_FOUND) {
+		if isNotFound(err) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving load balancer member: %s", err)
@@ -161,7 +167,7 @@ func resourceIBMLbaasServerInstanceAttachmentDelete(d *schema.ResourceData, meta
 	lbaasId := d.Get("lbaas_id").(string)
 	removeList := make([]string, 0, 1)
 	removeList = append(removeList, d.Get("uuid").(string))
-	_, err := waitForLbaasLBActive(d, meta)
+	_, err := waitForLbaasLBActive(d, meta, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", d.Id(), err)
@@ -170,7 +176,7 @@ func resourceIBMLbaasServerInstanceAttachmentDelete(d *schema.ResourceData, meta
 	if err != nil {
 		return fmt.Errorf("Error removing server instances: %#v", err)
 	}
-	_, err = waitForLbaasLBActive(d, meta)
+	_, err = waitForLbaasLBActive(d, meta, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
@@ -178,7 +184,7 @@ func resourceIBMLbaasServerInstanceAttachmentDelete(d *schema.ResourceData, meta
 	return nil
 }
 
-func waitForLbaasLBActive(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLbaasLBActive(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 	lbaasId := d.Get("lbaas_id").(string)
@@ -189,7 +195,7 @@ func waitForLbaasLBActive(d *schema.ResourceData, meta interface{}) (interface{}
 		Refresh: func() (interface{}, string, error) {
 			lb, err := service.GetLoadBalancer(sl.String(lbaasId))
 			if err != nil {
-				if apiErr, ok := err.(sl.Error); ok && (apiErr.StatusCode == 404 || apiErr.Exception == NOT_FOUND) {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The load balancer %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", err
@@ -199,7 +205,7 @@ func waitForLbaasLBActive(d *schema.ResourceData, meta interface{}) (interface{}
 			}
 			return lb, lbUpdatePening, nil
 		},
-		Timeout:        10 * time.Minute,
+		Timeout:        timeout,
 		Delay:          60 * time.Second,
 		MinTimeout:     3 * time.Second,
 		PollInterval:   60 * time.Second,
@@ -25,6 +25,11 @@ func resourceIBMMultiVlanFirewall() *schema.Resource {
 		Exists:   resourceIBMMultiVLanFirewallExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+			Update: schema.DefaultTimeout(2 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"datacenter": {
 				Type:        schema.TypeString,
@@ -218,7 +223,7 @@ func resourceIBMNetworkMultiVlanCreate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return fmt.Errorf("Error during Place order for Creating: %s", err)
 	}
-	_, vlan, _, err := findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d)
+	_, vlan, _, err := findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err)
 	}
@@ -378,7 +383,7 @@ func resourceIBMMultiVlanFirewallUpdate(d *schema.ResourceData, meta interface{}
 			if err != nil {
 				return fmt.Errorf("Error during Place order for Updating: %s", err)
 			}
-			_, _, _, err = findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d)
+			_, _, _, err = findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err)
 			}
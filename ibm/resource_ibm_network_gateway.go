@@ -33,6 +33,11 @@ func resourceIBMNetworkGateway() *schema.Resource {
 		Exists:   resourceIBMNetworkGatewayExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Hour),
+			Delete: schema.DefaultTimeout(24 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"name": {
@@ -506,7 +511,7 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	gID := *orderReceipt.OrderDetails.OrderContainers[0].Hardware[0].GlobalIdentifier
-	bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[0], meta, gID)
+	bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[0], meta, gID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for Gateway (%s) to become ready: %s", d.Id(), err)
@@ -528,7 +533,7 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 	if sameOrder {
 		// If we ordered HA and then wait for other member
 		gID1 := *orderReceipt.OrderDetails.OrderContainers[0].Hardware[1].GlobalIdentifier
-		bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[1], meta, gID1)
+		bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[1], meta, gID1, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for Gateway (%s) to become ready: %s", d.Id(), err)
@@ -542,7 +547,7 @@ func resourceIBMNetworkGatewayCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	} else if len(members) == 2 {
 		//Add the new gateway which has different configuration than the first
-		err := addGatewayMember(id, members[1], meta)
+		err := addGatewayMember(id, members[1], meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -636,7 +641,7 @@ func updateGatewayName(id int, name string, meta interface{}) error {
 	return err
 }
 
-func addGatewayMember(gwID int, member gatewayMember, meta interface{}) error {
+func addGatewayMember(gwID int, member gatewayMember, meta interface{}, timeout time.Duration) error {
 	sess := meta.(ClientSession).SoftLayerSession()
 	order, err := getMonthlyGatewayOrder(member, meta)
 	if err != nil {
@@ -682,7 +687,7 @@ func addGatewayMember(gwID int, member gatewayMember, meta interface{}) error {
 
 	gID := *orderReceipt.OrderDetails.Hardware[0].GlobalIdentifier
 
-	bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[0], meta, gID)
+	bm, err := waitForNetworkGatewayMemberProvision(&order.Hardware[0], meta, gID, timeout)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for Gateway (%d) to become ready: %s", gwID, err)
@@ -718,7 +723,7 @@ func resourceIBMNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) e
 		m := gatewayMember{
 			"member_id": *v.HardwareId,
 		}
-		err := deleteHardware(m, meta)
+		err := deleteHardware(m, meta, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -738,7 +743,7 @@ func resourceIBMNetworkGatewayExists(d *schema.ResourceData, meta interface{}) (
 
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); !ok || apiErr.StatusCode != 404 {
+		if !isNotFound(err) {
 			return false, fmt.Errorf("Error trying to retrieve Network Gateway: %s", err)
 		}
 	}
@@ -1008,7 +1013,7 @@ func setHardwareOptions(m gatewayMember, hardware *datatypes.Hardware) error {
 // Have to wait on provision date to become available on server that matches
 // hostname and domain.
 // http://sldn.softlayer.com/blog/bpotter/ordering-bare-metal-servers-using-softlayer-api
-func waitForNetworkGatewayMemberProvision(d *datatypes.Hardware, meta interface{}, globalIdentifier string) (interface{}, error) {
+func waitForNetworkGatewayMemberProvision(d *datatypes.Hardware, meta interface{}, globalIdentifier string, timeout time.Duration) (interface{}, error) {
 	hostname := *d.Hostname
 	domain := *d.Domain
 	log.Printf("Waiting for Gateway (%s.%s) to be provisioned", hostname, domain)
@@ -1032,7 +1037,7 @@ func waitForNetworkGatewayMemberProvision(d *datatypes.Hardware, meta interface{
 				return bms[0], "provisioned", nil
 			}
 		},
-		Timeout:        24 * time.Hour,
+		Timeout:        timeout,
 		Delay:          10 * time.Second,
 		MinTimeout:     1 * time.Minute,
 		NotFoundChecks: 24 * 60,
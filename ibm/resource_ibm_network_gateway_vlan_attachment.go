@@ -22,6 +22,12 @@ func resourceIBMNetworkGatewayVlanAttachment() *schema.Resource {
 		Exists:   resourceIBMNetworkGatewayVlanAttachmentExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Hour),
+			Update: schema.DefaultTimeout(24 * time.Hour),
+			Delete: schema.DefaultTimeout(24 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Type:        schema.TypeInt,
@@ -72,7 +78,7 @@ func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta
 							return err
 						}
 					}
-					_, err = waitForNetworkGatewayActiveState(*i.NetworkGatewayId, meta)
+					_, err = waitForNetworkGatewayActiveState(*i.NetworkGatewayId, meta, d.Timeout(schema.TimeoutCreate))
 					if err != nil {
 						return err
 					}
@@ -101,7 +107,7 @@ func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta
 		return err
 	}
 	d.SetId(fmt.Sprintf("%d", *resp.Id))
-	_, err = waitForNetworkGatewayActiveState(gatewayID, meta)
+	_, err = waitForNetworkGatewayActiveState(gatewayID, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -147,7 +153,7 @@ func resourceIBMNetworkGatewayVlanAttachmentUpdate(d *schema.ResourceData, meta
 			}
 		}
 		vlan, err := service.Id(id).GetObject()
-		_, err = waitForNetworkGatewayActiveState(*vlan.NetworkGatewayId, meta)
+		_, err = waitForNetworkGatewayActiveState(*vlan.NetworkGatewayId, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -164,7 +170,7 @@ func resourceIBMNetworkGatewayVlanAttachmentExists(d *schema.ResourceData, meta
 	}
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); !ok || apiErr.StatusCode != 404 {
+		if !isNotFound(err) {
 			return false, fmt.Errorf("Error trying to retrieve Network Gateway Vlan: %s", err)
 		}
 	}
@@ -184,7 +190,7 @@ func resourceIBMNetworkGatewayVlanAttachmentDelete(d *schema.ResourceData, meta
 		return err
 	}
 
-	_, err = waitForNetworkGatewayActiveState(*vlan.NetworkGatewayId, meta)
+	_, err = waitForNetworkGatewayActiveState(*vlan.NetworkGatewayId, meta, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -218,7 +224,7 @@ func resourceIBMNetworkGatewayVlanDissociate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func waitForNetworkGatewayActiveState(id int, meta interface{}) (interface{}, error) {
+func waitForNetworkGatewayActiveState(id int, meta interface{}, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Gateway (%d) to be active", id)
 	service := services.GetNetworkGatewayService(meta.(ClientSession).SoftLayerSession())
 
@@ -239,7 +245,7 @@ func waitForNetworkGatewayActiveState(id int, meta interface{}) (interface{}, er
 			return gw, "updating", nil
 
 		},
-		Timeout:        24 * time.Hour,
+		Timeout:        timeout,
 		Delay:          10 * time.Second,
 		MinTimeout:     1 * time.Minute,
 		NotFoundChecks: 24 * 60,
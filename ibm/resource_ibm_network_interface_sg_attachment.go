@@ -11,9 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
 	slsession "github.com/softlayer/softlayer-go/session"
-	"github.com/softlayer/softlayer-go/sl"
 )
 
+var networkInterfaceSGAttachmentLock = newParentLock("ibm_network_interface_sg_attachment", "network_interface")
+
 func resourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIBMNetworkInterfaceSGAttachmentCreate,
@@ -48,9 +49,8 @@ func resourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 }
 
 func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
+	unlock := networkInterfaceSGAttachmentLock.lock(strconv.Itoa(d.Get("network_interface_id").(int)))
+	defer unlock()
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
@@ -63,7 +63,10 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 		return err
 	}
 
-	_, err = service.Id(sgID).AttachNetworkComponents([]int{interfaceID})
+	err = networkInterfaceSGAttachmentLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := service.Id(sgID).AttachNetworkComponents([]int{interfaceID})
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -127,16 +130,18 @@ func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta in
 }
 
 func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
+	unlock := networkInterfaceSGAttachmentLock.lock(strconv.Itoa(d.Get("network_interface_id").(int)))
+	defer unlock()
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
 	if err != nil {
 		return err
 	}
-	_, err = service.Id(sgID).DetachNetworkComponents([]int{interfaceID})
+	err = networkInterfaceSGAttachmentLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := service.Id(sgID).DetachNetworkComponents([]int{interfaceID})
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Error detaching network components from Security Group: %s", err)
 	}
@@ -155,10 +160,8 @@ func resourceIBMNetworkInterfaceSGAttachmentExists(d *schema.ResourceData, meta
 
 	bindings, err := service.Id(sgID).GetNetworkComponentBindings()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -221,7 +224,7 @@ func vsReadyRefreshStateFunc(sess *slsession.Session, ifcID int) resource.StateR
 		ncs := services.GetVirtualGuestNetworkComponentService(sess)
 		guest, err := ncs.Id(ifcID).GetGuest()
 		if err != nil {
-			if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			if isNotFound(err) {
 				return nil, "", fmt.Errorf("Error retrieving virtual guest: %s", err)
 			}
 			return false, "retry", nil
@@ -229,7 +232,7 @@ func vsReadyRefreshStateFunc(sess *slsession.Session, ifcID int) resource.StateR
 		guestService := services.GetVirtualGuestService(sess)
 		ready, err := guestService.Id(*guest.Id).GetStatus()
 		if err != nil {
-			if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			if isNotFound(err) {
 				return nil, "", fmt.Errorf("Error retrieving virtual guest: %s", err)
 			}
 			return false, "retry", nil
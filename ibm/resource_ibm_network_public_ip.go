@@ -34,6 +34,7 @@ func resourceIBMNetworkPublicIp() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -188,7 +189,7 @@ func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{})
 			}
 			return datatypes.Network_Subnet_IpAddress_Global{}, "pending", nil
 		},
-		Timeout:    10 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -240,7 +241,7 @@ func resourceIBMNetworkPublicIpExists(d *schema.ResourceData, meta interface{})
 
 	result, err := service.Id(globalIpId).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+		if isNotFound(err) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving network public ip: %s", err)
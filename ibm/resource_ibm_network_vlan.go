@@ -389,10 +389,8 @@ func resourceIBMNetworkVlanExists(d *schema.ResourceData, meta interface{}) (boo
 
 	result, err := service.Id(vlanID).Mask("id").GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -25,6 +25,10 @@ func resourceIBMObjectStorageAccount() *schema.Resource {
 		Exists:   resourceIBMObjectStorageAccountExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -71,7 +75,7 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 		}
 
 		// Wait for the object storage account order to complete.
-		billingOrderItem, err := WaitForOrderCompletion(&receipt, meta)
+		billingOrderItem, err := WaitForOrderCompletion(&receipt, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for object storage account order (%d) to complete: %s", receipt.OrderId, err)
@@ -98,7 +102,7 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 }
 
 func WaitForOrderCompletion(
-	receipt *datatypes.Container_Product_Order_Receipt, meta interface{}) (datatypes.Billing_Order_Item, error) {
+	receipt *datatypes.Container_Product_Order_Receipt, meta interface{}, timeout time.Duration) (datatypes.Billing_Order_Item, error) {
 
 	log.Printf("Waiting for billing order %d to have zero active transactions", receipt.OrderId)
 	var billingOrderItem *datatypes.Billing_Order_Item
@@ -121,7 +125,7 @@ func WaitForOrderCompletion(
 				return billingOrderItem, "in progress", nil
 			}
 		},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
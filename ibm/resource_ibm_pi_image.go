@@ -8,11 +8,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
 )
 
+var piImageIDTemplate = newIDTemplate("ibm_pi_image", "{cloud_instance_id}/{image_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+
 func resourceIBMPIImage() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMPIImageCreate,
@@ -20,7 +21,7 @@ func resourceIBMPIImage() *schema.Resource {
 		Update:   resourceIBMPIImageUpdate,
 		Delete:   resourceIBMPIImageDelete,
 		Exists:   resourceIBMPIImageExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: piImageIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -157,10 +158,8 @@ func resourceIBMPIImageExists(d *schema.ResourceData, meta interface{}) (bool, e
 
 	image, err := client.Get(parts[1], powerinstanceid)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -168,18 +167,17 @@ func resourceIBMPIImageExists(d *schema.ResourceData, meta interface{}) (bool, e
 }
 
 func isWaitForIBMPIImageAvailable(client *st.IBMPIImageClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
-	log.Printf("Waiting for Power Image (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PIImageQueStatus},
-		Target:     []string{helpers.PIImageActiveStatus},
-		Refresh:    isIBMPIImageRefreshFunc(client, id, powerinstanceid),
-		Timeout:    timeout,
-		Delay:      20 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power image %s to be active", id),
+		Pending:         []string{"retry", helpers.PIImageQueStatus},
+		Target:          []string{helpers.PIImageActiveStatus},
+		Status:          refreshStatus(isIBMPIImageRefreshFunc(client, id, powerinstanceid)),
+		Timeout:         timeout,
+		Delay:           20 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isIBMPIImageRefreshFunc(client *st.IBMPIImageClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
@@ -623,7 +622,7 @@ func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 
 		if mem > maxMemLpar || procs > maxCPULpar {
 
-			_, err = performChangeAndReboot(client, parts[1], powerinstanceid, mem, procs)
+			_, err = performChangeAndReboot(client, parts[1], powerinstanceid, mem, procs, d.Timeout(schema.TimeoutUpdate))
 			//_, err = stopLparForResourceChange(client, parts[1], powerinstanceid)
 			if err != nil {
 				return fmt.Errorf("failed to perform the operation for the change")
@@ -703,10 +702,8 @@ func resourceIBMPIInstanceExists(d *schema.ResourceData, meta interface{}) (bool
 
 	instance, err := client.Get(parts[1], powerinstanceid, getTimeOut)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("error communicating with the API: %s", err)
 	}
@@ -717,18 +714,17 @@ func resourceIBMPIInstanceExists(d *schema.ResourceData, meta interface{}) (bool
 
 func isWaitForPIInstanceDeleted(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
-	log.Printf("Waiting for  (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PIInstanceDeleting},
-		Target:     []string{helpers.PIInstanceNotFound},
-		Refresh:    isPIInstanceDeleteRefreshFunc(client, id, powerinstanceid),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-		Timeout:    10 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power instance %s to be deleted", id),
+		Pending:         []string{"retry", helpers.PIInstanceDeleting},
+		Target:          []string{helpers.PIInstanceNotFound},
+		Status:          refreshStatus(isPIInstanceDeleteRefreshFunc(client, id, powerinstanceid)),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+		Timeout:         timeout,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -745,8 +741,6 @@ func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id, powerinst
 }
 
 func isWaitForPIInstanceAvailable(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string, instanceReadyStatus string) (interface{}, error) {
-	log.Printf("Waiting for PIInstance (%s) to be available and active ", id)
-
 	var queryTimeOut time.Duration
 
 	if instanceReadyStatus == "WARNING" {
@@ -755,34 +749,41 @@ func isWaitForPIInstanceAvailable(client *st.IBMPIInstanceClient, id string, tim
 		queryTimeOut = activeTimeOut
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"PENDING", helpers.PIInstanceBuilding, helpers.PIInstanceHealthWarning},
-		Target:     []string{helpers.PIInstanceAvailable, helpers.PIInstanceHealthOk, "ERROR", ""},
-		Refresh:    isPIInstanceRefreshFunc(client, id, powerinstanceid, instanceReadyStatus),
-		Delay:      10 * time.Second,
-		MinTimeout: queryTimeOut,
-		Timeout:    120 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power instance %s to be available", id),
+		Pending:         []string{"PENDING", helpers.PIInstanceBuilding, helpers.PIInstanceHealthWarning},
+		Target:          []string{helpers.PIInstanceAvailable, helpers.PIInstanceHealthOk, ""},
+		Failed:          []string{"ERROR"},
+		Status:          isPIInstanceRefreshFunc(client, id, powerinstanceid, instanceReadyStatus),
+		Delay:           10 * time.Second,
+		MinPollInterval: queryTimeOut,
+		Timeout:         timeout,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
-func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid, instanceReadyStatus string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid, instanceReadyStatus string) asyncStatusFunc {
+	return func() (interface{}, asyncStatus, error) {
 
 		pvm, err := client.Get(id, powerinstanceid, getTimeOut)
 		if err != nil {
-			return nil, "", err
+			return nil, asyncStatus{}, err
+		}
+		health := ""
+		if pvm.Health != nil {
+			health = pvm.Health.Status
 		}
+		status := asyncStatus{State: helpers.PIInstanceBuilding, Progress: fmt.Sprintf("status %s, health %s", *pvm.Status, health)}
 		allowableStatus := instanceReadyStatus
-		if *pvm.Status == helpers.PIInstanceAvailable && (pvm.Health.Status == allowableStatus) {
-			return pvm, helpers.PIInstanceAvailable, nil
+		if *pvm.Status == helpers.PIInstanceAvailable && (health == allowableStatus) {
+			status.State = helpers.PIInstanceAvailable
 		}
 		if *pvm.Status == "ERROR" {
-			return pvm, *pvm.Status, fmt.Errorf("Failed to create the lpar")
+			status.State = *pvm.Status
 		}
 
-		return pvm, helpers.PIInstanceBuilding, nil
+		return pvm, status, nil
 	}
 }
 
@@ -796,18 +797,17 @@ func checkBase64(input string) error {
 }
 
 func isWaitForPIInstanceStopped(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
-	log.Printf("Waiting for PIInstance (%s) to be stopped and powered off ", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"STOPPING", "RESIZE", "VERIFY_RESIZE", helpers.PIInstanceHealthWarning},
-		Target:     []string{"OK", "SHUTOFF"},
-		Refresh:    isPIInstanceRefreshFuncOff(client, id, powerinstanceid),
-		Delay:      10 * time.Second,
-		MinTimeout: 2 * time.Minute, // This is the time that the client will execute to check the status of the request
-		Timeout:    30 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power instance %s to be stopped", id),
+		Pending:         []string{"STOPPING", "RESIZE", "VERIFY_RESIZE", helpers.PIInstanceHealthWarning},
+		Target:          []string{"OK", "SHUTOFF"},
+		Status:          refreshStatus(isPIInstanceRefreshFuncOff(client, id, powerinstanceid)),
+		Delay:           10 * time.Second,
+		MinPollInterval: 2 * time.Minute, // This is the time that the client will execute to check the status of the request
+		Timeout:         timeout,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -825,7 +825,7 @@ func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id, powerinstanc
 	}
 }
 
-func stopLparForResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string) (interface{}, error) {
+func stopLparForResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
 	//TODO
 
 	log.Printf("Callin the stop lpar for Resource Change code ..")
@@ -838,7 +838,7 @@ func stopLparForResourceChange(client *st.IBMPIInstanceClient, id, powerinstance
 		return nil, err
 	}
 
-	_, err = isWaitForPIInstanceStopped(client, id, 30, powerinstanceid)
+	_, err = isWaitForPIInstanceStopped(client, id, timeout, powerinstanceid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to stop the lpar")
 	}
@@ -848,7 +848,7 @@ func stopLparForResourceChange(client *st.IBMPIInstanceClient, id, powerinstance
 
 // Start the lpar
 
-func startLparAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string) (interface{}, error) {
+func startLparAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
 	//TODO
 	body := &models.PVMInstanceAction{
 		Action: ptrToString("start"),
@@ -858,7 +858,7 @@ func startLparAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinsta
 		return nil, fmt.Errorf("start Action failed on [%s] %s", id, err)
 	}
 
-	_, err = isWaitForPIInstanceAvailable(client, id, 30, powerinstanceid, "OK")
+	_, err = isWaitForPIInstanceAvailable(client, id, timeout, powerinstanceid, "OK")
 	if err != nil {
 		return nil, fmt.Errorf("failed to stop the lpar")
 	}
@@ -868,7 +868,7 @@ func startLparAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinsta
 
 // Stop / Modify / Start only when the lpar is off limits
 
-func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid string, mem, procs float64) (interface{}, error) {
+func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid string, mem, procs float64, timeout time.Duration) (interface{}, error) {
 	/*
 		These are the steps
 		1. Stop the lpar - Check if the lpar is SHUTOFF
@@ -888,7 +888,7 @@ func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid
 	if err != nil {
 		return nil, fmt.Errorf("Stop Action failed on [%s]: %s", id, err)
 	}
-	_, err = isWaitForPIInstanceStopped(client, id, 30, powerinstanceid)
+	_, err = isWaitForPIInstanceStopped(client, id, timeout, powerinstanceid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to stop the lpar")
 	}
@@ -905,7 +905,7 @@ func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid
 		return nil, fmt.Errorf("failed to update the lpar with the change, %s", updateErr)
 	}
 
-	_, err = isWaitforPIInstanceUpdate(client, id, 30, powerinstanceid)
+	_, err = isWaitforPIInstanceUpdate(client, id, timeout, powerinstanceid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get an update from the Service after the resource change, %s", err)
 	}
@@ -924,7 +924,7 @@ func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid
 		return nil, fmt.Errorf("the error from the start is %s", starterr)
 	}
 
-	_, err = isWaitForPIInstanceAvailable(client, id, 30, powerinstanceid, "OK")
+	_, err = isWaitForPIInstanceAvailable(client, id, timeout, powerinstanceid, "OK")
 	if err != nil {
 		return nil, fmt.Errorf("failed to stop the lpar %s", err)
 	}
@@ -934,18 +934,17 @@ func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid
 }
 
 func isWaitforPIInstanceUpdate(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
-	log.Printf("Waiting for PIInstance (%s) to be SHUTOFF AFTER THE RESIZE Due to DLPAR Operation ", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"RESIZE", "VERIFY_RESIZE"},
-		Target:     []string{"ACTIVE", "SHUTOFF", helpers.PIInstanceHealthOk},
-		Refresh:    isPIInstanceShutAfterResourceChange(client, id, powerinstanceid),
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Minute,
-		Timeout:    60 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power instance %s to be resized", id),
+		Pending:         []string{"RESIZE", "VERIFY_RESIZE"},
+		Target:          []string{"ACTIVE", "SHUTOFF", helpers.PIInstanceHealthOk},
+		Status:          refreshStatus(isPIInstanceShutAfterResourceChange(client, id, powerinstanceid)),
+		Delay:           10 * time.Second,
+		MinPollInterval: 5 * time.Minute,
+		Timeout:         timeout,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isPIInstanceShutAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string) resource.StateRefreshFunc {
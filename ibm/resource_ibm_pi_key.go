@@ -5,12 +5,13 @@ import (
 	"log"
 	"time"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var piKeyIDTemplate = newIDTemplate("ibm_pi_key", "{cloud_instance_id}/{key_name}", "d7bec597-4726-451f-8a63-e62e6f19c32c/mykey")
+
 func resourceIBMPIKey() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMPIKeyCreate,
@@ -18,7 +19,7 @@ func resourceIBMPIKey() *schema.Resource {
 		Update:   resourceIBMPIKeyUpdate,
 		Delete:   resourceIBMPIKeyDelete,
 		Exists:   resourceIBMPIKeyExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: piKeyIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -153,10 +154,8 @@ func resourceIBMPIKeyExists(d *schema.ResourceData, meta interface{}) (bool, err
 
 	key, err := client.Get(parts[1], powerinstanceid)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
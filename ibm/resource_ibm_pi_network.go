@@ -15,6 +15,8 @@ import (
 	"github.com/IBM-Cloud/power-go-client/helpers"
 )
 
+var piNetworkIDTemplate = newIDTemplate("ibm_pi_network", "{cloud_instance_id}/{network_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+
 func resourceIBMPINetwork() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIBMPINetworkCreate,
@@ -22,7 +24,7 @@ func resourceIBMPINetwork() *schema.Resource {
 		Update: resourceIBMPINetworkUpdate,
 		Delete: resourceIBMPINetworkDelete,
 		//Exists:   resourceIBMPINetworkExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: piNetworkIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -198,16 +200,17 @@ func resourceIBMPINetworkExists(d *schema.ResourceData, meta interface{}) (bool,
 
 func isWaitForIBMPINetworkAvailable(client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PINetworkProvisioning},
-		Target:     []string{"NETWORK_READY"},
-		Refresh:    isIBMPINetworkRefreshFunc(client, id, powerinstanceid),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power network %s to be ready", id),
+		Pending:         []string{"retry", helpers.PINetworkProvisioning},
+		Target:          []string{"NETWORK_READY"},
+		Status:          refreshStatus(isIBMPINetworkRefreshFunc(client, id, powerinstanceid)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isIBMPINetworkRefreshFunc(client *st.IBMPINetworkClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -14,6 +14,8 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/models"
 )
 
+var piNetworkPortIDTemplate = newIDTemplate("ibm_pi_network_port", "{cloud_instance_id}/{port_id}/{network_name?}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb/network-name")
+
 func resourceIBMPINetworkPort() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIBMPINetworkPortCreate,
@@ -21,7 +23,7 @@ func resourceIBMPINetworkPort() *schema.Resource {
 		Update: resourceIBMPINetworkPortUpdate,
 		Delete: resourceIBMPINetworkPortDelete,
 		//Exists:   resourceIBMPINetworkExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: piNetworkPortIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -191,18 +193,17 @@ func resourceIBMPINetworkPortDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func isWaitForIBMPINetworkPortAvailable(client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid, networkname string) (interface{}, error) {
-	log.Printf("Waiting for Power Network (%s) that was created for Network Zone (%s) to be available.", id, networkname)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PINetworkProvisioning},
-		Target:     []string{"DOWN"},
-		Refresh:    isIBMPINetworkPortRefreshFunc(client, id, powerinstanceid, networkname),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("port %s of the Power network %s to be available", id, networkname),
+		Pending:         []string{"retry", helpers.PINetworkProvisioning},
+		Target:          []string{"DOWN"},
+		Status:          refreshStatus(isIBMPINetworkPortRefreshFunc(client, id, powerinstanceid, networkname)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isIBMPINetworkPortRefreshFunc(client *st.IBMPINetworkClient, id, powerinstanceid, networkname string) resource.StateRefreshFunc {
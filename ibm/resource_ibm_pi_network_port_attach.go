@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var piNetworkPortAttachIDTemplate = newIDTemplate("ibm_pi_network_port_attach", "{cloud_instance_id}/{port_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+
 func resourceIBMPINetworkPortAttach() *schema.Resource {
 	return &schema.Resource{
 
@@ -20,7 +22,7 @@ func resourceIBMPINetworkPortAttach() *schema.Resource {
 		Update: resourceIBMPINetworkPortAttachUpdate,
 		Delete: resourceIBMPINetworkPortAttachDelete,
 		//Exists:   resourceIBMPINetworkExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: piNetworkPortAttachIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -170,18 +172,17 @@ func resourceIBMPINetworkPortAttachDelete(d *schema.ResourceData, meta interface
 }
 
 func isWaitForIBMPINetworkPortAttachAvailable(client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid, networkname string) (interface{}, error) {
-	log.Printf("Waiting for Power Network (%s) that was created for Network Zone (%s) to be available.", id, networkname)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PINetworkProvisioning},
-		Target:     []string{"ACTIVE"},
-		Refresh:    isIBMPINetworkPortAttachRefreshFunc(client, id, powerinstanceid, networkname),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("port %s of the Power network %s to be attached", id, networkname),
+		Pending:         []string{"retry", helpers.PINetworkProvisioning},
+		Target:          []string{"ACTIVE"},
+		Status:          refreshStatus(isIBMPINetworkPortAttachRefreshFunc(client, id, powerinstanceid, networkname)),
+		Timeout:         timeout,
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isIBMPINetworkPortAttachRefreshFunc(client *st.IBMPINetworkClient, id, powerinstanceid, networkname string) resource.StateRefreshFunc {
@@ -236,18 +236,17 @@ func resourceIBMPIOperationsExists(d *schema.ResourceData, meta interface{}) (bo
 
 func isWaitForPIInstanceOperationStatus(client *st.IBMPIInstanceClient, name string, timeout time.Duration, powerinstanceid, operation, targetstatus string) (interface{}, error) {
 
-	log.Printf("Waiting for the Operation [ %s ] to be performed on the instance with name [ %s ]", operation, name)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"ACTIVE", "SHUTOFF", "WARNING"},
-		Target:     []string{targetstatus},
-		Refresh:    isPIOperationsRefreshFunc(client, name, powerinstanceid, targetstatus),
-		Delay:      1 * time.Minute,
-		MinTimeout: 2 * time.Minute,
-		Timeout:    120 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power instance %s to be %s", name, targetstatus),
+		Pending:         []string{"ACTIVE", "SHUTOFF", "WARNING"},
+		Target:          []string{targetstatus},
+		Status:          refreshStatus(isPIOperationsRefreshFunc(client, name, powerinstanceid, targetstatus)),
+		Delay:           1 * time.Minute,
+		MinPollInterval: 2 * time.Minute,
+		Timeout:         timeout,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 
 }
 
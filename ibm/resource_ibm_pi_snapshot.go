@@ -8,13 +8,14 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var piSnapshotIDTemplate = newIDTemplate("ibm_pi_snapshot", "{cloud_instance_id}/{snapshot_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+
 func resourceIBMPISnapshot() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMPISnapshotCreate,
@@ -22,7 +23,7 @@ func resourceIBMPISnapshot() *schema.Resource {
 		Update:   resourceIBMPISnapshotUpdate,
 		Delete:   resourceIBMPISnapshotDelete,
 		Exists:   resourceIBMPISnapshotExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: piSnapshotIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -195,7 +196,7 @@ func resourceIBMPISnapshotUpdate(d *schema.ResourceData, meta interface{}) error
 
 		}
 
-		_, err = isWaitForPIInstanceSnapshotAvailable(client, parts[1], d.Timeout(schema.TimeoutCreate), powerinstanceid)
+		_, err = isWaitForPIInstanceSnapshotAvailable(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid)
 		if err != nil {
 			return err
 		}
@@ -251,10 +252,8 @@ func resourceIBMPISnapshotExists(d *schema.ResourceData, meta interface{}) (bool
 
 	snapshotdelete, err := client.Get(parts[1], powerinstanceid, getTimeOut)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -267,18 +266,17 @@ func resourceIBMPISnapshotExists(d *schema.ResourceData, meta interface{}) (bool
 
 func isWaitForPIInstanceSnapshotAvailable(client *st.IBMPISnapshotClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
-	log.Printf("Waiting for PIInstance Snapshot (%s) to be available and active ", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"in_progress", "BUILD"},
-		Target:     []string{"available", "ACTIVE"},
-		Refresh:    isPIInstanceSnapshotRefreshFunc(client, id, powerinstanceid),
-		Delay:      30 * time.Second,
-		MinTimeout: 2 * time.Minute,
-		Timeout:    60 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power snapshot %s to be available", id),
+		Pending:         []string{"in_progress", "BUILD"},
+		Target:          []string{"available", "ACTIVE"},
+		Status:          refreshStatus(isPIInstanceSnapshotRefreshFunc(client, id, powerinstanceid)),
+		Delay:           30 * time.Second,
+		MinPollInterval: 2 * time.Minute,
+		Timeout:         timeout,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isPIInstanceSnapshotRefreshFunc(client *st.IBMPISnapshotClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -303,18 +301,17 @@ func isPIInstanceSnapshotRefreshFunc(client *st.IBMPISnapshotClient, id, powerin
 
 func isWaitForPIInstanceSnapshotDeleted(client *st.IBMPISnapshotClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
-	log.Printf("Waiting for  (%s) to be deleted.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PIInstanceDeleting},
-		Target:     []string{"Not Found"},
-		Refresh:    isPIInstanceSnapshotDeleteRefreshFunc(client, id, powerinstanceid),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-		Timeout:    10 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power snapshot %s to be deleted", id),
+		Pending:         []string{"retry", helpers.PIInstanceDeleting},
+		Target:          []string{"Not Found"},
+		Status:          refreshStatus(isPIInstanceSnapshotDeleteRefreshFunc(client, id, powerinstanceid)),
+		Delay:           10 * time.Second,
+		MinPollInterval: 10 * time.Second,
+		Timeout:         timeout,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isPIInstanceSnapshotDeleteRefreshFunc(client *st.IBMPISnapshotClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -3,13 +3,11 @@ package ibm
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
 )
@@ -21,6 +19,8 @@ const (
 	volDeleteTimeOut = 180 * time.Second
 )
 
+var piVolumeIDTemplate = newIDTemplate("ibm_pi_volume", "{cloud_instance_id}/{volume_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+
 func resourceIBMPIVolume() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMPIVolumeCreate,
@@ -28,7 +28,7 @@ func resourceIBMPIVolume() *schema.Resource {
 		Update:   resourceIBMPIVolumeUpdate,
 		Delete:   resourceIBMPIVolumeDelete,
 		Exists:   resourceIBMPIVolumeExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: piVolumeIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -227,10 +227,8 @@ func resourceIBMPIVolumeExists(d *schema.ResourceData, meta interface{}) (bool,
 
 	vol, err := client.Get(parts[1], powerinstanceid, getTimeOut)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -242,18 +240,17 @@ func resourceIBMPIVolumeExists(d *schema.ResourceData, meta interface{}) (bool,
 }
 
 func isWaitForIBMPIVolumeAvailable(client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for Volume (%s) to be available.", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PIVolumeProvisioning},
-		Target:     []string{helpers.PIVolumeProvisioningDone},
-		Refresh:    isIBMPIVolumeRefreshFunc(client, id, powerinstanceid),
-		Delay:      10 * time.Second,
-		MinTimeout: 2 * time.Minute,
-		Timeout:    30 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power volume %s to be available", id),
+		Pending:         []string{"retry", helpers.PIVolumeProvisioning},
+		Target:          []string{helpers.PIVolumeProvisioningDone},
+		Status:          refreshStatus(isIBMPIVolumeRefreshFunc(client, id, powerinstanceid)),
+		Delay:           10 * time.Second,
+		MinPollInterval: 2 * time.Minute,
+		Timeout:         timeout,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isIBMPIVolumeRefreshFunc(client *st.IBMPIVolumeClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -272,22 +269,23 @@ func isIBMPIVolumeRefreshFunc(client *st.IBMPIVolumeClient, id, powerinstanceid
 }
 
 func isWaitForIBMPIVolumeDeleted(client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting", helpers.PIVolumeProvisioning},
-		Target:     []string{"deleted"},
-		Refresh:    isIBMPIVolumeDeleteRefreshFunc(client, id, powerinstanceid),
-		Delay:      10 * time.Second,
-		MinTimeout: 2 * time.Minute,
-		Timeout:    30 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power volume %s to be deleted", id),
+		Pending:         []string{"deleting", helpers.PIVolumeProvisioning},
+		Target:          []string{"deleted"},
+		Status:          refreshStatus(isIBMPIVolumeDeleteRefreshFunc(client, id, powerinstanceid)),
+		Delay:           10 * time.Second,
+		MinPollInterval: 2 * time.Minute,
+		Timeout:         timeout,
 	}
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isIBMPIVolumeDeleteRefreshFunc(client *st.IBMPIVolumeClient, id, powerinstanceid string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		vol, err := client.Get(id, powerinstanceid, volGetTimeOut)
 		if err != nil {
-			if strings.Contains(err.Error(), "Resource not found") {
+			if isNotFound(err) {
 				return vol, "deleted", nil
 			}
 			return nil, "", err
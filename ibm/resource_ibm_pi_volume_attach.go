@@ -30,6 +30,7 @@ func resourceIBMPIVolumeAttach() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
@@ -167,7 +168,7 @@ func resourceIBMPIVolumeAttachUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	_, err = isWaitForIBMPIVolumeAttachAvailable(client, *volrequest.VolumeID, powerinstanceid, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForIBMPIVolumeAttachAvailable(client, *volrequest.VolumeID, powerinstanceid, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
@@ -195,18 +196,17 @@ func resourceIBMPIVolumeAttachDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func isWaitForIBMPIVolumeAttachAvailable(client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
-	log.Printf("Waiting for Volume (%s) to be available for attachment", id)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", helpers.PIVolumeProvisioning},
-		Target:     []string{helpers.PIVolumeAllowableAttachStatus},
-		Refresh:    isIBMPIVolumeAttachRefreshFunc(client, id, powerinstanceid),
-		Delay:      10 * time.Second,
-		MinTimeout: 2 * time.Minute,
-		Timeout:    10 * time.Minute,
+	waiter := &asyncWaiter{
+		Description:     fmt.Sprintf("Power volume %s to be attached", id),
+		Pending:         []string{"retry", helpers.PIVolumeProvisioning},
+		Target:          []string{helpers.PIVolumeAllowableAttachStatus},
+		Status:          refreshStatus(isIBMPIVolumeAttachRefreshFunc(client, id, powerinstanceid)),
+		Delay:           10 * time.Second,
+		MinPollInterval: 2 * time.Minute,
+		Timeout:         timeout,
 	}
 
-	return stateConf.WaitForState()
+	return waiter.wait()
 }
 
 func isIBMPIVolumeAttachRefreshFunc(client *st.IBMPIVolumeClient, id, powerinstanceid string) resource.StateRefreshFunc {
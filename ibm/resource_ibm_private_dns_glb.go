@@ -289,7 +289,7 @@ func resourceIBMPrivateDNSGLBExists(d *schema.ResourceData, meta interface{}) (b
 	getlbOptions := sess.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
 	_, detail, err := sess.GetLoadBalancer(getlbOptions)
 	if err != nil {
-		if detail != nil && detail.StatusCode == 404 {
+		if isNotFound(responseError(detail, err)) {
 			log.Printf("Get GLB failed with status code 404: %v", detail)
 			return false, nil
 		}
@@ -356,7 +356,7 @@ func isVLoadBalancerDeleteRefreshFunc(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *sche
 		getlbOptions := LoadBalancer.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
 		_, response, err := LoadBalancer.GetLoadBalancer(getlbOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return "", pdnsGLBDeleted, nil
 			}
 			return "", "", fmt.Errorf("Error Getting PDNS Load Balancer : %s\n%s", err, response)
@@ -454,9 +454,9 @@ func resourceIBMPrivateDNSGLBMonitorExists(d *schema.ResourceData, meta interfac
 	idset := strings.Split(d.Id(), "/")
 
 	getMonitorOptions := sess.NewGetMonitorOptions(idset[0], idset[1])
-	response, detail, err := sess.GetMonitor(getMonitorOptions)
+	_, detail, err := sess.GetMonitor(getMonitorOptions)
 	if err != nil {
-		if response != nil && detail != nil && detail.StatusCode == 404 {
+		if isNotFound(responseError(detail, err)) {
 			return false, nil
 		}
 		return false, err
@@ -364,9 +364,9 @@ func resourceIBMPrivateDNSGLBPoolExists(d *schema.ResourceData, meta interface{}
 	idset := strings.Split(d.Id(), "/")
 
 	getPoolOptions := sess.NewGetPoolOptions(idset[0], idset[1])
-	response, detail, err := sess.GetPool(getPoolOptions)
+	_, detail, err := sess.GetPool(getPoolOptions)
 	if err != nil {
-		if response != nil && detail != nil && detail.StatusCode == 404 {
+		if isNotFound(responseError(detail, err)) {
 			return false, nil
 		}
 		return false, err
@@ -401,7 +401,7 @@ func waitForPDNSGlbPoolDelete(d *schema.ResourceData, meta interface{}) (interfa
 		Refresh: func() (interface{}, string, error) {
 			_, detail, err := cisClient.GetPool(getPoolOptions)
 			if err != nil {
-				if detail != nil && detail.StatusCode == 404 {
+				if isNotFound(responseError(detail, err)) {
 					return detail, clusterDeleted, nil
 				}
 				return nil, "", err
@@ -5,9 +5,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var privateDNSPermittedNetworkLock = newParentLock("ibm_dns_permitted_network", "private_dns_zone")
+
 const (
 	pdnsVpcCRN                     = "vpc_crn"
 	pdnsNetworkType                = "type"
@@ -103,9 +107,8 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	zoneID := d.Get(pdnsZoneID).(string)
 	vpcCRN := d.Get(pdnsVpcCRN).(string)
 	nwType := d.Get(pdnsNetworkType).(string)
-	mk := "private_dns_permitted_network_" + instanceID + zoneID
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
+	unlock := privateDNSPermittedNetworkLock.lock(instanceID, zoneID)
+	defer unlock()
 
 	createPermittedNetworkOptions := sess.NewCreatePermittedNetworkOptions(instanceID, zoneID)
 	permittedNetworkCrn, err := sess.NewPermittedNetworkVpc(vpcCRN)
@@ -115,7 +118,12 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 
 	createPermittedNetworkOptions.SetPermittedNetwork(permittedNetworkCrn)
 	createPermittedNetworkOptions.SetType(nwType)
-	response, detail, err := sess.CreatePermittedNetwork(createPermittedNetworkOptions)
+	var response *dnssvcsv1.PermittedNetwork
+	var detail *core.DetailedResponse
+	err = privateDNSPermittedNetworkLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		response, detail, err = sess.CreatePermittedNetwork(createPermittedNetworkOptions)
+		return responseError(detail, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error creating pdns permitted network:%s\n%s", err, detail)
 	}
@@ -158,11 +166,14 @@ func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta in
 	}
 
 	idSet := strings.Split(d.Id(), "/")
-	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
+	unlock := privateDNSPermittedNetworkLock.lock(idSet[0], idSet[1])
+	defer unlock()
 	deletePermittedNetworkOptions := sess.NewDeletePermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
+	var response *core.DetailedResponse
+	err = privateDNSPermittedNetworkLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		_, response, err = sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
+		return responseError(response, err)
+	})
 
 	if err != nil {
 		return fmt.Errorf("Error deleting pdns permitted network:%s\n%s", err, response)
@@ -179,13 +190,12 @@ func resourceIBMPrivateDNSPermittedNetworkExists(d *schema.ResourceData, meta in
 	}
 
 	idSet := strings.Split(d.Id(), "/")
-	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
+	unlock := privateDNSPermittedNetworkLock.lock(idSet[0], idSet[1])
+	defer unlock()
 	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
 	_, response, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, err
@@ -5,9 +5,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+var privateDNSResourceRecordLock = newParentLock("ibm_dns_resource_record", "private_dns_zone")
+
 var allowedPrivateDomainRecordTypes = []string{
 	"A", "AAAA", "CNAME", "MX", "PTR", "SRV", "TXT",
 }
@@ -282,10 +286,14 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 		createResourceRecordOptions.SetService(service)
 		createResourceRecordOptions.SetProtocol(protocol)
 	}
-	mk := "private_dns_resource_record_" + instanceID + zoneID
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
-	response, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
+	unlock := privateDNSResourceRecordLock.lock(instanceID, zoneID)
+	defer unlock()
+	var response *dnssvcsv1.ResourceRecord
+	var detail *core.DetailedResponse
+	err = privateDNSResourceRecordLock.retryConflicts(d.Timeout(schema.TimeoutCreate), func() error {
+		response, detail, err = sess.CreateResourceRecord(createResourceRecordOptions)
+		return responseError(detail, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error creating pdns resource record:%s\n%s", err, detail)
 	}
@@ -372,9 +380,8 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	mk := "private_dns_resource_record_" + idSet[0] + idSet[1]
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
+	unlock := privateDNSResourceRecordLock.lock(idSet[0], idSet[1])
+	defer unlock()
 
 	updateResourceRecordOptions := sess.NewUpdateResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 
@@ -462,7 +469,11 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			updateResourceRecordOptions.SetProtocol(protocol)
 		}
 
-		_, detail, err := sess.UpdateResourceRecord(updateResourceRecordOptions)
+		var detail *core.DetailedResponse
+		err = privateDNSResourceRecordLock.retryConflicts(d.Timeout(schema.TimeoutUpdate), func() error {
+			_, detail, err = sess.UpdateResourceRecord(updateResourceRecordOptions)
+			return responseError(detail, err)
+		})
 		if err != nil {
 			return fmt.Errorf("Error updating pdns resource record:%s\n%s", err, detail)
 		}
@@ -480,10 +491,13 @@ func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta inte
 	}
 
 	deleteResourceRecordOptions := sess.NewDeleteResourceRecordOptions(idSet[0], idSet[1], idSet[2])
-	mk := "private_dns_resource_record_" + idSet[0] + idSet[1]
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
-	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
+	unlock := privateDNSResourceRecordLock.lock(idSet[0], idSet[1])
+	defer unlock()
+	var response *core.DetailedResponse
+	err = privateDNSResourceRecordLock.retryConflicts(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = sess.DeleteResourceRecord(deleteResourceRecordOptions)
+		return responseError(response, err)
+	})
 	if err != nil {
 		return fmt.Errorf("Error deleting pdns resource record:%s\n%s", err, response)
 	}
@@ -500,13 +514,12 @@ func resourceIBMPrivateDNSResourceRecordExists(d *schema.ResourceData, meta inte
 
 	idSet := strings.Split(d.Id(), "/")
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
-	mk := "private_dns_resource_record_" + idSet[0] + idSet[1]
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
+	unlock := privateDNSResourceRecordLock.lock(idSet[0], idSet[1])
+	defer unlock()
 	_, response, err := sess.GetResourceRecord(getResourceRecordOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return false, nil
 		}
 		return false, err
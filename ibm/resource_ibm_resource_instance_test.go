@@ -2,12 +2,14 @@ package ibm
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
 	"github.com/IBM-Cloud/bluemix-go/models"
@@ -304,3 +306,47 @@ func testAccCheckIBMResourceInstanceServiceendpoints(serviceName string) string
 			
 	`, serviceName)
 }
+
+func TestUnitIBMResourceInstanceRead(t *testing.T) {
+	cloud := newFakeCloud(t)
+	defer cloud.close()
+	cloud.controller.addPlan("fake-kms-service", "kms", "fake-kms-tiered-plan", "tiered-pricing")
+	id := cloud.controller.addInstance("fake-kms", "fake-kms-tiered-plan", fakeResourceGroupID, "us-south")
+	meta := cloud.session()
+	if err := UpdateTagsUsingCRN(nil, newStringSet(resourceIBMVPCHash, []string{"env:test"}), meta, id); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceIBMResourceInstance().Schema, map[string]interface{}{})
+	d.SetId(id)
+	if err := resourceIBMResourceInstanceRead(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]interface{}{
+		"name":              "fake-kms",
+		"service":           "kms",
+		"plan":              "tiered-pricing",
+		"location":          "us-south",
+		"resource_group_id": fakeResourceGroupID,
+		"crn":               id,
+	}
+	for key, value := range expected {
+		if d.Get(key) != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, d.Get(key))
+		}
+	}
+	if tags := d.Get("tags").(*schema.Set); tags.Len() != 1 || !tags.Contains("env:test") {
+		t.Errorf("expected the tags of the instance, got %v", tags.List())
+	}
+
+	if exists, err := resourceIBMResourceInstanceExists(d, meta); err != nil || !exists {
+		t.Errorf("expected the instance to exist, got %t (%v)", exists, err)
+	}
+	// bluemix-go replaces the 404 of the instance by ResourceServiceInstanceDoesnotExist
+	cloud.once(http.MethodGet, "/resource_controller/v1/resource_instances/{id}", func(r *fakeRequest) (int, interface{}) {
+		return http.StatusNotFound, map[string]string{"message": "Instance not found"}
+	})
+	if exists, err := resourceIBMResourceInstanceExists(d, meta); err != nil || exists {
+		t.Errorf("expected the instance not to exist, got %t (%v)", exists, err)
+	}
+}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv2"
 	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev1/controller"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/crn"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -236,10 +235,8 @@ func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (boo
 
 	resourceKey, err := rsContClient.ResourceServiceKey().GetKey(resourceKeyID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -0,0 +1,249 @@
+package ibm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	resourceTagResourceID = "resource_id"
+	resourceTagTags       = "tags"
+	resourceTagType       = "tag_type"
+
+	resourceTagTypeUser   = "user"
+	resourceTagTypeAccess = "access"
+)
+
+func resourceIBMResourceTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMResourceTagCreate,
+		Read:   resourceIBMResourceTagRead,
+		Update: resourceIBMResourceTagUpdate,
+		Delete: resourceIBMResourceTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMResourceTagImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			resourceTagResourceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CRN of the resource on which the tags are attached",
+			},
+
+			resourceTagTags: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         resourceIBMVPCHash,
+				Description: "List of tags attached to the resource",
+			},
+
+			resourceTagType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      resourceTagTypeUser,
+				ValidateFunc: InvokeValidator("ibm_resource_tag", resourceTagType),
+				Description:  "Type of the tags, user or access",
+			},
+		},
+	}
+}
+
+func resourceIBMResourceTagValidator() *ResourceValidator {
+
+	validateSchema := make([]ValidateSchema, 1)
+	tagTypes := "user, access"
+
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 resourceTagType,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			Default:                    resourceTagTypeUser,
+			AllowedValues:              tagTypes})
+
+	ibmResourceTagResourceValidator := ResourceValidator{ResourceName: "ibm_resource_tag", Schema: validateSchema}
+	return &ibmResourceTagResourceValidator
+}
+
+func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) error {
+	resourceID := d.Get(resourceTagResourceID).(string)
+	tagType := d.Get(resourceTagType).(string)
+	tags := expandStringList(d.Get(resourceTagTags).(*schema.Set).List())
+
+	err := attachResourceTags(meta, resourceID, tagType, tags)
+	if err != nil {
+		return err
+	}
+	d.SetId(resourceID)
+	return resourceIBMResourceTagRead(d, meta)
+}
+
+func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error {
+	resourceID := d.Get(resourceTagResourceID).(string)
+	tagType := d.Get(resourceTagType).(string)
+
+	attached, err := listResourceTags(meta, resourceID, tagType)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error getting tags of %s: %s", resourceID, err)
+	}
+
+	// Only the tags managed by this resource are kept, the other tags attached to the
+	// resource are managed elsewhere
+	tags := d.Get(resourceTagTags).(*schema.Set).Intersection(attached)
+	d.Set(resourceTagTags, tags)
+	return nil
+}
+
+func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	resourceID := d.Get(resourceTagResourceID).(string)
+	tagType := d.Get(resourceTagType).(string)
+
+	if d.HasChange(resourceTagTags) {
+		o, n := d.GetChange(resourceTagTags)
+		olds := o.(*schema.Set)
+		news := n.(*schema.Set)
+		remove := expandStringList(olds.Difference(news).List())
+		add := expandStringList(news.Difference(olds).List())
+
+		if len(remove) > 0 {
+			err := detachResourceTags(meta, resourceID, tagType, remove)
+			if err != nil {
+				return err
+			}
+		}
+		if len(add) > 0 {
+			err := attachResourceTags(meta, resourceID, tagType, add)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return resourceIBMResourceTagRead(d, meta)
+}
+
+func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) error {
+	resourceID := d.Get(resourceTagResourceID).(string)
+	tagType := d.Get(resourceTagType).(string)
+	tags := expandStringList(d.Get(resourceTagTags).(*schema.Set).List())
+
+	if len(tags) > 0 {
+		err := detachResourceTags(meta, resourceID, tagType, tags)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMResourceTagImport imports the tags attached to a CRN, access tags are
+// imported with the "/access" suffix, e.g. crn:v1:bluemix:public:is:us-south:a/123::vpc:r006-123/access
+func resourceIBMResourceTagImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceID := d.Id()
+	tagType := resourceTagTypeUser
+	for _, t := range []string{resourceTagTypeUser, resourceTagTypeAccess} {
+		if strings.HasSuffix(resourceID, "/"+t) {
+			resourceID = strings.TrimSuffix(resourceID, "/"+t)
+			tagType = t
+		}
+	}
+	if !strings.HasPrefix(resourceID, "crn:") {
+		return nil, fmt.Errorf("Invalid ID %q for ibm_resource_tag, the ID must be the CRN of the resource, followed by /access to import access tags", d.Id())
+	}
+
+	tags, err := listResourceTags(meta, resourceID, tagType)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting tags of %s: %s", resourceID, err)
+	}
+	d.SetId(resourceID)
+	d.Set(resourceTagResourceID, resourceID)
+	d.Set(resourceTagType, tagType)
+	d.Set(resourceTagTags, tags)
+	return []*schema.ResourceData{d}, nil
+}
+
+func listResourceTags(meta interface{}, resourceID, tagType string) (*schema.Set, error) {
+	gtClient, err := meta.(ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting global tagging client settings: %s", err)
+	}
+
+	var tags []string
+	var offset int64
+	for {
+		listOptions := gtClient.NewListTagsOptions()
+		listOptions.SetAttachedTo(resourceID)
+		listOptions.SetTagType(tagType)
+		listOptions.SetOffset(offset)
+		listOptions.SetLimit(1000)
+		result, response, err := gtClient.ListTags(listOptions)
+		if err != nil {
+			return nil, responseError(response, err)
+		}
+		for _, item := range result.Items {
+			tags = append(tags, *item.Name)
+		}
+		offset += int64(len(result.Items))
+		if len(result.Items) == 0 || result.TotalCount == nil || offset >= *result.TotalCount {
+			break
+		}
+	}
+	return newStringSet(resourceIBMVPCHash, tags), nil
+}
+
+func attachResourceTags(meta interface{}, resourceID, tagType string, tags []string) error {
+	gtClient, err := meta.(ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("Error getting global tagging client settings: %s", err)
+	}
+	attachOptions := gtClient.NewAttachTagOptions([]globaltaggingv1.Resource{{ResourceID: &resourceID}})
+	attachOptions.SetTagNames(tags)
+	attachOptions.SetTagType(tagType)
+	result, response, err := gtClient.AttachTag(attachOptions)
+	if err != nil {
+		return fmt.Errorf("Error attaching tags %v to %s: %s", tags, resourceID, responseError(response, err))
+	}
+	return tagResultsError(result, response, "attaching", tags, resourceID)
+}
+
+func detachResourceTags(meta interface{}, resourceID, tagType string, tags []string) error {
+	gtClient, err := meta.(ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("Error getting global tagging client settings: %s", err)
+	}
+	detachOptions := gtClient.NewDetachTagOptions([]globaltaggingv1.Resource{{ResourceID: &resourceID}})
+	detachOptions.SetTagNames(tags)
+	detachOptions.SetTagType(tagType)
+	result, response, err := gtClient.DetachTag(detachOptions)
+	if err != nil {
+		return fmt.Errorf("Error detaching tags %v from %s: %s", tags, resourceID, responseError(response, err))
+	}
+	return tagResultsError(result, response, "detaching", tags, resourceID)
+}
+
+// tagResultsError returns the error reported for the resource, Global Tagging
+// answers 200 when tags cannot be attached or detached
+func tagResultsError(result *globaltaggingv1.TagResults, response *core.DetailedResponse, action string, tags []string, resourceID string) error {
+	if result == nil {
+		return nil
+	}
+	for _, r := range result.Results {
+		if r.IsError != nil && *r.IsError {
+			return fmt.Errorf("Error %s tags %v for %s: %s", action, tags, resourceID, response)
+		}
+	}
+	return nil
+}
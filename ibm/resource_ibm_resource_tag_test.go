@@ -0,0 +1,90 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccIBMResourceTag_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-tag-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMResourceTagDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMResourceTagConfig(name, `"env:test"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMResourceTagAttached("ibm_resource_tag.tag", "env:test"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tags.#", "1"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tag_type", "user"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMResourceTagConfig(name, `"env:test", "team:terraform"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMResourceTagAttached("ibm_resource_tag.tag", "team:terraform"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tags.#", "2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_resource_tag.tag",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceTagAttached(n, tag string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		tags, err := listResourceTags(testAccProvider.Meta(), rs.Primary.ID, rs.Primary.Attributes["tag_type"])
+		if err != nil {
+			return err
+		}
+		if !tags.Contains(tag) {
+			return fmt.Errorf("Tag %s is not attached to %s: %v", tag, rs.Primary.ID, tags.List())
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMResourceTagDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_resource_tag" {
+			continue
+		}
+		tags, err := listResourceTags(testAccProvider.Meta(), rs.Primary.ID, rs.Primary.Attributes["tag_type"])
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if tags.Contains("env:test") {
+			return fmt.Errorf("Tag env:test is still attached to %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMResourceTagConfig(name, tags string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_resource_tag" "tag" {
+		resource_id = ibm_is_vpc.vpc.crn
+		tags        = [%s]
+	}
+	`, name, tags)
+}
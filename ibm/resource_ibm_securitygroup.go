@@ -99,7 +99,7 @@ func resourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		// If the group is somehow already destroyed, mark as
 		// succesfully gone
-		if err, ok := err.(sl.Error); ok && err.StatusCode == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -168,10 +168,8 @@ func resourceIBMSecurityGroupExists(d *schema.ResourceData, meta interface{}) (b
 
 	result, err := service.Id(groupID).GetObject()
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -196,7 +196,7 @@ func resourceIBMSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		// If the group is somehow already destroyed, mark as
 		// succesfully gone
-		if err, ok := err.(sl.Error); ok && err.StatusCode == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -276,7 +276,7 @@ func resourceIBMSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 	id, _ := strconv.Atoi(d.Id())
 	_, err := service.Id(sgID).RemoveRules([]int{id})
 	if err != nil {
-		if err, ok := err.(sl.Error); ok && err.StatusCode == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -297,7 +297,7 @@ func resourceIBMSecurityGroupRuleExists(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		// If the group is somehow already destroyed, mark as
 		// succesfully gone
-		if err, ok := err.(sl.Error); ok && err.StatusCode == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return false, nil
 		}
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -19,7 +18,7 @@ const (
 )
 
 func resourceIBMServiceInstance() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create:   resourceIBMServiceInstanceCreate,
 		Read:     resourceIBMServiceInstanceRead,
 		Update:   resourceIBMServiceInstanceUpdate,
@@ -27,6 +26,12 @@ func resourceIBMServiceInstance() *schema.Resource {
 		Exists:   resourceIBMServiceInstanceExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -104,7 +109,7 @@ func resourceIBMServiceInstance() *schema.Resource {
 				Description: "Define timeout to wait for the service instances to succeeded/deleted etc.",
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Default:     10,
+				Deprecated:  waitTimeMinutesDeprecation,
 			},
 			"dashboard_url": {
 				Description: "Dashboard URL to access resource.",
@@ -112,7 +117,7 @@ func resourceIBMServiceInstance() *schema.Resource {
 				Computed:    true,
 			},
 		},
-	}
+	}, upgradeWaitTimeMinutesState(10))
 }
 
 func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
@@ -167,7 +172,7 @@ func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(service.Metadata.GUID)
 
-	_, err = waitForServiceInstanceAvailable(d, meta)
+	_, err = waitForServiceInstanceAvailable(d, meta, waitTimeout(d, schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for create service (%s) to be succeeded: %s", d.Id(), err)
@@ -253,7 +258,7 @@ func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error updating service: %s", err)
 	}
 
-	_, err = waitForServiceInstanceAvailable(d, meta)
+	_, err = waitForServiceInstanceAvailable(d, meta, waitTimeout(d, schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for update service (%s) to be succeeded: %s", d.Id(), err)
@@ -293,10 +298,8 @@ func resourceIBMServiceInstanceExists(d *schema.ResourceData, meta interface{})
 
 	service, err := cfClient.ServiceInstances().Get(serviceGUID)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -320,7 +323,7 @@ func getServiceTags(d *schema.ResourceData) []string {
 	return tags
 }
 
-func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
 		return false, err
@@ -333,7 +336,7 @@ func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (
 		Refresh: func() (interface{}, string, error) {
 			service, err := cfClient.ServiceInstances().Get(serviceGUID)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("The service instance %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", err
@@ -343,7 +346,7 @@ func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (
 			}
 			return service, service.Entity.LastOperation.State, nil
 		},
-		Timeout:    time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
@@ -363,7 +366,7 @@ func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (int
 		Refresh: func() (interface{}, string, error) {
 			service, err := cfClient.ServiceInstances().Get(serviceGUID)
 			if err != nil {
-				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				if isNotFound(err) {
 					return service, svcInstanceSuccessStatus, nil
 				}
 				return nil, "", err
@@ -373,7 +376,7 @@ func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (int
 			}
 			return service, service.Entity.LastOperation.State, nil
 		},
-		Timeout:    time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute,
+		Timeout:    waitTimeout(d, schema.TimeoutDelete),
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -240,10 +239,8 @@ func resourceIBMSpaceExists(d *schema.ResourceData, meta interface{}) (bool, err
 
 	space, err := cfClient.Spaces().Get(id)
 	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+		if isNotFound(err) {
+			return false, nil
 		}
 		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
@@ -32,6 +32,10 @@ func resourceIBMSSLCertificate() *schema.Resource {
 		Delete:   resourceIBMSSLCertificateDelete,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"server_count": {
@@ -469,7 +473,7 @@ func resourceIBMSSLCertificateCreate(d *schema.ResourceData, m interface{}) erro
 			return fmt.Errorf("Error during creation of ssl: %s", err)
 		}
 
-		ssl, err := findSSLByOrderId(sess, *receipt.OrderId)
+		ssl, err := findSSLByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 		d.SetId(fmt.Sprintf("%d", *ssl.Id))
 		return resourceIBMSSLCertificateRead(d, m)
 	} else {
@@ -811,7 +815,7 @@ func buildSSLProductOrderContainer(d *schema.ResourceData, sess *session1.Sessio
 	return &sslContainer, nil
 }
 
-func findSSLByOrderId(sess *session1.Session, orderId int) (datatypes.Security_Certificate_Request, error) {
+func findSSLByOrderId(sess *session1.Session, orderId int, timeout time.Duration) (datatypes.Security_Certificate_Request, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -830,7 +834,7 @@ func findSSLByOrderId(sess *session1.Session, orderId int) (datatypes.Security_C
 				return nil, "pending", nil
 			}
 		},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
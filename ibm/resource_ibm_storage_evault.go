@@ -378,7 +378,7 @@ func WaitForEvaultAvailable(d *schema.ResourceData, meta interface{}, timeout st
 			service := services.GetNetworkStorageBackupEvaultService(sess)
 			result, err := service.Id(id).Mask("activeTransactionCount").GetObject()
 			if err != nil {
-				if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("Error retrieving evault: %s", err)
 				}
 				return false, "retry", nil
@@ -412,7 +412,7 @@ func resourceIBMStorageEvaultExists(d *schema.ResourceData, meta interface{}) (b
 		GetObject()
 
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+		if isNotFound(err) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving evault information: %s", err)
@@ -583,7 +583,7 @@ func resourceIBMStorageFileExists(d *schema.ResourceData, meta interface{}) (boo
 		GetObject()
 
 	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+		if isNotFound(err) {
 			return false, nil
 		}
 		return false, fmt.Errorf("Error retrieving storage information: %s", err)
@@ -757,7 +757,7 @@ func WaitForStorageAvailable(d *schema.ResourceData, meta interface{}) (interfac
 			service := services.GetNetworkStorageService(sess)
 			result, err := service.Id(id).Mask("activeTransactionCount").GetObject()
 			if err != nil {
-				if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("Error retrieving storage: %s", err)
 				}
 				return false, "retry", nil
@@ -1528,7 +1528,7 @@ func WaitForStorageUpdate(d *schema.ResourceData, meta interface{}) (interface{}
 			service := services.GetNetworkStorageService(sess)
 			result, err := service.Id(id).Mask(storageDetailMask).GetObject()
 			if err != nil {
-				if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+				if isNotFound(err) {
 					return nil, "", fmt.Errorf("Error retrieving storage: %s", err)
 				}
 				return result, "provisioning", nil
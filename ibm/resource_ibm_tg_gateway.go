@@ -48,7 +48,7 @@ func resourceIBMTransitGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -261,7 +261,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 
 	tgw, response, err := client.GetTransitGateway(tgOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -368,7 +368,7 @@ func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) e
 	response, err := client.DeleteTransitGateway(delOptions)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error deleting Transit Gateway (%s): %s\n%s", ID, err, response)
@@ -404,7 +404,7 @@ func isTransitGatewayDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatew
 		}
 		transitGateway, response, err := client.GetTransitGateway(gettgwoptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return transitGateway, isTransitGatewayDeleted, nil
 			}
 			return nil, "", fmt.Errorf("Error Getting Transit Gateway: %s\n%s", err, response)
@@ -428,7 +428,7 @@ func resourceIBMTransitGatewayExists(d *schema.ResourceData, meta interface{}) (
 	}
 	_, response, err := client.GetTransitGateway(tgOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return false, nil
 		}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -27,6 +28,9 @@ const (
 	tgConnectionId                      = "connection_id"
 )
 
+var tgGatewayConnectionIDTemplate = newIDTemplate("ibm_tg_connection", "{gateway_id}/{connection_id}", "d7bec597-4726-451f-8a63-e62e6f19c32c/cea6651a-bc0a-4438-9f8a-a0770bbf3ebb")
+var tgGatewayConnectionLock = newParentLock("ibm_tg_connection", "transit_gateway")
+
 func resourceIBMTransitGatewayConnection() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMTransitGatewayConnectionCreate,
@@ -34,7 +38,7 @@ func resourceIBMTransitGatewayConnection() *schema.Resource {
 		Delete:   resourceIBMTransitGatewayConnectionDelete,
 		Exists:   resourceIBMTransitGatewayConnectionExists,
 		Update:   resourceIBMTransitGatewayConnectionUpdate,
-		Importer: &schema.ResourceImporter{},
+		Importer: tgGatewayConnectionIDTemplate.importer(),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -162,7 +166,12 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 		createTransitGatewayConnectionOptions.SetNetworkAccountID(networkAccId)
 	}
 
-	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
+	var tgConnections *transitgatewayapisv1.TransitGatewayConnectionCust
+	var response *core.DetailedResponse
+	err = tgGatewayConnectionLock.mutate(d.Timeout(schema.TimeoutCreate), func() error {
+		tgConnections, response, err = client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
+		return responseError(response, err)
+	}, gatewayId)
 	if err != nil {
 		return fmt.Errorf("Create Transit Gateway connection err %s\n%s", err, response)
 	}
@@ -239,7 +248,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	getTransitGatewayConnectionOptions.SetID(ID)
 	instance, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return nil
 		}
@@ -318,7 +327,10 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
+	err = tgGatewayConnectionLock.mutate(d.Timeout(schema.TimeoutUpdate), func() error {
+		_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
+		return responseError(response, err)
+	}, gatewayId)
 	if err != nil {
 		return fmt.Errorf("Error in Update Transit Gateway Connection : %s\n%s", err, response)
 	}
@@ -343,10 +355,14 @@ func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta inte
 		ID: &ID,
 	}
 	deleteTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
-	response, err := client.DeleteTransitGatewayConnection(deleteTransitGatewayConnectionOptions)
+	var response *core.DetailedResponse
+	err = tgGatewayConnectionLock.mutate(d.Timeout(schema.TimeoutDelete), func() error {
+		response, err = client.DeleteTransitGatewayConnection(deleteTransitGatewayConnectionOptions)
+		return responseError(response, err)
+	}, gatewayId)
 
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			return nil
 		}
 		return fmt.Errorf("Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response)
@@ -394,7 +410,7 @@ func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.Tr
 
 		if err != nil {
 
-			if response != nil && response.StatusCode == 404 {
+			if isNotFound(responseError(response, err)) {
 				return tgConnection, isTransitGatewayConnectionDeleted, nil
 			}
 
@@ -422,7 +438,7 @@ func resourceIBMTransitGatewayConnectionExists(d *schema.ResourceData, meta inte
 	getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
 	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
 	if err != nil {
-		if response != nil && response.StatusCode == 404 {
+		if isNotFound(responseError(response, err)) {
 			d.SetId("")
 			return false, nil
 		}
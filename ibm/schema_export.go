@@ -0,0 +1,358 @@
+package ibm
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// jsonSchema is the subset of JSON Schema produced by the schema-export command
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties interface{}            `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	MinItems             int                    `json:"minItems,omitempty"`
+	MaxItems             int                    `json:"maxItems,omitempty"`
+	UniqueItems          bool                   `json:"uniqueItems,omitempty"`
+	Enum                 []interface{}          `json:"enum,omitempty"`
+	Minimum              *int                   `json:"minimum,omitempty"`
+	Maximum              *int                   `json:"maximum,omitempty"`
+	MinLength            int                    `json:"minLength,omitempty"`
+	MaxLength            int                    `json:"maxLength,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	ContentMediaType     string                 `json:"contentMediaType,omitempty"`
+	Default              interface{}            `json:"default,omitempty"`
+	ReadOnly             bool                   `json:"readOnly,omitempty"`
+	Deprecated           bool                   `json:"deprecated,omitempty"`
+	OneOf                []*jsonSchema          `json:"oneOf,omitempty"`
+	AllOf                []*jsonSchema          `json:"allOf,omitempty"`
+	If                   *jsonSchema            `json:"if,omitempty"`
+	Then                 *jsonSchema            `json:"then,omitempty"`
+	ForceNew             bool                   `json:"x-force-new,omitempty"`
+	Sensitive            bool                   `json:"x-sensitive,omitempty"`
+	Validator            string                 `json:"x-validator,omitempty"`
+}
+
+// schemaExport is the document written by the schema-export command
+type schemaExport struct {
+	Provider    *jsonSchema            `json:"provider,omitempty"`
+	Resources   map[string]*jsonSchema `json:"resources,omitempty"`
+	DataSources map[string]*jsonSchema `json:"data_sources,omitempty"`
+}
+
+// SchemaExportCommand implements the schema-export subcommand of the provider binary.
+// It writes the JSON Schema of the provider, resources and data sources, merged with
+// the constraints of the Validator dictionary, to out or to one file per schema in -dir.
+func SchemaExportCommand(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("schema-export", flag.ContinueOnError)
+	flags.SetOutput(out)
+	dir := flags.String("dir", "", "Write one <name>.json file per resource and data source to this directory instead of the standard output")
+	flags.Usage = func() {
+		fmt.Fprintf(out, "Usage: terraform-provider-ibm schema-export [-dir DIR] [NAME...]\n\n")
+		fmt.Fprintf(out, "Exports the JSON Schema of the resources and data sources, all of them when no NAME is given.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	export, err := exportSchemas(Provider().(*schema.Provider), Validator(), flags.Args())
+	if err != nil {
+		return err
+	}
+	if *dir == "" {
+		return writeJSON(out, export)
+	}
+	write := func(kind string, schemas map[string]*jsonSchema) error {
+		if err := os.MkdirAll(filepath.Join(*dir, kind), 0755); err != nil {
+			return fmt.Errorf("Error creating the schema directory: %s", err)
+		}
+		for name, s := range schemas {
+			f, err := os.Create(filepath.Join(*dir, kind, name+".json"))
+			if err != nil {
+				return fmt.Errorf("Error creating the schema of %s: %s", name, err)
+			}
+			err = writeJSON(f, s)
+			f.Close()
+			if err != nil {
+				return fmt.Errorf("Error writing the schema of %s: %s", name, err)
+			}
+		}
+		return nil
+	}
+	if export.Provider != nil {
+		if err := write(".", map[string]*jsonSchema{"provider": export.Provider}); err != nil {
+			return err
+		}
+	}
+	if err := write("resources", export.Resources); err != nil {
+		return err
+	}
+	return write("data-sources", export.DataSources)
+}
+
+func writeJSON(out io.Writer, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(append(b, '\n'))
+	return err
+}
+
+// exportSchemas converts the schemas of the provider, all of them or the named ones only
+func exportSchemas(provider *schema.Provider, validators ValidatorDict, names []string) (*schemaExport, error) {
+	export := &schemaExport{
+		Resources:   map[string]*jsonSchema{},
+		DataSources: map[string]*jsonSchema{},
+	}
+	if len(names) == 0 {
+		export.Provider = resourceJSONSchema("provider", &schema.Resource{Schema: provider.Schema}, nil)
+		for name := range provider.ResourcesMap {
+			names = append(names, name)
+		}
+		for name := range provider.DataSourcesMap {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resource, isResource := provider.ResourcesMap[name]
+		dataSource, isDataSource := provider.DataSourcesMap[name]
+		if !isResource && !isDataSource {
+			return nil, fmt.Errorf("Unknown resource or data source %s", name)
+		}
+		if isResource {
+			export.Resources[name] = resourceJSONSchema(name, resource, validators.ResourceValidatorDictionary[name])
+		}
+		if isDataSource {
+			export.DataSources[name] = resourceJSONSchema(name, dataSource, validators.DataSourceValidatorDictionary[name])
+		}
+	}
+	return export, nil
+}
+
+func resourceJSONSchema(name string, resource *schema.Resource, validator *ResourceValidator) *jsonSchema {
+	s := blockJSONSchema(resource.Schema, validator)
+	s.Schema = jsonSchemaDraft
+	s.Title = name
+	s.Deprecated = resource.DeprecationMessage != ""
+	if resource.Timeouts != nil {
+		timeouts := &jsonSchema{Type: "object", Properties: map[string]*jsonSchema{}, AdditionalProperties: false}
+		for key, timeout := range map[string]*time.Duration{
+			schema.TimeoutCreate: resource.Timeouts.Create,
+			schema.TimeoutRead:   resource.Timeouts.Read,
+			schema.TimeoutUpdate: resource.Timeouts.Update,
+			schema.TimeoutDelete: resource.Timeouts.Delete,
+		} {
+			if timeout != nil {
+				timeouts.Properties[key] = &jsonSchema{Type: "string", Default: timeout.String()}
+			}
+		}
+		s.Properties["timeouts"] = &jsonSchema{Type: "array", MaxItems: 1, Items: timeouts}
+	}
+	if validator != nil {
+		for _, rule := range validator.Rules {
+			target := s
+			if rule.Block != "" {
+				target = s.Properties[rule.Block].Items
+			}
+			target.AllOf = append(target.AllOf, ruleJSONSchema(rule, target))
+		}
+	}
+	return s
+}
+
+func blockJSONSchema(attributes map[string]*schema.Schema, validator *ResourceValidator) *jsonSchema {
+	s := &jsonSchema{Type: "object", Properties: map[string]*jsonSchema{}, AdditionalProperties: false}
+	for key, attribute := range attributes {
+		s.Properties[key] = attributeJSONSchema(key, attribute, validator)
+		if attribute.Required {
+			s.Required = append(s.Required, key)
+		}
+	}
+	sort.Strings(s.Required)
+	return s
+}
+
+func attributeJSONSchema(key string, attribute *schema.Schema, validator *ResourceValidator) *jsonSchema {
+	s := &jsonSchema{
+		Description: attribute.Description,
+		Default:     attribute.Default,
+		ReadOnly:    attribute.Computed && !attribute.Optional && !attribute.Required,
+		Deprecated:  attribute.Deprecated != "" || attribute.Removed != "",
+		ForceNew:    attribute.ForceNew,
+		Sensitive:   attribute.Sensitive,
+	}
+	switch attribute.Type {
+	case schema.TypeBool:
+		s.Type = "boolean"
+	case schema.TypeInt:
+		s.Type = "integer"
+	case schema.TypeFloat:
+		s.Type = "number"
+	case schema.TypeString:
+		s.Type = "string"
+	case schema.TypeList, schema.TypeSet:
+		s.Type = "array"
+		s.MinItems = attribute.MinItems
+		s.MaxItems = attribute.MaxItems
+		s.UniqueItems = attribute.Type == schema.TypeSet
+		s.Items = elemJSONSchema(key, attribute.Elem, validator)
+	case schema.TypeMap:
+		s.Type = "object"
+		s.AdditionalProperties = elemJSONSchema(key, attribute.Elem, validator)
+	}
+
+	// The validators are registered by attribute name, only the attributes validating their value use them
+	if attribute.ValidateFunc != nil {
+		if vs, ok := findValidateSchema(validator, key); ok {
+			switch {
+			case attribute.Type == schema.TypeMap:
+				addValidateConstraints(s.AdditionalProperties.(*jsonSchema), vs)
+			case vs.Elements && s.Items != nil:
+				addValidateConstraints(s.Items, vs)
+			default:
+				addValidateConstraints(s, vs)
+			}
+		}
+	}
+	return s
+}
+
+func elemJSONSchema(key string, elem interface{}, validator *ResourceValidator) *jsonSchema {
+	switch e := elem.(type) {
+	case *schema.Resource:
+		return blockJSONSchema(e.Schema, validator)
+	case *schema.Schema:
+		return attributeJSONSchema(key, e, validator)
+	default:
+		// Terraform defaults to strings when the element type is not set
+		return &jsonSchema{Type: "string"}
+	}
+}
+
+func findValidateSchema(validator *ResourceValidator, identifier string) (ValidateSchema, bool) {
+	if validator != nil {
+		for _, vs := range validator.Schema {
+			if vs.Identifier == identifier && vs.Identifier != "" {
+				return vs, true
+			}
+		}
+	}
+	return ValidateSchema{}, false
+}
+
+// addValidateConstraints translates the validator function of the parameter to JSON Schema keywords
+func addValidateConstraints(s *jsonSchema, vs ValidateSchema) {
+	s.Validator = vs.ValidateFunctionIdentifier.String()
+	intValue := func(constraint ValueConstraintType) *int {
+		if v, ok := vs.GetValue(constraint).(int); ok {
+			return &v
+		}
+		return nil
+	}
+	switch vs.ValidateFunctionIdentifier {
+	case IntBetween:
+		s.Minimum, s.Maximum = intValue(MinValue), intValue(MaxValue)
+	case IntAtLeast:
+		s.Minimum = intValue(MinValue)
+	case IntAtMost:
+		s.Maximum = intValue(MaxValue)
+	case ValidateAllowedStringValue, ValidateAllowedIntValue:
+		s.Enum = enumValues(s.Type, splitValues(vs.AllowedValues))
+	case StringLenBetween:
+		s.MinLength, s.MaxLength = vs.MinValueLength, vs.MaxValueLength
+	case ValidateRegexpLen:
+		s.MinLength, s.MaxLength = vs.MinValueLength, vs.MaxValueLength
+		s.Pattern = vs.Regexp
+	case ValidateRegexp:
+		s.Pattern = vs.Regexp
+	case ValidateNoZeroValues:
+		if s.Type == "string" {
+			s.MinLength = 1
+		}
+	case ValidateJSONString:
+		s.ContentMediaType = "application/json"
+	}
+}
+
+// enumValues converts the values of a validator to the JSON type of the parameter
+func enumValues(jsonType string, values []string) []interface{} {
+	enum := make([]interface{}, len(values))
+	for i, value := range values {
+		enum[i] = value
+		switch jsonType {
+		case "integer":
+			if n, err := strconv.Atoi(value); err == nil {
+				enum[i] = n
+			}
+		case "number":
+			if f, err := strconv.ParseFloat(value, 64); err == nil {
+				enum[i] = f
+			}
+		case "boolean":
+			if b, err := strconv.ParseBool(value); err == nil {
+				enum[i] = b
+			}
+		}
+	}
+	return enum
+}
+
+// ruleJSONSchema translates a cross-field rule of the object to JSON Schema keywords
+func ruleJSONSchema(rule ValidateRule, object *jsonSchema) *jsonSchema {
+	propertyType := func(identifier string) string {
+		if p, ok := object.Properties[identifier]; ok {
+			return p.Type
+		}
+		return "string"
+	}
+	var when *jsonSchema
+	if rule.Type != RuleExactlyOneOf {
+		condition := &jsonSchema{}
+		if rule.WhenValues != "" {
+			condition.Enum = enumValues(propertyType(rule.When), splitValues(rule.WhenValues))
+		}
+		when = &jsonSchema{
+			Properties: map[string]*jsonSchema{rule.When: condition},
+			Required:   []string{rule.When},
+		}
+	}
+
+	switch rule.Type {
+	case RuleExactlyOneOf:
+		s := &jsonSchema{}
+		for _, identifier := range rule.Identifiers {
+			s.OneOf = append(s.OneOf, &jsonSchema{Required: []string{identifier}})
+		}
+		return s
+	case RuleRequiredWith:
+		return &jsonSchema{If: when, Then: &jsonSchema{Required: rule.Identifiers}}
+	case RuleAllowedValuesWhen:
+		then := &jsonSchema{Properties: map[string]*jsonSchema{}}
+		for _, identifier := range rule.Identifiers {
+			then.Properties[identifier] = &jsonSchema{
+				Enum: enumValues(propertyType(identifier), splitValues(rule.AllowedValues)),
+			}
+		}
+		return &jsonSchema{If: when, Then: then}
+	default:
+		panic(fmt.Sprintf("unknown validation rule %s", rule.Type))
+	}
+}
@@ -0,0 +1,152 @@
+package ibm
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func testSchemaExportProvider() (*schema.Provider, ValidatorDict) {
+	provider := &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"region": {Type: schema.TypeString, Optional: true, Default: "us-south"},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"ibm_test": {
+				Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(10 * time.Minute)},
+				Schema: map[string]*schema.Schema{
+					"name":     {Type: schema.TypeString, Required: true, ValidateFunc: func(interface{}, string) ([]string, []error) { return nil, nil }},
+					"size":     {Type: schema.TypeInt, Optional: true, ValidateFunc: func(interface{}, string) ([]string, []error) { return nil, nil }},
+					"crn":      {Type: schema.TypeString, Computed: true},
+					"tags":     {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					"labels":   {Type: schema.TypeMap, Optional: true, ValidateFunc: func(interface{}, string) ([]string, []error) { return nil, nil }},
+					"protocol": {Type: schema.TypeString, Optional: true},
+					"rule": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"port": {Type: schema.TypeInt, Optional: true},
+							},
+						},
+					},
+				},
+			},
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"ibm_test": {
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Required: true, ValidateFunc: func(interface{}, string) ([]string, []error) { return nil, nil }},
+				},
+			},
+		},
+	}
+	validators := ValidatorDict{
+		ResourceValidatorDictionary: map[string]*ResourceValidator{
+			"ibm_test": {
+				ResourceName: "ibm_test",
+				Schema: []ValidateSchema{
+					{},
+					{Identifier: "name", ValidateFunctionIdentifier: ValidateRegexpLen, Type: TypeString, Regexp: "^[a-z]+$", MinValueLength: 1, MaxValueLength: 63},
+					{Identifier: "size", ValidateFunctionIdentifier: IntBetween, Type: TypeInt, MinValue: "0", MaxValue: "10"},
+					{Identifier: "labels", ValidateFunctionIdentifier: ValidateAllowedStringValue, Type: TypeString, AllowedValues: "on, off", Elements: true},
+				},
+				Rules: []ValidateRule{
+					{Type: RuleRequiredWith, Identifiers: []string{"rule"}, When: "protocol", WhenValues: "tcp"},
+					{Type: RuleAllowedValuesWhen, Block: "rule", Identifiers: []string{"port"}, When: "port", AllowedValues: "22, 80"},
+				},
+			},
+		},
+		DataSourceValidatorDictionary: map[string]*ResourceValidator{},
+	}
+	return provider, validators
+}
+
+func TestExportSchemas(t *testing.T) {
+	provider, validators := testSchemaExportProvider()
+	export, err := exportSchemas(provider, validators, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if export.Provider == nil || export.Provider.Properties["region"].Default != "us-south" {
+		t.Errorf("expected the provider schema, got %+v", export.Provider)
+	}
+	resource := export.Resources["ibm_test"]
+	if resource == nil || export.DataSources["ibm_test"] == nil {
+		t.Fatalf("expected the resource and the data source, got %+v", export)
+	}
+	if !reflect.DeepEqual(resource.Required, []string{"name"}) || resource.AdditionalProperties != false {
+		t.Errorf("expected name to be the only required attribute, got %v", resource.Required)
+	}
+
+	props := resource.Properties
+	name, size := props["name"], props["size"]
+	if name.Pattern != "^[a-z]+$" || name.MinLength != 1 || name.MaxLength != 63 || name.Validator != "ValidateRegexpLen" {
+		t.Errorf("expected the regexp and length constraints on name, got %+v", name)
+	}
+	if size.Type != "integer" || *size.Minimum != 0 || *size.Maximum != 10 {
+		t.Errorf("expected the range constraints on size, got %+v", size)
+	}
+	if !props["crn"].ReadOnly || props["tags"].Type != "array" || !props["tags"].UniqueItems || props["tags"].Items.Type != "string" {
+		t.Errorf("expected a computed crn and a set of strings, got %+v and %+v", props["crn"], props["tags"])
+	}
+	if labels := props["labels"].AdditionalProperties.(*jsonSchema); !reflect.DeepEqual(labels.Enum, []interface{}{"on", "off"}) {
+		t.Errorf("expected the allowed values on the values of labels, got %+v", labels)
+	}
+	if rule := props["rule"]; rule.MaxItems != 2 || rule.Items.Properties["port"].Type != "integer" {
+		t.Errorf("expected a block of at most two rules, got %+v", rule)
+	}
+	if timeouts := props["timeouts"]; timeouts == nil || timeouts.Items.Properties["create"].Default != "10m0s" {
+		t.Errorf("expected the timeouts block, got %+v", timeouts)
+	}
+
+	if len(resource.AllOf) != 1 || !reflect.DeepEqual(resource.AllOf[0].Then.Required, []string{"rule"}) ||
+		!reflect.DeepEqual(resource.AllOf[0].If.Properties["protocol"].Enum, []interface{}{"tcp"}) {
+		t.Errorf("expected the required with rule, got %+v", resource.AllOf)
+	}
+	rule := props["rule"].Items
+	if len(rule.AllOf) != 1 || !reflect.DeepEqual(rule.AllOf[0].Then.Properties["port"].Enum, []interface{}{22, 80}) {
+		t.Errorf("expected the allowed values rule on the rule block, got %+v", rule.AllOf)
+	}
+
+	if _, err := exportSchemas(provider, validators, []string{"ibm_unknown"}); err == nil {
+		t.Errorf("expected an error for an unknown resource")
+	}
+}
+
+func TestSchemaExportCommand(t *testing.T) {
+	var out bytes.Buffer
+	if err := SchemaExportCommand([]string{"ibm_cis_page_rule"}, &out); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var export schemaExport
+	if err := json.Unmarshal(out.Bytes(), &export); err != nil {
+		t.Fatalf("expected a JSON document, got %s", err)
+	}
+	actions := export.Resources["ibm_cis_page_rule"].Properties[cisPageRuleActions].Items
+	if len(actions.Properties[cisPageRuleActionsID].Enum) == 0 || len(actions.AllOf) == 0 {
+		t.Errorf("expected the allowed actions and the rules of the actions, got %+v", actions)
+	}
+
+	dir, err := ioutil.TempDir("", "schema-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := SchemaExportCommand([]string{"-dir", dir, "ibm_is_vpc"}, &out); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, file := range []string{"resources/ibm_is_vpc.json", "data-sources/ibm_is_vpc.json"} {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			t.Errorf("expected %s to be written: %s", file, err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// withStateUpgrades declares the schema version of the resource, upgrades[i] upgrades
+// the state written with the schema version i to the version i+1, and the resource is
+// at the version len(upgrades). The upgrades rewrite the values of the state, e.g.
+// the format of the ID, the current schema is used to decode the legacy flatmap states.
+func withStateUpgrades(r *schema.Resource, upgrades ...schema.StateUpgradeFunc) *schema.Resource {
+	stateType := r.CoreConfigSchema().ImpliedType()
+	r.SchemaVersion = len(upgrades)
+	r.StateUpgraders = make([]schema.StateUpgrader, 0, len(upgrades))
+	for version, upgrade := range upgrades {
+		r.StateUpgraders = append(r.StateUpgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    stateType,
+			Upgrade: upgrade,
+		})
+	}
+	return r
+}
+
+// chainStateUpgrades applies the upgrades in order within a single schema version
+func chainStateUpgrades(upgrades ...schema.StateUpgradeFunc) schema.StateUpgradeFunc {
+	return func(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		var err error
+		for _, upgrade := range upgrades {
+			rawState, err = upgrade(rawState, meta)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return rawState, nil
+	}
+}
+
+// upgradeStateID rewrites the ID of the state, the state is left unchanged when it has no ID
+func upgradeStateID(upgrade func(id string, rawState map[string]interface{}) (string, error)) schema.StateUpgradeFunc {
+	return func(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		id, ok := rawState["id"].(string)
+		if !ok || id == "" {
+			return rawState, nil
+		}
+		newID, err := upgrade(id, rawState)
+		if err != nil {
+			return nil, fmt.Errorf("Error upgrading the state of %s: %s", id, err)
+		}
+		rawState["id"] = newID
+		return rawState, nil
+	}
+}
+
+// upgradeStateAttribute rewrites the value of a top level string attribute, the state
+// is left unchanged when the attribute is not set
+func upgradeStateAttribute(name string, upgrade func(value string) (string, error)) schema.StateUpgradeFunc {
+	return func(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		value, ok := rawState[name].(string)
+		if !ok || value == "" {
+			return rawState, nil
+		}
+		newValue, err := upgrade(value)
+		if err != nil {
+			return nil, fmt.Errorf("Error upgrading the attribute %s of the state: %s", name, err)
+		}
+		rawState[name] = newValue
+		return rawState, nil
+	}
+}
+
+// clearStateAttributeDefault removes a top level attribute from the state when it has
+// the given value, typically the default of an attribute which no longer has one
+func clearStateAttributeDefault(name string, value interface{}) schema.StateUpgradeFunc {
+	return func(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if current, ok := rawState[name]; ok && current != nil && fmt.Sprint(current) == fmt.Sprint(value) {
+			delete(rawState, name)
+		}
+		return rawState, nil
+	}
+}
@@ -0,0 +1,142 @@
+package ibm
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const testCisCRN = "crn:v1:bluemix:public:internet-svcs:global:a/4ea1882a2d3401ed1e459979941966ea:31fa970d-51d0-4b05-893e-251cba75a7b3::"
+
+func TestWithStateUpgrades(t *testing.T) {
+	upgrade := func(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		return rawState, nil
+	}
+	r := withStateUpgrades(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+		},
+	}, upgrade, upgrade)
+	if r.SchemaVersion != 2 || len(r.StateUpgraders) != 2 {
+		t.Fatalf("expected the schema version 2 with 2 upgraders, got %d and %d", r.SchemaVersion, len(r.StateUpgraders))
+	}
+	for i, u := range r.StateUpgraders {
+		if u.Version != i {
+			t.Errorf("expected the upgrader %d to upgrade the version %d, got %d", i, i, u.Version)
+		}
+		if !u.Type.IsObjectType() || !u.Type.HasAttribute("name") {
+			t.Errorf("expected the type of the upgrader %d to be the schema of the resource, got %#v", i, u.Type)
+		}
+	}
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Errorf("unexpected validation error: %s", err)
+	}
+}
+
+func TestChainStateUpgrades(t *testing.T) {
+	upgrade := chainStateUpgrades(
+		upgradeStateAttribute("a", func(v string) (string, error) { return v + "1", nil }),
+		upgradeStateAttribute("a", func(v string) (string, error) { return v + "2", nil }),
+	)
+	state, err := upgrade(map[string]interface{}{"a": "0"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if state["a"] != "012" {
+		t.Errorf("expected the upgrades to be applied in order, got %v", state["a"])
+	}
+
+	upgrade = chainStateUpgrades(
+		upgradeStateAttribute("a", func(v string) (string, error) { return "", errors.New("invalid") }),
+		upgradeStateAttribute("a", func(v string) (string, error) { t.Errorf("unexpected upgrade"); return v, nil }),
+	)
+	if _, err := upgrade(map[string]interface{}{"a": "0"}, nil); err == nil {
+		t.Errorf("expected the error of the first upgrade")
+	}
+}
+
+func TestUpgradeStateID(t *testing.T) {
+	upgrade := upgradeStateID(func(id string, rawState map[string]interface{}) (string, error) {
+		return rawState["prefix"].(string) + ":" + id, nil
+	})
+	state, err := upgrade(map[string]interface{}{"id": "abc", "prefix": "p"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if state["id"] != "p:abc" {
+		t.Errorf("expected p:abc, got %v", state["id"])
+	}
+	state, err = upgrade(map[string]interface{}{"prefix": "p"}, nil)
+	if err != nil || state["id"] != nil {
+		t.Errorf("expected a state without ID to be unchanged, got %v, %v", state, err)
+	}
+}
+
+func TestUpgradeCisDomainIDState(t *testing.T) {
+	for _, domainID := range []string{"9caf68812ae9b3f0377fdf986751a78f", "9caf68812ae9b3f0377fdf986751a78f:" + testCisCRN} {
+		state, err := upgradeCisDomainIDState(map[string]interface{}{cisDomainID: domainID}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if state[cisDomainID] != "9caf68812ae9b3f0377fdf986751a78f" {
+			t.Errorf("expected the zone ID for %s, got %v", domainID, state[cisDomainID])
+		}
+	}
+}
+
+func TestUpgradeCisFirewallIDState(t *testing.T) {
+	cases := []struct {
+		id           string
+		firewallType interface{}
+		expected     string
+	}{
+		{"489d96f0da6ed76251b475971b097205:9caf68812ae9b3f0377fdf986751a78f:" + testCisCRN, "lockdowns", "lockdowns:489d96f0da6ed76251b475971b097205:9caf68812ae9b3f0377fdf986751a78f:" + testCisCRN},
+		{"489d96f0da6ed76251b475971b097205:9caf68812ae9b3f0377fdf986751a78f:" + testCisCRN, nil, "lockdowns:489d96f0da6ed76251b475971b097205:9caf68812ae9b3f0377fdf986751a78f:" + testCisCRN},
+		{"ua_rules:489d96f0da6ed76251b475971b097205:9caf68812ae9b3f0377fdf986751a78f:" + testCisCRN, "ua_rules", "ua_rules:489d96f0da6ed76251b475971b097205:9caf68812ae9b3f0377fdf986751a78f:" + testCisCRN},
+	}
+	for _, c := range cases {
+		state, err := upgradeCisFirewallIDState(map[string]interface{}{"id": c.id, cisFirewallType: c.firewallType}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if state["id"] != c.expected {
+			t.Errorf("expected %s, got %v", c.expected, state["id"])
+		}
+	}
+	if _, err := upgradeCisFirewallIDState(map[string]interface{}{"id": "489d96f0da6ed76251b475971b097205"}, nil); err == nil {
+		t.Errorf("expected an error for an invalid ID")
+	}
+}
+
+func TestUpgradeCisDNSRecordsImportIDState(t *testing.T) {
+	zoneID := "9caf68812ae9b3f0377fdf986751a78f"
+	state, err := upgradeCisDNSRecordsImportIDState(map[string]interface{}{
+		"id": "12:10:C:\\zones\\records.txt:" + zoneID + ":" + testCisCRN,
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]interface{}{
+		"id":                                  zoneID + ":" + testCisCRN,
+		cisDNSRecordsImportFile:               "C:\\zones\\records.txt",
+		cisDNSRecordsImportTotalRecordsParsed: 12,
+		cisDNSRecordsImportRecordsAdded:       10,
+	}
+	if !reflect.DeepEqual(state, expected) {
+		t.Errorf("expected %v, got %v", expected, state)
+	}
+
+	// The upgrade is idempotent
+	state, err = upgradeCisDNSRecordsImportIDState(state, nil)
+	if err != nil || state["id"] != zoneID+":"+testCisCRN {
+		t.Errorf("expected the ID to be unchanged, got %v, %v", state["id"], err)
+	}
+
+	for _, id := range []string{"10:records.txt:" + zoneID + ":" + testCisCRN, "a:b:records.txt:" + zoneID + ":" + testCisCRN} {
+		if _, err := upgradeCisDNSRecordsImportIDState(map[string]interface{}{"id": id}, nil); err == nil {
+			t.Errorf("expected an error for the invalid ID %s", id)
+		}
+	}
+}
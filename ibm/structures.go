@@ -1456,6 +1456,24 @@ func convertTftoCisTwoVar(tfId string) (Id string, cisId string, err error) {
 	return
 }
 
+// Cloud Internet Services
+// cisIDExampleCRN is the CIS instance CRN shown in the examples of the CIS IDs
+const cisIDExampleCRN = "crn:v1:bluemix:public:internet-svcs:global:a/4ea1882a2d3401ed1e459979941966ea:31fa970d-51d0-4b05-893e-251cba75a7b3::"
+
+// Cloud Internet Services
+// newCisIDTemplate returns the template of a CIS ID, the CRN of the CIS instance is
+// appended to the segments of the template
+func newCisIDTemplate(resource, template, example string) *idTemplate {
+	return newIDTemplate(resource, template+":{cis_id:crn}", example+":"+cisIDExampleCRN)
+}
+
+// Cloud Internet Services
+// upgradeCisDomainIDState stores the domain_id as the zone ID, the ID of ibm_cis_domain
+// (zone ID:crn) passed as domain_id was kept as is in the state
+var upgradeCisDomainIDState = upgradeStateAttribute(cisDomainID, func(domainID string) (string, error) {
+	return strings.SplitN(domainID, ":", 2)[0], nil
+})
+
 // Cloud Internet Services
 func transformToIBMCISDnsData(recordType string, id string, value interface{}) (newValue interface{}, err error) {
 	switch {
@@ -1497,8 +1515,7 @@ func rcInstanceExists(resourceId string, resourceType string, meta interface{})
 	exists := true
 	instance, err := rsConClient.ResourceServiceInstance().GetInstance(resourceId)
 	if err != nil {
-		if strings.Contains(err.Error(), "Object not found") ||
-			strings.Contains(err.Error(), "status code: 404") {
+		if isNotFound(err) {
 			exists = false
 		} else {
 			return true, fmt.Errorf("Error checking resource instance exists: %s", err)
@@ -1583,7 +1600,7 @@ func UpdateTags(d *schema.ResourceData, meta interface{}) error {
 		newList = new(schema.Set)
 	}
 	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
+	news := withDefaultTags(newList.(*schema.Set), olds.F, meta)
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
 	add := make([]string, len(addInt))
@@ -1602,16 +1619,11 @@ func UpdateTags(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 	if len(remove) > 0 {
+		// Detached tags are not deleted, they may be attached to other resources
 		_, err := gtClient.Tags().DetachTags(resourceID, remove)
 		if err != nil {
 			return fmt.Errorf("Error detaching database tags %v: %s", remove, err)
 		}
-		for _, v := range remove {
-			_, err := gtClient.Tags().DeleteTag(v)
-			if err != nil {
-				return fmt.Errorf("Error deleting database tag %v: %s", v, err)
-			}
-		}
 	}
 	return nil
 }
@@ -1646,7 +1658,7 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		newList = new(schema.Set)
 	}
 	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
+	news := withDefaultTags(newList.(*schema.Set), olds.F, meta)
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
 	add := make([]string, len(addInt))
@@ -1666,16 +1678,11 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 	}
 
 	if len(remove) > 0 {
+		// Detached tags are not deleted, they may be attached to other resources
 		_, err := gtClient.Tags().DetachTags(resourceCRN, remove)
 		if err != nil {
 			return fmt.Errorf("Error detaching database tags %v: %s", remove, err)
 		}
-		for _, v := range remove {
-			_, err := gtClient.Tags().DeleteTag(v)
-			if err != nil {
-				return fmt.Errorf("Error deleting database tag %v: %s", v, err)
-			}
-		}
 	}
 
 	if len(add) > 0 {
@@ -1707,7 +1714,49 @@ func flattenSSLCiphers(ciphers []datatypes.Network_LBaaS_SSLCipher) *schema.Set
 	return newStringSet(schema.HashString, c)
 }
 
-func resourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
+// defaultTags returns the default_tags of the provider
+func defaultTags(meta interface{}) []string {
+	if sess, ok := meta.(ClientSession); ok {
+		return sess.DefaultTags()
+	}
+	return nil
+}
+
+// withDefaultTags merges the default_tags of the provider into the tags of a resource,
+// hash is used when the tags have no hash function, e.g. for an empty set
+func withDefaultTags(tags *schema.Set, hash schema.SchemaSetFunc, meta interface{}) *schema.Set {
+	defaults := defaultTags(meta)
+	if len(defaults) == 0 {
+		return tags
+	}
+	if tags.F != nil {
+		hash = tags.F
+	}
+	if hash == nil {
+		hash = resourceIBMVPCHash
+	}
+	merged := schema.NewSet(hash, tags.List())
+	for _, tag := range defaults {
+		merged.Add(tag)
+	}
+	return merged
+}
+
+func resourceTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+
+	// Plan the default_tags of the provider with the tags of the resource, so a
+	// resource is not updated when only the default tags are missing from its tags
+	if tags, ok := diff.Get("tags").(*schema.Set); ok && len(defaultTags(meta)) > 0 {
+		effective := withDefaultTags(tags, nil, meta)
+		if o, _ := diff.GetChange("tags"); diff.Id() != "" && effective.Equal(o) {
+			return diff.Clear("tags")
+		}
+		if !effective.Equal(tags) {
+			if err := diff.SetNew("tags", effective); err != nil {
+				return err
+			}
+		}
+	}
 
 	if diff.Id() != "" && diff.HasChange("tags") {
 		o, n := diff.GetChange("tags")
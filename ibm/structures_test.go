@@ -0,0 +1,96 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestResourceTagsCustomizeDiffDefaultTags(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      resourceIBMVPCHash,
+			},
+		},
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			return resourceTagsCustomizeDiff(diff, v)
+		},
+	}
+	meta := &clientSession{config: &Config{DefaultTags: []string{"env:prod", "team:platform"}}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": []interface{}{"app:web"},
+	})
+
+	// The plan of a new resource shows the default tags
+	diff, err := resource.Diff(nil, config, meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	planned := map[string]bool{}
+	for k, attr := range diff.Attributes {
+		if k != "tags.#" {
+			planned[attr.New] = true
+		}
+	}
+	for _, tag := range []string{"app:web", "env:prod", "team:platform"} {
+		if !planned[tag] {
+			t.Errorf("expected %s in the planned tags, got %v", tag, planned)
+		}
+	}
+
+	// The default tags read from the API are not a drift
+	state := &terraform.InstanceState{
+		ID:         "vpc-1",
+		Attributes: tagAttributes("app:web", "env:prod", "team:platform"),
+	}
+	diff, err = resource.Diff(state, config, meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff when only the default tags are missing, got %v", diff.Attributes)
+	}
+
+	// Other tags are still removed
+	state.Attributes = tagAttributes("app:web", "app:old", "env:prod", "team:platform")
+	diff, err = resource.Diff(state, config, meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff == nil || diff.Empty() {
+		t.Fatalf("expected a diff removing app:old")
+	}
+	if attr := diff.Attributes[fmt.Sprintf("tags.%d", resourceIBMVPCHash("app:old"))]; attr == nil || !attr.NewRemoved {
+		t.Errorf("expected app:old to be removed, got %v", diff.Attributes)
+	}
+}
+
+func TestWithDefaultTags(t *testing.T) {
+	meta := &clientSession{config: &Config{DefaultTags: []string{"env:prod"}}}
+	tags := withDefaultTags(new(schema.Set), resourceIBMVPCHash, meta)
+	if tags.Len() != 1 || !tags.Contains("env:prod") {
+		t.Errorf("expected the default tags to be added to an empty set, got %v", tags.List())
+	}
+	tags = withDefaultTags(newStringSet(resourceIBMVPCHash, []string{"ENV:PROD"}), nil, meta)
+	if tags.Len() != 1 {
+		t.Errorf("expected tags to be compared ignoring case, got %v", tags.List())
+	}
+	if tags := withDefaultTags(new(schema.Set), nil, &clientSession{config: &Config{}}); tags.Len() != 0 {
+		t.Errorf("expected no tags without default tags, got %v", tags.List())
+	}
+}
+
+func tagAttributes(tags ...string) map[string]string {
+	attributes := map[string]string{"tags.#": fmt.Sprint(len(tags))}
+	for _, tag := range tags {
+		attributes[fmt.Sprintf("tags.%d", resourceIBMVPCHash(tag))] = tag
+	}
+	return attributes
+}
@@ -0,0 +1,79 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev1/controller"
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// sweepCISDNSRecordNames are the names of the DNS records of the acceptance tests,
+// which are not random
+var sweepCISDNSRecordNames = regexp.MustCompile(`^(tf-acctest-basic|tf-acctest-case-insensitive|test|test_acc)\.`)
+
+// The DNS records are swept from the test domains of the CIS instance of the tests,
+// IBM_CIS_DOMAIN_STATIC and IBM_CIS_DOMAIN_TEST of IBM_CIS_INSTANCE
+func init() {
+	addSweeper("ibm_cis_dns_record", nil, sweepCISDNSRecordNames, listSweepableCISDNSRecords)
+}
+
+func listSweepableCISDNSRecords(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	if cisInstance == "" {
+		log.Printf("[WARN] IBM_CIS_INSTANCE is not set, the DNS records are not swept")
+		return nil, nil
+	}
+	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
+	if err != nil {
+		return nil, err
+	}
+	instances, err := rsConClient.ResourceServiceInstance().ListInstances(controller.ServiceInstanceQuery{Name: cisInstance})
+	if err != nil {
+		return nil, err
+	}
+	if len(instances) == 0 {
+		return nil, fmt.Errorf("No CIS instance found with name %s", cisInstance)
+	}
+	crn := instances[0].ID
+
+	zonesClient, err := meta.(ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return nil, err
+	}
+	zonesClient.Crn = core.StringPtr(crn)
+	opt := zonesClient.NewListZonesOptions()
+	opt.SetPage(1)
+	opt.SetPerPage(1000)
+	zones, response, err := zonesClient.ListZones(opt)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the zones of %s: %s\n%s", crn, err, response)
+	}
+
+	recordsClient, err := meta.(ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	recordsClient.Crn = core.StringPtr(crn)
+	var found []sweepable
+	for _, zone := range zones.Result {
+		if *zone.Name != cisDomainStatic && *zone.Name != cisDomainTest {
+			continue
+		}
+		recordsClient.ZoneIdentifier = zone.ID
+		opt := recordsClient.NewListAllDnsRecordsOptions()
+		opt.SetPage(1)
+		opt.SetPerPage(1000)
+		records, response, err := recordsClient.ListAllDnsRecords(opt)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing the DNS records of %s: %s\n%s", *zone.Name, err, response)
+		}
+		for _, record := range records.Result {
+			found = append(found, sweepable{
+				id:   convertCisToTfThreeVar(*record.ID, *zone.ID, crn),
+				name: *record.Name,
+			})
+		}
+	}
+	return found, nil
+}
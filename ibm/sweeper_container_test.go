@@ -0,0 +1,63 @@
+package ibm
+
+import (
+	"fmt"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+// sweepClusterNames are the names of the clusters of the acceptance tests
+var sweepClusterNames = sweepNames("terraform-", "terraform_", "terraform1_")
+
+// The clusters are swept before the VPC subnets they use
+func init() {
+	addSweeper("ibm_container_cluster", nil, sweepClusterNames, listSweepableClusters)
+	addSweeper("ibm_container_vpc_cluster", nil, sweepClusterNames, listSweepableVpcClusters)
+}
+
+func listSweepableClusters(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	csClient, err := meta.(ClientSession).ContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := csClient.Clusters().List(v1.ClusterTargetHeader{AccountID: userDetails.userAccount, ResourceGroup: resourceGroupID})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the clusters: %s", err)
+	}
+	var found []sweepable
+	for _, cluster := range clusters {
+		found = append(found, sweepable{
+			id:              cluster.ID,
+			name:            cluster.Name,
+			resourceGroupID: cluster.ResourceGroupID,
+			attributes:      map[string]interface{}{"resource_group_id": cluster.ResourceGroupID},
+		})
+	}
+	return found, nil
+}
+
+func listSweepableVpcClusters(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := csClient.Clusters().List(v2.ClusterTargetHeader{ResourceGroup: resourceGroupID})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the VPC clusters: %s", err)
+	}
+	var found []sweepable
+	for _, cluster := range clusters {
+		found = append(found, sweepable{
+			id:              cluster.ID,
+			name:            cluster.Name,
+			resourceGroupID: cluster.ResourceGroupID,
+			attributes:      map[string]interface{}{"resource_group_id": cluster.ResourceGroupID},
+		})
+	}
+	return found, nil
+}
@@ -0,0 +1,128 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev1/controller"
+	"github.com/IBM-Cloud/bluemix-go/models"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+var (
+	// sweepBucketNames are the names of the COS buckets of the acceptance tests
+	sweepBucketNames = sweepNames("tf-bucket", "terraform")
+
+	// sweepInstanceNames are the names of the service instances of the acceptance tests
+	sweepInstanceNames = sweepNames("activity_tracker_", "cos_", "cos_instance_", "hpcs_", "kmsInstance_", "kms_",
+		"metrics_monitor_", "terraform-", "terraform_", "tf-Pgress-", "tf-cos-", "tf-ins-", "tf-kms-")
+)
+
+// The COS buckets are swept before the service instances of the tests, which are
+// swept after the clusters using them
+func init() {
+	addSweeper("ibm_cos_bucket", nil, sweepBucketNames, listSweepableCOSBuckets)
+	addSweeper("ibm_resource_instance", []string{"ibm_cos_bucket", "ibm_container_cluster", "ibm_container_vpc_cluster"},
+		sweepInstanceNames, listSweepableResourceInstances)
+}
+
+// listSweepableServiceInstances lists the active service instances of the resource
+// group, except the instance of the CIS tests
+func listSweepableServiceInstances(meta interface{}, resourceGroupID string) ([]models.ServiceInstance, error) {
+	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
+	if err != nil {
+		return nil, err
+	}
+	instances, err := rsConClient.ResourceServiceInstance().ListInstances(controller.ServiceInstanceQuery{ResourceGroupID: resourceGroupID})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the resource instances: %s", err)
+	}
+	var active []models.ServiceInstance
+	for _, instance := range instances {
+		if instance.State == "active" && instance.Name != cisInstance {
+			active = append(active, instance)
+		}
+	}
+	return active, nil
+}
+
+func listSweepableResourceInstances(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	instances, err := listSweepableServiceInstances(meta, resourceGroupID)
+	if err != nil {
+		return nil, err
+	}
+	var found []sweepable
+	for _, instance := range instances {
+		found = append(found, sweepable{id: instance.ID, name: instance.Name, resourceGroupID: instance.ResourceGroupID})
+	}
+	return found, nil
+}
+
+// listSweepableCOSBuckets lists the buckets of the COS instances, with the location
+// of the bucket read from its location constraint, e.g. us-south-standard
+func listSweepableCOSBuckets(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	instances, err := listSweepableServiceInstances(meta, resourceGroupID)
+	if err != nil {
+		return nil, err
+	}
+	bxSession, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	if bxSession.Config.BluemixAPIKey == "" {
+		return nil, fmt.Errorf("The COS buckets are swept with an IBM Cloud API key")
+	}
+	authEndpoint, err := bxSession.Config.EndpointLocator.IAMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	apiEndpoint, _ := selectCosApi("crl", "us")
+
+	var found []sweepable
+	for _, instance := range instances {
+		if instance.Crn.ServiceName != "cloud-object-storage" {
+			continue
+		}
+		s3Conf := aws.NewConfig().WithEndpoint(apiEndpoint).WithS3ForcePathStyle(true).
+			WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpoint+"/identity/token", bxSession.Config.BluemixAPIKey, instance.ID))
+		s3Client := s3.New(session.Must(session.NewSession()), s3Conf)
+		buckets, err := s3Client.ListBucketsExtended(&s3.ListBucketsExtendedInput{})
+		if err != nil {
+			return nil, fmt.Errorf("Error listing the buckets of %s: %s", instance.ID, err)
+		}
+		for _, bucket := range buckets.Buckets {
+			location := aws.StringValue(bucket.LocationConstraint)
+			if i := strings.LastIndex(location, "-"); i > 0 && containsString(storageClass, location[i+1:]) {
+				location = location[:i]
+			}
+			attribute, apiType := "", ""
+			switch {
+			case containsString(crossRegionLocation, location):
+				attribute, apiType = "cross_region_location", "crl"
+			case containsString(regionLocation, location):
+				attribute, apiType = "region_location", "rl"
+			case containsString(singleSiteLocation, location):
+				attribute, apiType = "single_site_location", "ssl"
+			default:
+				log.Printf("[WARN] Unknown location %s of the bucket %s, it is not swept", aws.StringValue(bucket.LocationConstraint), *bucket.Name)
+				continue
+			}
+			found = append(found, sweepable{
+				id:              fmt.Sprintf("%s:bucket:%s:meta:%s:%s:public", strings.Replace(instance.ID, "::", "", -1), *bucket.Name, apiType, location),
+				name:            *bucket.Name,
+				resourceGroupID: instance.ResourceGroupID,
+				attributes: map[string]interface{}{
+					"bucket_name":          *bucket.Name,
+					"resource_instance_id": instance.ID,
+					attribute:              location,
+					"force_delete":         true,
+				},
+			})
+		}
+	}
+	return found, nil
+}
@@ -0,0 +1,32 @@
+package ibm
+
+import (
+	"fmt"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+)
+
+// The Power instances are swept from the cloud instance of the tests, PI_CLOUDINSTANCE_ID
+func init() {
+	addSweeper("ibm_pi_instance", nil, sweepNames("tf-pi-instance-"), listSweepablePIInstances)
+}
+
+func listSweepablePIInstances(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return nil, err
+	}
+	client := st.NewIBMPIInstanceClient(sess, pi_cloud_instance_id)
+	instances, err := client.GetAll(pi_cloud_instance_id, getTimeOut)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the pvm instances of %s: %s", pi_cloud_instance_id, err)
+	}
+	var found []sweepable
+	for _, instance := range instances.PvmInstances {
+		found = append(found, sweepable{
+			id:   fmt.Sprintf("%s/%s", pi_cloud_instance_id, *instance.PvmInstanceID),
+			name: *instance.ServerName,
+		})
+	}
+	return found, nil
+}
@@ -0,0 +1,185 @@
+package ibm
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// The sweepers delete the resources left behind by failed acceptance tests in the
+// resource group of the tests, e.g.
+//
+//	IBM_SWEEP_RESOURCE_GROUP_ID=... go test ./ibm -v -sweep=us-south -sweep-run=ibm_is_vpc -sweep-dry-run
+//
+// Each resource type has its sweeper, and the sweepers of the resources depending
+// on a resource run before its own sweeper, e.g. the load balancers before the
+// subnets and the subnets before the VPCs. Only the resources named like the tests
+// name them are deleted, e.g. tf-vpc-42. With -sweep-dry-run the sweepers only list
+// the resources they would delete.
+var sweepDryRun = flag.Bool("sweep-dry-run", false, "List the resources the sweepers would delete without deleting them")
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sweepResourceGroupEnv is the variable holding the ID of the resource group of the
+// acceptance tests, the resources of the other groups are not swept
+const sweepResourceGroupEnv = "IBM_SWEEP_RESOURCE_GROUP_ID"
+
+// sweepNames returns the pattern of the names generated by the acceptance tests with
+// the prefixes, a prefix followed by the random number of the test, e.g. tf-vpc-42
+// or testvpc42
+func sweepNames(prefixes ...string) *regexp.Regexp {
+	quoted := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		quoted[i] = regexp.QuoteMeta(prefix)
+	}
+	return regexp.MustCompile(`^(` + strings.Join(quoted, "|") + `)\d+$`)
+}
+
+// sweepable is a resource found by a sweeper, the attributes are set on the
+// resource data before the resource is deleted. The resource group is empty for the
+// resources which are not in a resource group, the listing scopes them to the tests,
+// e.g. the Power instances of PI_CLOUDINSTANCE_ID.
+type sweepable struct {
+	id              string
+	name            string
+	resourceGroupID string
+	attributes      map[string]interface{}
+}
+
+// sweepListFunc lists the resources of a type which may be swept, in the resource
+// group when the API filters by resource group
+type sweepListFunc func(meta interface{}, resourceGroupID string) ([]sweepable, error)
+
+// addSweeper registers the sweeper of a resource type, deleting the listed resources
+// of the resource group of the tests whose name matches names with the Delete
+// function of the resource. The sweepers of the dependencies run first.
+func addSweeper(resourceType string, dependencies []string, names *regexp.Regexp, list sweepListFunc) {
+	resource.AddTestSweepers(resourceType, &resource.Sweeper{
+		Name:         resourceType,
+		Dependencies: dependencies,
+		F: func(region string) error {
+			resourceGroupID := os.Getenv(sweepResourceGroupEnv)
+			if resourceGroupID == "" {
+				return fmt.Errorf("%s must be set to the ID of the resource group of the acceptance tests to sweep %s", sweepResourceGroupEnv, resourceType)
+			}
+			meta, err := sharedClientSession(region)
+			if err != nil {
+				return err
+			}
+			return sweepResources(meta, resourceType, names, resourceGroupID, list, *sweepDryRun)
+		},
+	})
+}
+
+var (
+	sweepSessionsMu sync.Mutex
+	sweepSessions   = map[string]interface{}{}
+)
+
+// sharedClientSession returns the client session of the region configured from the
+// environment like the provider, e.g. with IC_API_KEY
+func sharedClientSession(region string) (interface{}, error) {
+	sweepSessionsMu.Lock()
+	defer sweepSessionsMu.Unlock()
+	if meta, ok := sweepSessions[region]; ok {
+		return meta, nil
+	}
+	provider := Provider().(*schema.Provider)
+	if err := provider.Configure(terraform.NewResourceConfigRaw(map[string]interface{}{"region": region})); err != nil {
+		return nil, fmt.Errorf("Error configuring the client session of the sweepers in %s: %s", region, err)
+	}
+	sweepSessions[region] = provider.Meta()
+	return provider.Meta(), nil
+}
+
+func sweepResources(meta interface{}, resourceType string, names *regexp.Regexp, resourceGroupID string, list sweepListFunc, dryRun bool) error {
+	found, err := list(meta, resourceGroupID)
+	if err != nil {
+		return fmt.Errorf("Error listing the %s resources to sweep: %s", resourceType, err)
+	}
+	r := Provider().(*schema.Provider).ResourcesMap[resourceType]
+	var failures []string
+	for _, s := range found {
+		if !names.MatchString(s.name) || (s.resourceGroupID != "" && s.resourceGroupID != resourceGroupID) {
+			continue
+		}
+		if dryRun {
+			log.Printf("[INFO] Would sweep %s %s (%s)", resourceType, s.name, s.id)
+			continue
+		}
+		log.Printf("[INFO] Sweeping %s %s (%s)", resourceType, s.name, s.id)
+		d := r.Data(nil)
+		d.SetId(s.id)
+		for k, v := range s.attributes {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("Error setting %s of %s %s: %s", k, resourceType, s.id, err)
+			}
+		}
+		if err := r.Delete(d, meta); err != nil {
+			failures = append(failures, fmt.Sprintf("%s (%s): %s", s.name, s.id, err))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("Error sweeping %d %s resources:\n%s", len(failures), resourceType, strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+func TestUnitSweepResources(t *testing.T) {
+	cloud := newFakeCloud(t)
+	defer cloud.close()
+	meta := cloud.session()
+	sess, err := meta.VpcV1API()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	ids := map[string]string{}
+	for name, resourceGroupID := range map[string]string{
+		"tf-vpc-42":   fakeResourceGroupID,
+		"testvpc43":   fakeResourceGroupID,
+		"tf-vpc-prod": fakeResourceGroupID,
+		"production":  fakeResourceGroupID,
+		"tf-vpc-44":   "other-resource-group",
+	} {
+		vpc, _, err := sess.CreateVPC(&vpcv1.CreateVPCOptions{
+			Name:          core.StringPtr(name),
+			ResourceGroup: &vpcv1.ResourceGroupIdentity{ID: core.StringPtr(resourceGroupID)},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		ids[name] = *vpc.ID
+	}
+
+	if err := sweepResources(meta, "ibm_is_vpc", sweepVPCNames, fakeResourceGroupID, listSweepableVPCs, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for name, id := range ids {
+		if cloud.vpc.get(id) == nil {
+			t.Errorf("expected the dry run to keep %s", name)
+		}
+	}
+
+	if err := sweepResources(meta, "ibm_is_vpc", sweepVPCNames, fakeResourceGroupID, listSweepableVPCs, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for name, id := range ids {
+		expected := name == "tf-vpc-42" || name == "testvpc43"
+		if swept := cloud.vpc.get(id) == nil; swept != expected {
+			t.Errorf("unexpected sweep of %s, swept: %t", name, swept)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// sweepVPCNames are the names of the VPC resources of the acceptance tests
+var sweepVPCNames = sweepNames(
+	"flowlog-instance-", "flowlog-subnet-", "flowlog-vpc-", "terraform", "terraform_",
+	"terraformvpcsg-", "terraformvpcuat-", "testinstancegroup", "testsshkey",
+	"testsubnet", "testvpc", "tf-", "tf-create-name-", "tf-instance-", "tf-instnace-",
+	"tf-routetable-", "tf-ssh-", "tf-subnet-", "tf-vol-", "tf-vol-upd-", "tf-vpc-",
+	"tf-vpcname-", "tfaddprename-", "tfaddprenamename-", "tfc-sg-name-", "tfc-vpc-name-",
+	"tfcreate", "tfike-name-", "tfimage-name-", "tfimg-enc-name-", "tfimg-name-",
+	"tfins-name-", "tfins-ssh-", "tfins-subnet-", "tfins-vpc-", "tfip-", "tfip-instance-",
+	"tfip-sshname-", "tfip-subnet-", "tfip-vpc-", "tfipsecc-name-", "tflb-create-name-",
+	"tflb-name-", "tflb-subnet-name-", "tflb-vpc-", "tflblipolicy-rule-field-",
+	"tflblipolicy-rule-value-", "tflblis", "tflblis-subnet-", "tflblis-vpc-",
+	"tflblisuat", "tflblisuat-listener-policy-", "tflblisuat-subnet-", "tflblisuat-vpc-",
+	"tflbp-subnet-", "tflbp-vpc-", "tflbpc-name-", "tflbpm-vpc-", "tflbpmc-name-",
+	"tflbpoolc", "tflbpoolu", "tflbt-vpc-", "tfnlbcreate", "tfnlbpoolc", "tfnlbupdate",
+	"tfnw-acl-", "tfpgw-name-", "tfpgw-update-name-", "tfpgw-vpc-", "tfsg-",
+	"tfsg-createname-", "tfsg-updatename-", "tfsg-vpc-", "tfsgrule-createname-",
+	"tfsgrule-updatename-", "tfsgrule-vpc-", "tfssh-createname-", "tfssh-name-",
+	"tfsubnet-", "tfsubnet-create-data-", "tfsubnet-gw-", "tfsubnet-name-",
+	"tfsubnet-vpc-", "tfupdate", "tfvpc-create-", "tfvpcrt-create-",
+	"tfvpcrt-create-data-", "tfvpcrt-up-create-", "tfvpcuat-", "tfvpcuat-create-",
+	"tfvpcuat-create-data-", "tfvpngc-createname-", "tfvpngc-subnet-",
+	"tfvpngc-updatename-", "tfvpngc-vpc-", "tfvpngc-vpn-", "tfvpngw-createname-",
+	"tfvpngw-subnet-", "tfvpngw-vpc-", "tfvpnuat-createname-", "tfvpnuat-subnet-",
+	"tfvpnuat-vpc-", "tfvpnuat-vpngw-",
+)
+
+// The VPC resources are swept from the leaves to the VPCs. The pools, listeners and
+// members have no name or a generic one, they are swept with the load balancers of
+// the tests.
+func init() {
+	addSweeper("ibm_is_lb_pool_member", nil, sweepVPCNames, listSweepableLBPoolMembers)
+	addSweeper("ibm_is_lb_listener", nil, sweepVPCNames, listSweepableLBListeners)
+	addSweeper("ibm_is_lb_pool", []string{"ibm_is_lb_pool_member", "ibm_is_lb_listener"}, sweepVPCNames, listSweepableLBPools)
+	addSweeper("ibm_is_lb", []string{"ibm_is_lb_pool"}, sweepVPCNames, listSweepableLBs)
+	addSweeper("ibm_is_floating_ip", nil, sweepVPCNames, listSweepableFloatingIPs)
+	addSweeper("ibm_is_instance", []string{"ibm_is_lb_pool_member", "ibm_is_floating_ip"}, sweepVPCNames, listSweepableInstances)
+	addSweeper("ibm_is_volume", []string{"ibm_is_instance"}, sweepVPCNames, listSweepableVolumes)
+	addSweeper("ibm_is_ssh_key", []string{"ibm_is_instance"}, sweepVPCNames, listSweepableSSHKeys)
+	addSweeper("ibm_is_subnet", []string{"ibm_is_lb", "ibm_is_instance", "ibm_container_vpc_cluster"}, sweepVPCNames, listSweepableSubnets)
+	addSweeper("ibm_is_public_gateway", []string{"ibm_is_subnet"}, sweepVPCNames, listSweepablePublicGateways)
+	addSweeper("ibm_is_security_group", []string{"ibm_is_instance", "ibm_is_lb"}, sweepVPCNames, listSweepableSecurityGroups)
+	addSweeper("ibm_is_vpc", []string{"ibm_is_subnet", "ibm_is_public_gateway", "ibm_is_security_group", "ibm_container_vpc_cluster"}, sweepVPCNames, listSweepableVPCs)
+}
+
+// sweepVPCPages calls list with the start of each page until the last one, list
+// returns the start of the next page
+func sweepVPCPages(list func(start *string) (string, error)) error {
+	start := ""
+	for {
+		var next *string
+		if start != "" {
+			next = &start
+		}
+		var err error
+		if start, err = list(next); err != nil {
+			return err
+		}
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func listSweepableVPCs(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var found []sweepable
+	err = sweepVPCPages(func(start *string) (string, error) {
+		vpcs, response, err := sess.ListVpcs(&vpcv1.ListVpcsOptions{Start: start, ResourceGroupID: &resourceGroupID})
+		if err != nil {
+			return "", fmt.Errorf("Error Fetching vpcs %s\n%s", err, response)
+		}
+		for _, vpc := range vpcs.Vpcs {
+			found = append(found, sweepable{id: *vpc.ID, name: *vpc.Name, resourceGroupID: *vpc.ResourceGroup.ID})
+		}
+		return GetNext(vpcs.Next), nil
+	})
+	return found, err
+}
+
+func listSweepableSubnets(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var found []sweepable
+	err = sweepVPCPages(func(start *string) (string, error) {
+		subnets, response, err := sess.ListSubnets(&vpcv1.ListSubnetsOptions{Start: start, ResourceGroupID: &resourceGroupID})
+		if err != nil {
+			return "", fmt.Errorf("Error Fetching subnets %s\n%s", err, response)
+		}
+		for _, subnet := range subnets.Subnets {
+			found = append(found, sweepable{id: *subnet.ID, name: *subnet.Name, resourceGroupID: *subnet.ResourceGroup.ID})
+		}
+		return GetNext(subnets.Next), nil
+	})
+	return found, err
+}
+
+func listSweepablePublicGateways(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var found []sweepable
+	err = sweepVPCPages(func(start *string) (string, error) {
+		gateways, response, err := sess.ListPublicGateways(&vpcv1.ListPublicGatewaysOptions{Start: start, ResourceGroupID: &resourceGroupID})
+		if err != nil {
+			return "", fmt.Errorf("Error Fetching public gateways %s\n%s", err, response)
+		}
+		for _, gateway := range gateways.PublicGateways {
+			found = append(found, sweepable{id: *gateway.ID, name: *gateway.Name, resourceGroupID: *gateway.ResourceGroup.ID})
+		}
+		return GetNext(gateways.Next), nil
+	})
+	return found, err
+}
+
+func listSweepableSecurityGroups(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var found []sweepable
+	err = sweepVPCPages(func(start *string) (string, error) {
+		groups, response, err := sess.ListSecurityGroups(&vpcv1.ListSecurityGroupsOptions{Start: start, ResourceGroupID: &resourceGroupID})
+		if err != nil {
+			return "", fmt.Errorf("Error Fetching security groups %s\n%s", err, response)
+		}
+		for _, group := range groups.SecurityGroups {
+			found = append(found, sweepable{id: *group.ID, name: *group.Name, resourceGroupID: *group.ResourceGroup.ID})
+		}
+		return GetNext(groups.Next), nil
+	})
+	return found, err
+}
+
+func listSweepableInstances(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var found []sweepable
+	err = sweepVPCPages(func(start *string) (string, error) {
+		instances, response, err := sess.ListInstances(&vpcv1.ListInstancesOptions{Start: start, ResourceGroupID: &resourceGroupID})
+		if err != nil {
+			return "", fmt.Errorf("Error Fetching instances %s\n%s", err, response)
+		}
+		for _, instance := range instances.Instances {
+			found = append(found, sweepable{id: *instance.ID, name: *instance.Name, resourceGroupID: *instance.ResourceGroup.ID})
+		}
+		return GetNext(instances.Next), nil
+	})
+	return found, err
+}
+
+func listSweepableFloatingIPs(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var found []sweepable
+	err = sweepVPCPages(func(start *string) (string, error) {
+		floatingIPs, response, err := sess.ListFloatingIps(&vpcv1.ListFloatingIpsOptions{Start: start, ResourceGroupID: &resourceGroupID})
+		if err != nil {
+			return "", fmt.Errorf("Error Fetching floating IPs %s\n%s", err, response)
+		}
+		for _, floatingIP := range floatingIPs.FloatingIps {
+			found = append(found, sweepable{id: *floatingIP.ID, name: *floatingIP.Name, resourceGroupID: *floatingIP.ResourceGroup.ID})
+		}
+		return GetNext(floatingIPs.Next), nil
+	})
+	return found, err
+}
+
+func listSweepableVolumes(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var found []sweepable
+	err = sweepVPCPages(func(start *string) (string, error) {
+		volumes, response, err := sess.ListVolumes(&vpcv1.ListVolumesOptions{Start: start})
+		if err != nil {
+			return "", fmt.Errorf("Error Fetching volumes %s\n%s", err, response)
+		}
+		for _, volume := range volumes.Volumes {
+			found = append(found, sweepable{id: *volume.ID, name: *volume.Name, resourceGroupID: *volume.ResourceGroup.ID})
+		}
+		return GetNext(volumes.Next), nil
+	})
+	return found, err
+}
+
+func listSweepableSSHKeys(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	keys, response, err := sess.ListKeys(&vpcv1.ListKeysOptions{ResourceGroupID: &resourceGroupID})
+	if err != nil {
+		return nil, fmt.Errorf("Error Fetching keys %s\n%s", err, response)
+	}
+	var found []sweepable
+	for _, key := range keys.Keys {
+		found = append(found, sweepable{id: *key.ID, name: *key.Name, resourceGroupID: *key.ResourceGroup.ID})
+	}
+	return found, nil
+}
+
+func listSweepableLBs(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	lbs, response, err := sess.ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Error Fetching load balancers %s\n%s", err, response)
+	}
+	var found []sweepable
+	for _, lb := range lbs.LoadBalancers {
+		found = append(found, sweepable{id: *lb.ID, name: *lb.Name, resourceGroupID: *lb.ResourceGroup.ID})
+	}
+	return found, nil
+}
+
+// listSweepableLBChildren lists the children of every load balancer, named after
+// their load balancer
+func listSweepableLBChildren(meta interface{}, resourceGroupID string, children func(sess *vpcv1.VpcV1, lb sweepable) ([]sweepable, error)) ([]sweepable, error) {
+	lbs, err := listSweepableLBs(meta, resourceGroupID)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var found []sweepable
+	for _, lb := range lbs {
+		items, err := children(sess, lb)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, items...)
+	}
+	return found, nil
+}
+
+func listSweepableLBPools(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	return listSweepableLBChildren(meta, resourceGroupID, func(sess *vpcv1.VpcV1, lb sweepable) ([]sweepable, error) {
+		pools, response, err := sess.ListLoadBalancerPools(&vpcv1.ListLoadBalancerPoolsOptions{LoadBalancerID: &lb.id})
+		if err != nil {
+			return nil, fmt.Errorf("Error Fetching the pools of the load balancer %s %s\n%s", lb.id, err, response)
+		}
+		var found []sweepable
+		for _, pool := range pools.Pools {
+			found = append(found, sweepable{id: fmt.Sprintf("%s/%s", lb.id, *pool.ID), name: lb.name, resourceGroupID: lb.resourceGroupID})
+		}
+		return found, nil
+	})
+}
+
+func listSweepableLBListeners(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	return listSweepableLBChildren(meta, resourceGroupID, func(sess *vpcv1.VpcV1, lb sweepable) ([]sweepable, error) {
+		listeners, response, err := sess.ListLoadBalancerListeners(&vpcv1.ListLoadBalancerListenersOptions{LoadBalancerID: &lb.id})
+		if err != nil {
+			return nil, fmt.Errorf("Error Fetching the listeners of the load balancer %s %s\n%s", lb.id, err, response)
+		}
+		var found []sweepable
+		for _, listener := range listeners.Listeners {
+			found = append(found, sweepable{id: fmt.Sprintf("%s/%s", lb.id, *listener.ID), name: lb.name, resourceGroupID: lb.resourceGroupID})
+		}
+		return found, nil
+	})
+}
+
+func listSweepableLBPoolMembers(meta interface{}, resourceGroupID string) ([]sweepable, error) {
+	return listSweepableLBChildren(meta, resourceGroupID, func(sess *vpcv1.VpcV1, lb sweepable) ([]sweepable, error) {
+		pools, response, err := sess.ListLoadBalancerPools(&vpcv1.ListLoadBalancerPoolsOptions{LoadBalancerID: &lb.id})
+		if err != nil {
+			return nil, fmt.Errorf("Error Fetching the pools of the load balancer %s %s\n%s", lb.id, err, response)
+		}
+		var found []sweepable
+		for _, pool := range pools.Pools {
+			members, response, err := sess.ListLoadBalancerPoolMembers(&vpcv1.ListLoadBalancerPoolMembersOptions{LoadBalancerID: &lb.id, PoolID: pool.ID})
+			if err != nil {
+				return nil, fmt.Errorf("Error Fetching the members of the pool %s/%s %s\n%s", lb.id, *pool.ID, err, response)
+			}
+			for _, member := range members.Members {
+				found = append(found, sweepable{id: fmt.Sprintf("%s/%s/%s", lb.id, *pool.ID, *member.ID), name: lb.name, resourceGroupID: lb.resourceGroupID})
+			}
+		}
+		return found, nil
+	})
+}
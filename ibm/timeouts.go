@@ -0,0 +1,30 @@
+package ibm
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	waitTimeMinutes            = "wait_time_minutes"
+	waitTimeMinutesDeprecation = "wait_time_minutes is deprecated, use the create, update and delete timeouts instead"
+)
+
+// waitTimeout returns the time to wait for the operation of a resource having the
+// deprecated wait_time_minutes, it takes precedence over the create, update or delete
+// timeout of the resource when it is set in the configuration.
+func waitTimeout(d *schema.ResourceData, key string) time.Duration {
+	if v, ok := d.GetOkExists(waitTimeMinutes); ok {
+		return time.Duration(v.(int)) * time.Minute
+	}
+	return d.Timeout(key)
+}
+
+// upgradeWaitTimeMinutesState drops wait_time_minutes from the states where it has the
+// value it used to default to, the timeouts of the resource default to the same time.
+// Without the upgrade the configurations not setting wait_time_minutes would show a diff
+// and keep on using the value of the state.
+func upgradeWaitTimeMinutesState(oldDefault int) schema.StateUpgradeFunc {
+	return clearStateAttributeDefault(waitTimeMinutes, oldDefault)
+}
@@ -0,0 +1,43 @@
+package ibm
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestUpgradeWaitTimeMinutesState(t *testing.T) {
+	cases := []struct {
+		name     string
+		state    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{"default", map[string]interface{}{"id": "1", waitTimeMinutes: float64(90)}, map[string]interface{}{"id": "1"}},
+		{"flatmap default", map[string]interface{}{"id": "1", waitTimeMinutes: "90"}, map[string]interface{}{"id": "1"}},
+		{"set", map[string]interface{}{"id": "1", waitTimeMinutes: float64(30)}, map[string]interface{}{"id": "1", waitTimeMinutes: float64(30)}},
+		{"null", map[string]interface{}{"id": "1", waitTimeMinutes: nil}, map[string]interface{}{"id": "1", waitTimeMinutes: nil}},
+	}
+	for _, c := range cases {
+		state, err := upgradeWaitTimeMinutesState(90)(c.state, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+		if !reflect.DeepEqual(state, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, state)
+		}
+	}
+}
+
+func TestWaitTimeout(t *testing.T) {
+	r := resourceIBMComputeVmInstance()
+	d := r.TestResourceData()
+	if timeout := waitTimeout(d, schema.TimeoutCreate); timeout != d.Timeout(schema.TimeoutCreate) {
+		t.Errorf("expected the create timeout, got %s", timeout)
+	}
+	d.Set(waitTimeMinutes, 15)
+	if timeout := waitTimeout(d, schema.TimeoutCreate); timeout != 15*time.Minute {
+		t.Errorf("expected wait_time_minutes to take precedence, got %s", timeout)
+	}
+}
@@ -1120,6 +1120,37 @@ type ValidateSchema struct {
 	Required bool
 	Default  interface{}
 	ForceNew bool
+
+	// Validate each element of a list, set or map instead of the value itself.
+	// Ex: the values of a TypeMap, where terraform passes the whole map to ValidateFunc
+	Elements bool
+}
+
+// ValidateRuleType is an enum of the cross-field rules supported by this tool.
+type ValidateRuleType int
+
+const (
+	// Exactly one of the Identifiers must be set
+	RuleExactlyOneOf ValidateRuleType = iota
+	// All the Identifiers must be set when When is set, or has one of the WhenValues
+	RuleRequiredWith
+	// The Identifiers must have one of the AllowedValues when When has one of the WhenValues
+	RuleAllowedValuesWhen
+)
+
+// ValidateRule is used to describe a rule between several parameters of a resource.
+type ValidateRule struct {
+	Type ValidateRuleType
+
+	// The list or set of blocks the rule applies to, each block is validated on its own.
+	// Ex: actions in ibm_cis_page_rule resource. Empty for top level parameters.
+	Block string
+
+	Identifiers []string
+
+	When          string
+	WhenValues    string //Comma separated list of strings.
+	AllowedValues string //Comma separated list of strings.
 }
 
 type ResourceValidator struct {
@@ -1129,6 +1160,9 @@ type ResourceValidator struct {
 
 	// Array of validator objects. Each object refers to one parameter in the resource provider.
 	Schema []ValidateSchema
+
+	// Array of cross-field rules, checked by the CustomizeDiff returned by InvokeResourceValidator.
+	Rules []ValidateRule
 }
 
 type ValidatorDict struct {
@@ -1144,53 +1178,181 @@ type ValidatorDict struct {
 var validatorDict = Validator()
 
 // This is the main validation function. This function will be used in all the provider code.
+// It panics when the parameter is not registered in the Validator dictionary, so that a typo in
+// the identifier fails the provider initialization instead of disabling the validation.
 func InvokeValidator(resourceName, identifier string) schema.SchemaValidateFunc {
+	return invokeValidator(validatorDict.ResourceValidatorDictionary, resourceName, identifier)
+}
+
+func InvokeDataSourceValidator(resourceName, identifier string) schema.SchemaValidateFunc {
+	return invokeValidator(validatorDict.DataSourceValidatorDictionary, resourceName, identifier)
+}
+
+func invokeValidator(dict map[string]*ResourceValidator, resourceName, identifier string) schema.SchemaValidateFunc {
 	// Loop through dictionary and identify the resource and then the parameter configuration.
-	var schemaToInvoke ValidateSchema
-	found := false
-	resourceItem := validatorDict.ResourceValidatorDictionary[resourceName]
-	if resourceItem.ResourceName == resourceName {
-		parameterValidateSchema := resourceItem.Schema
-		for _, validateSchema := range parameterValidateSchema {
-			if validateSchema.Identifier == identifier {
-				schemaToInvoke = validateSchema
-				found = true
-				break
+	resourceItem, ok := dict[resourceName]
+	if !ok || resourceItem.ResourceName != resourceName {
+		panic(fmt.Sprintf("unknown validator resource %s", resourceName))
+	}
+	for _, validateSchema := range resourceItem.Schema {
+		if validateSchema.Identifier == identifier {
+			if validateSchema.Elements {
+				return validateElements(invokeValidatorInternal(validateSchema))
 			}
+			return invokeValidatorInternal(validateSchema)
 		}
 	}
+	panic(fmt.Sprintf("unknown validator %s of %s", identifier, resourceName))
+}
 
-	if found {
-		return invokeValidatorInternal(schemaToInvoke)
-	} else {
-		// Add error code later. TODO
-		return nil
+// validateElements applies the validation function to each element of a list, set or map
+func validateElements(f schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		switch value := v.(type) {
+		case map[string]interface{}:
+			for key, elem := range value {
+				w, e := f(elem, fmt.Sprintf("%s.%s", k, key))
+				ws, errors = append(ws, w...), append(errors, e...)
+			}
+		case *schema.Set:
+			return validateElements(f)(value.List(), k)
+		case []interface{}:
+			for i, elem := range value {
+				w, e := f(elem, fmt.Sprintf("%s.%d", k, i))
+				ws, errors = append(ws, w...), append(errors, e...)
+			}
+		default:
+			return f(v, k)
+		}
+		return
 	}
 }
 
-func InvokeDataSourceValidator(resourceName, identifier string) schema.SchemaValidateFunc {
-	// Loop through dictionary and identify the resource and then the parameter configuration.
-	var schemaToInvoke ValidateSchema
-	found := false
-
-	dataSourceItem := validatorDict.DataSourceValidatorDictionary[resourceName]
-	if dataSourceItem.ResourceName == resourceName {
-		parameterValidateSchema := dataSourceItem.Schema
-		for _, validateSchema := range parameterValidateSchema {
-			if validateSchema.Identifier == identifier {
-				schemaToInvoke = validateSchema
-				found = true
-				break
+// unknownVariableValue is the value terraform gives to the attributes that are not known during the plan
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// InvokeResourceValidator returns the CustomizeDiff checking the cross-field rules of the resource.
+func InvokeResourceValidator(resourceName string) schema.CustomizeDiffFunc {
+	resourceItem, ok := validatorDict.ResourceValidatorDictionary[resourceName]
+	if !ok || resourceItem.ResourceName != resourceName {
+		panic(fmt.Sprintf("unknown validator resource %s", resourceName))
+	}
+	if len(resourceItem.Rules) == 0 {
+		panic(fmt.Sprintf("no validation rules for %s", resourceName))
+	}
+	rules := resourceItem.Rules
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		get := func(key string) (interface{}, bool) {
+			if !d.NewValueKnown(key) {
+				return unknownVariableValue, true
 			}
+			return d.GetOk(key)
 		}
+		return checkValidateRules(rules, get)
 	}
+}
 
-	if found {
-		return invokeValidatorInternal(schemaToInvoke)
-	} else {
-		// Add error code later. TODO
-		return nil
+// checkValidateRules checks the rules against the values returned by get, the same way as ResourceData.GetOk
+func checkValidateRules(rules []ValidateRule, get func(key string) (interface{}, bool)) error {
+	for _, rule := range rules {
+		if rule.Block == "" {
+			if err := rule.check(get, ""); err != nil {
+				return err
+			}
+			continue
+		}
+		v, ok := get(rule.Block)
+		if !ok || v == unknownVariableValue {
+			continue
+		}
+		var blocks []interface{}
+		switch b := v.(type) {
+		case *schema.Set:
+			blocks = b.List()
+		case []interface{}:
+			blocks = b
+		}
+		for _, block := range blocks {
+			values, _ := block.(map[string]interface{})
+			getInBlock := func(key string) (interface{}, bool) {
+				v := values[key]
+				return v, v != nil && !reflect.DeepEqual(v, reflect.Zero(reflect.TypeOf(v)).Interface())
+			}
+			if err := rule.check(getInBlock, fmt.Sprintf(" in %s", rule.Block)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (rule ValidateRule) check(get func(key string) (interface{}, bool), where string) error {
+	isSet := func(key string) (value string, ok bool, known bool) {
+		v, ok := get(key)
+		if v == unknownVariableValue {
+			return "", false, false
+		}
+		if ok {
+			value = fmt.Sprint(v)
+		}
+		return value, ok, true
+	}
+
+	switch rule.Type {
+	case RuleExactlyOneOf:
+		count := 0
+		for _, identifier := range rule.Identifiers {
+			_, ok, known := isSet(identifier)
+			if !known {
+				return nil
+			}
+			if ok {
+				count++
+			}
+		}
+		if count != 1 {
+			return fmt.Errorf("Exactly one of %s must be set%s", quoteIdentifiers(rule.Identifiers), where)
+		}
+	case RuleRequiredWith, RuleAllowedValuesWhen:
+		when, ok, known := isSet(rule.When)
+		if !ok || !known {
+			return nil
+		}
+		if rule.WhenValues != "" && !stringInSlice(when, splitValues(rule.WhenValues)) {
+			return nil
+		}
+		for _, identifier := range rule.Identifiers {
+			value, ok, known := isSet(identifier)
+			if !known {
+				continue
+			}
+			if rule.Type == RuleRequiredWith && !ok {
+				return fmt.Errorf("%s must be set when `%s` is %s%s", quoteIdentifiers(rule.Identifiers), rule.When, when, where)
+			}
+			if rule.Type == RuleAllowedValuesWhen && ok && !stringInSlice(value, splitValues(rule.AllowedValues)) {
+				return fmt.Errorf("`%s` must be one of %v when `%s` is %s%s, got %s", identifier, splitValues(rule.AllowedValues), rule.When, when, where, value)
+			}
+		}
+	default:
+		panic(fmt.Sprintf("unknown validation rule %s", rule.Type))
+	}
+	return nil
+}
+
+func quoteIdentifiers(identifiers []string) string {
+	quoted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		quoted[i] = fmt.Sprintf("`%s`", identifier)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func splitValues(values string) []string {
+	arr := strings.Split(values, ",")
+	for i, ele := range arr {
+		arr[i] = strings.TrimSpace(ele)
 	}
+	return arr
 }
 
 // the function is currently modified to invoke SchemaValidateFunc directly.
@@ -1233,7 +1395,7 @@ func invokeValidatorInternal(schema ValidateSchema) schema.SchemaValidateFunc {
 		return validateBindedPackageName()
 
 	default:
-		return nil
+		panic(fmt.Sprintf("unknown validator function %s of %s", funcIdentifier, schema.Identifier))
 	}
 }
 
@@ -1303,7 +1465,14 @@ func (vs ValidateSchema) GetValue(valueConstraint ValueConstraintType) interface
 
 // Use stringer tool to generate this later.
 func (i FunctionIdentifier) String() string {
-	return [...]string{"IntBetween", "IntAtLeast", "IntAtMost"}[i]
+	return [...]string{"IntBetween", "IntAtLeast", "IntAtMost", "ValidateAllowedStringValue", "StringLenBetween",
+		"ValidateIPorCIDR", "ValidateCIDRAddress", "ValidateAllowedIntValue", "ValidateRegexpLen", "ValidateRegexp",
+		"ValidateNoZeroValues", "ValidateJSONString", "ValidateJSONParam", "ValidateBindedPackageName"}[i]
+}
+
+// Use Stringer tool to generate this later.
+func (i ValidateRuleType) String() string {
+	return [...]string{"RuleExactlyOneOf", "RuleRequiredWith", "RuleAllowedValuesWhen"}[i]
 }
 
 // Use Stringer tool to generate this later.
@@ -0,0 +1,200 @@
+package ibm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestValidateElements(t *testing.T) {
+	f := validateElements(invokeValidatorInternal(ValidateSchema{
+		Identifier:                 "labels",
+		ValidateFunctionIdentifier: ValidateAllowedStringValue,
+		Type:                       TypeString,
+		AllowedValues:              "on, off"}))
+
+	cases := []struct {
+		value  interface{}
+		errors int
+	}{
+		{"on", 0},
+		{"yes", 1},
+		{map[string]interface{}{"a": "on", "b": "off"}, 0},
+		{map[string]interface{}{"a": "on", "b": "yes", "c": "no"}, 2},
+		{[]interface{}{"on", "yes"}, 1},
+		{schema.NewSet(schema.HashString, []interface{}{"yes", "no", "off"}), 2},
+	}
+	for _, c := range cases {
+		_, errors := f(c.value, "labels")
+		if len(errors) != c.errors {
+			t.Errorf("expected %d errors for %v, got %v", c.errors, c.value, errors)
+		}
+	}
+	if _, errors := f(map[string]interface{}{"a": "yes"}, "labels"); len(errors) != 1 || !strings.Contains(errors[0].Error(), "labels.a") {
+		t.Errorf("expected the error to name the element, got %v", errors)
+	}
+}
+
+func TestCheckValidateRules(t *testing.T) {
+	rules := []ValidateRule{
+		{Type: RuleExactlyOneOf, Identifiers: []string{"name", "id"}},
+		{Type: RuleRequiredWith, Identifiers: []string{"port"}, When: "protocol", WhenValues: "tcp, udp"},
+		{Type: RuleAllowedValuesWhen, Identifiers: []string{"port"}, When: "protocol", WhenValues: "tcp", AllowedValues: "22, 80"},
+	}
+	cases := []struct {
+		values map[string]interface{}
+		err    string
+	}{
+		{map[string]interface{}{"name": "vpc"}, ""},
+		{map[string]interface{}{}, "Exactly one of `name`, `id` must be set"},
+		{map[string]interface{}{"name": "vpc", "id": "r006"}, "Exactly one of `name`, `id` must be set"},
+		{map[string]interface{}{"name": unknownVariableValue, "id": "r006"}, ""},
+		{map[string]interface{}{"id": "r006", "protocol": "udp"}, "`port` must be set when `protocol` is udp"},
+		{map[string]interface{}{"id": "r006", "protocol": "icmp"}, ""},
+		{map[string]interface{}{"id": "r006", "protocol": "udp", "port": unknownVariableValue}, ""},
+		{map[string]interface{}{"id": "r006", "protocol": "tcp", "port": 80}, ""},
+		{map[string]interface{}{"id": "r006", "protocol": "tcp", "port": 443}, "`port` must be one of [22 80] when `protocol` is tcp, got 443"},
+		{map[string]interface{}{"id": "r006", "protocol": "udp", "port": 443}, ""},
+	}
+	for _, c := range cases {
+		get := func(key string) (interface{}, bool) {
+			v, ok := c.values[key]
+			return v, ok
+		}
+		err := checkValidateRules(rules, get)
+		if c.err == "" && err != nil {
+			t.Errorf("unexpected error for %v: %s", c.values, err)
+		}
+		if c.err != "" && (err == nil || err.Error() != c.err) {
+			t.Errorf("expected %q for %v, got %v", c.err, c.values, err)
+		}
+	}
+}
+
+func TestCheckValidateRulesInBlocks(t *testing.T) {
+	rules := resourceCISPageRuleValidator().Rules
+	action := func(id, value, url string, statusCode int) map[string]interface{} {
+		return map[string]interface{}{
+			cisPageRuleActionsID:              id,
+			cisPageRuleActionsValue:           value,
+			cisPageRuleActionsValueURL:        url,
+			cisPageRuleActionsValueStatusCode: statusCode,
+		}
+	}
+	cases := []struct {
+		actions []interface{}
+		err     string
+	}{
+		{[]interface{}{action("always_online", "on", "", 0), action("ssl", "strict", "", 0)}, ""},
+		{[]interface{}{action("always_online", "full", "", 0)}, "`value` must be one of [on off] when `id` is always_online in actions, got full"},
+		{[]interface{}{action("ssl", "", "", 0)}, "`value` must be set when `id` is ssl in actions"},
+		{[]interface{}{action("edge_cache_ttl", "7200", "", 0)}, ""},
+		{[]interface{}{action("edge_cache_ttl", "7201", "", 0)}, "`value` must be one of"},
+		{[]interface{}{action("disable_security", "", "", 0)}, ""},
+		{[]interface{}{action("forwarding_url", "", "https://example.com/*", 301)}, ""},
+		{[]interface{}{action("forwarding_url", "", "https://example.com/*", 0)}, "`url`, `status_code` must be set when `id` is forwarding_url in actions"},
+		{[]interface{}{action("forwarding_url", "", "https://example.com/*", 307)}, "`status_code` must be one of [301 302]"},
+	}
+	for _, c := range cases {
+		get := func(key string) (interface{}, bool) {
+			if key == cisPageRuleActions {
+				return c.actions, true
+			}
+			return nil, false
+		}
+		err := checkValidateRules(rules, get)
+		if c.err == "" && err != nil {
+			t.Errorf("unexpected error for %v: %s", c.actions, err)
+		}
+		if c.err != "" && (err == nil || !strings.HasPrefix(err.Error(), c.err)) {
+			t.Errorf("expected %q for %v, got %v", c.err, c.actions, err)
+		}
+	}
+}
+
+func TestCheckValidateRulesCISDNSRecord(t *testing.T) {
+	rules := resourceIBMCISDnsRecordValidator().Rules
+	cases := []struct {
+		values map[string]interface{}
+		err    string
+	}{
+		{map[string]interface{}{cisDNSRecordType: "A", cisDNSRecordContent: "192.168.0.1"}, ""},
+		{map[string]interface{}{cisDNSRecordType: "SRV", cisDNSRecordData: map[string]interface{}{"target": "example.com"}}, ""},
+		{map[string]interface{}{cisDNSRecordType: "PTR", cisDNSRecordContent: "example.com"}, ""},
+		{map[string]interface{}{cisDNSRecordType: "PTR"}, ""},
+		{map[string]interface{}{cisDNSRecordType: "LOC", cisDNSRecordContent: "51 30 12.748 N"}, "`data` must be set when `type` is LOC"},
+		{map[string]interface{}{cisDNSRecordType: "MX", cisDNSRecordData: map[string]interface{}{"target": "example.com"}}, "`content` must be set when `type` is MX"},
+		{map[string]interface{}{cisDNSRecordType: "A", cisDNSRecordContent: unknownVariableValue}, ""},
+	}
+	for _, c := range cases {
+		get := func(key string) (interface{}, bool) {
+			v, ok := c.values[key]
+			return v, ok
+		}
+		err := checkValidateRules(rules, get)
+		if c.err == "" && err != nil {
+			t.Errorf("unexpected error for %v: %s", c.values, err)
+		}
+		if c.err != "" && (err == nil || err.Error() != c.err) {
+			t.Errorf("expected %q for %v, got %v", c.err, c.values, err)
+		}
+	}
+
+	validate := InvokeValidator(ibmCISDNSRecord, cisDNSRecordData)
+	if _, errs := validate(map[string]interface{}{"tag": "issue", "value": "ca.example.com"}, cisDNSRecordData); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	if _, errs := validate(map[string]interface{}{"tag": "issue", "value": ""}, cisDNSRecordData); len(errs) != 1 {
+		t.Errorf("expected an error for the empty value, got %v", errs)
+	}
+}
+
+func TestInvokeValidatorUnknownIdentifier(t *testing.T) {
+	expectPanic := func(name string, f func()) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("expected %s to panic", name)
+			}
+		}()
+		f()
+	}
+	expectPanic("an unknown identifier", func() { InvokeValidator("ibm_is_vpc", "nmae") })
+	expectPanic("an unknown resource", func() { InvokeValidator("ibm_is_vpcs", "name") })
+	expectPanic("an unknown data source", func() { InvokeDataSourceValidator("ibm_is_vpcs", "name") })
+	expectPanic("an unknown function", func() { invokeValidatorInternal(ValidateSchema{ValidateFunctionIdentifier: ValidateJSONParam}) })
+	expectPanic("a resource without rules", func() { InvokeResourceValidator("ibm_is_vpc") })
+	if InvokeValidator("ibm_is_vpc", "name") == nil {
+		t.Errorf("expected the validator of a registered identifier")
+	}
+}
+
+// The identifiers of the rules must be attributes of the resource, or of the block they apply to
+func TestValidateRulesIdentifiers(t *testing.T) {
+	resources := Provider().(*schema.Provider).ResourcesMap
+	for name, validator := range Validator().ResourceValidatorDictionary {
+		for _, rule := range validator.Rules {
+			attributes := resources[name].Schema
+			if rule.Block != "" {
+				block, ok := attributes[rule.Block]
+				if !ok {
+					t.Errorf("%s: unknown block %s", name, rule.Block)
+					continue
+				}
+				attributes = block.Elem.(*schema.Resource).Schema
+			}
+			for _, identifier := range append([]string{rule.When}, rule.Identifiers...) {
+				if _, ok := attributes[identifier]; identifier != "" && !ok {
+					t.Errorf("%s: unknown attribute %s in the rule %s", name, identifier, rule.Type)
+				}
+			}
+		}
+	}
+}
+
+func TestFunctionIdentifierString(t *testing.T) {
+	if s := fmt.Sprint(ValidateBindedPackageName); s != "ValidateBindedPackageName" {
+		t.Errorf("expected ValidateBindedPackageName, got %s", s)
+	}
+}